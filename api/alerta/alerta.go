@@ -0,0 +1,82 @@
+package alerta
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/GianGoulart/CrudProdutos/app"
+	alertaApp "github.com/GianGoulart/CrudProdutos/app/alerta"
+	"github.com/GianGoulart/CrudProdutos/logger"
+	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/labstack/echo/v4"
+)
+
+// Register group item check
+func Register(g *echo.Group, apps *app.Container) {
+	h := &handler{
+		apps: apps,
+	}
+
+	g.GET("", h.getAlertas)
+	g.PUT("/:codigo/reconhecer", h.reconhecerAlerta)
+}
+
+type handler struct {
+	apps *app.Container
+}
+
+func (h *handler) getAlertas(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	filtro := model.FiltroAlerta{
+		Produto: c.QueryParam("produto"),
+	}
+
+	if q := c.QueryParam("reconhecido"); q != "" {
+		reconhecido, err := strconv.ParseBool(q)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, model.Response{
+				Data: nil,
+				Err:  "reconhecido deve ser true ou false",
+			})
+		}
+		filtro.Reconhecido = &reconhecido
+	}
+
+	resp, err := h.apps.Alerta.GetAlertas(ctx, filtro)
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).Error("api.alerta.getAlertas")
+		return c.JSON(http.StatusInternalServerError, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.Response{
+		Data: resp,
+	})
+}
+
+func (h *handler) reconhecerAlerta(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	resp, err := h.apps.Alerta.ReconhecerAlerta(ctx, c.Param("codigo"))
+	if errors.Is(err, alertaApp.ErrAlertaNaoEncontrado) {
+		return c.JSON(http.StatusNotFound, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).Error("api.alerta.reconhecerAlerta")
+		return c.JSON(http.StatusBadRequest, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.Response{
+		Data: resp,
+	})
+}
@@ -0,0 +1,68 @@
+package alerta
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/GianGoulart/CrudProdutos/app"
+	"github.com/GianGoulart/CrudProdutos/mocks"
+	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	erro = errors.New("ocorreu um erro")
+
+	res = []model.Alerta{
+		{Codigo: "a1", ProdutoCodigo: "p1", Nivel: model.AlertaEstoqueBaixo, EstoqueDisponivel: 2, Limite: 5},
+	}
+)
+
+func Test_getAlertas(t *testing.T) {
+	e := echo.New()
+	ctx := context.Background()
+	reconhecido := false
+
+	cases := map[string]struct {
+		Query        string
+		ExpectedData int
+
+		PrepareMock func(mock *mocks.IAlertaApp)
+	}{
+		"deve retornar sucesso com os filtros": {Query: "?produto=p1&reconhecido=false", ExpectedData: http.StatusOK, PrepareMock: func(mock *mocks.IAlertaApp) {
+			mock.On("GetAlertas", ctx, model.FiltroAlerta{Produto: "p1", Reconhecido: &reconhecido}).Return(&res, nil)
+		}},
+		"deve retornar erro com o filtro inválido": {Query: "?reconhecido=talvez", ExpectedData: http.StatusBadRequest, PrepareMock: func(mock *mocks.IAlertaApp) {}},
+		"deve retornar erro com a mensagem: ocorreu um erro": {ExpectedData: http.StatusInternalServerError, PrepareMock: func(mock *mocks.IAlertaApp) {
+			mock.On("GetAlertas", ctx, model.FiltroAlerta{}).Return(nil, erro)
+		}},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			mock := new(mocks.IAlertaApp)
+
+			cs.PrepareMock(mock)
+
+			request, err := http.NewRequest(http.MethodGet, "/alertas"+cs.Query, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rr := httptest.NewRecorder()
+			h := handler{
+				apps: &app.Container{Alerta: mock},
+			}
+
+			c := e.NewContext(request, rr)
+
+			if assert.NoError(t, h.getAlertas(c)) {
+				assert.Equal(t, cs.ExpectedData, rr.Code)
+			}
+		})
+	}
+}
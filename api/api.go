@@ -1,8 +1,17 @@
 package api
 
 import (
+	"github.com/GianGoulart/CrudProdutos/api/alerta"
+	"github.com/GianGoulart/CrudProdutos/api/categoria"
+	"github.com/GianGoulart/CrudProdutos/api/deposito"
+	"github.com/GianGoulart/CrudProdutos/api/fornecedor"
+	"github.com/GianGoulart/CrudProdutos/api/graphql"
+	"github.com/GianGoulart/CrudProdutos/api/marca"
 	"github.com/GianGoulart/CrudProdutos/api/produto"
+	"github.com/GianGoulart/CrudProdutos/api/promocao"
+	"github.com/GianGoulart/CrudProdutos/api/webhook"
 	"github.com/GianGoulart/CrudProdutos/app"
+	"github.com/GianGoulart/CrudProdutos/ratelimit"
 	"github.com/labstack/echo/v4"
 	"github.com/sirupsen/logrus"
 )
@@ -11,11 +20,32 @@ import (
 type Options struct {
 	Group *echo.Group
 	Apps  *app.Container
+
+	// Idempotency middleware aplicado nas rotas de escrita que aceitam Idempotency-Key
+	Idempotency echo.MiddlewareFunc
+
+	// GraphQL limites das consultas em /graphql, que não é registrado quando nil
+	GraphQL *graphql.Limites
+
+	// RateLimit limiter onde as rotas de consulta por POST são declaradas como leitura,
+	// nil quando o rate limit está desabilitado
+	RateLimit *ratelimit.Limiter
 }
 
 // Register api instance
 func Register(opts Options) {
-	produto.Register(opts.Group.Group("produtos"), opts.Apps)
+	produto.Register(opts.Group.Group("produtos"), opts.Apps, opts.Idempotency, opts.RateLimit)
+	categoria.Register(opts.Group.Group("categorias"), opts.Apps)
+	marca.Register(opts.Group.Group("marcas"), opts.Apps)
+	fornecedor.Register(opts.Group.Group("fornecedores"), opts.Apps)
+	deposito.Register(opts.Group.Group("depositos"), opts.Apps)
+	alerta.Register(opts.Group.Group("alertas"), opts.Apps)
+	webhook.Register(opts.Group.Group("webhooks"), opts.Apps)
+	promocao.Register(opts.Group.Group("promocoes"), opts.Apps)
+
+	if opts.GraphQL != nil {
+		graphql.Register(opts.Group.Group("graphql"), opts.Apps, *opts.GraphQL, opts.RateLimit)
+	}
 
 	logrus.Info("Registered -> Api")
 }
@@ -0,0 +1,175 @@
+package categoria
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/GianGoulart/CrudProdutos/app"
+	categoriaApp "github.com/GianGoulart/CrudProdutos/app/categoria"
+	"github.com/GianGoulart/CrudProdutos/logger"
+	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/labstack/echo/v4"
+)
+
+// Register group item check
+func Register(g *echo.Group, apps *app.Container) {
+	h := &handler{
+		apps: apps,
+	}
+
+	g.GET("", h.getCategorias)
+	g.GET("/arvore", h.getArvore)
+	g.GET("/:codigo", h.getCategoriaByCodigo)
+	g.POST("", h.createCategoria)
+	g.PUT("", h.updateCategoria)
+	g.DELETE("/:codigo", h.deleteCategoria)
+}
+
+type handler struct {
+	apps *app.Container
+}
+
+func (h *handler) getCategorias(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	resp, err := h.apps.Categoria.GetCategorias(ctx)
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).Error("api.categoria.getCategorias")
+		return c.JSON(http.StatusInternalServerError, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.Response{
+		Data: resp,
+	})
+}
+
+func (h *handler) getArvore(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	resp, err := h.apps.Categoria.GetArvore(ctx)
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).Error("api.categoria.getArvore")
+		return c.JSON(http.StatusInternalServerError, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.Response{
+		Data: resp,
+	})
+}
+
+func (h *handler) getCategoriaByCodigo(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	resp, err := h.apps.Categoria.GetCategoriaByCodigo(ctx, c.Param("codigo"))
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).Error("api.categoria.getCategoriaByCodigo")
+		return c.JSON(http.StatusInternalServerError, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+
+	if resp.Codigo == "" {
+		return c.JSON(http.StatusNotFound, model.Response{
+			Data: nil,
+			Err:  categoriaApp.ErrCategoriaNaoEncontrada.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.Response{
+		Data: resp,
+	})
+}
+
+func (h *handler) createCategoria(c echo.Context) error {
+	ctx := c.Request().Context()
+	payload := new(model.Categoria)
+
+	if err := c.Bind(payload); err != nil {
+		logger.FromContext(ctx).WithError(err).Warn("api.categoria.createCategoria")
+		return c.JSON(http.StatusBadRequest, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+
+	response, err := h.apps.Categoria.CreateCategoria(ctx, payload)
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).Error("api.categoria.createCategoria")
+		return c.JSON(http.StatusBadRequest, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.Response{
+		Data: response,
+	})
+}
+
+func (h *handler) updateCategoria(c echo.Context) error {
+	ctx := c.Request().Context()
+	payload := new(model.Categoria)
+
+	if err := c.Bind(payload); err != nil {
+		logger.FromContext(ctx).WithError(err).Warn("api.categoria.updateCategoria")
+		return c.JSON(http.StatusBadRequest, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+
+	response, err := h.apps.Categoria.UpdateCategoria(ctx, payload)
+	if errors.Is(err, categoriaApp.ErrCategoriaNaoEncontrada) {
+		return c.JSON(http.StatusNotFound, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).Error("api.categoria.updateCategoria")
+		return c.JSON(http.StatusBadRequest, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.Response{
+		Data: response,
+	})
+}
+
+func (h *handler) deleteCategoria(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	resp, err := h.apps.Categoria.DeleteCategoria(ctx, c.Param("codigo"))
+	if errors.Is(err, categoriaApp.ErrCategoriaNaoEncontrada) {
+		return c.JSON(http.StatusNotFound, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+	if errors.Is(err, categoriaApp.ErrCategoriaComFilhas) {
+		return c.JSON(http.StatusConflict, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).Error("api.categoria.deleteCategoria")
+		return c.JSON(http.StatusBadRequest, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.Response{
+		Data: resp,
+	})
+}
@@ -0,0 +1,166 @@
+package categoria
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/GianGoulart/CrudProdutos/app"
+	categoriaApp "github.com/GianGoulart/CrudProdutos/app/categoria"
+	"github.com/GianGoulart/CrudProdutos/mocks"
+	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	erro = errors.New("ocorreu um erro")
+
+	res = []model.Categoria{
+		{Codigo: "eletro", Nome: "Eletrodomésticos"},
+		{Codigo: "tv", Nome: "Televisores", Pai: "eletro"},
+	}
+)
+
+func Test_getCategoriaByCodigo(t *testing.T) {
+	e := echo.New()
+	ctx := context.Background()
+
+	cases := map[string]struct {
+		ExpectedData int
+
+		PrepareMock func(mock *mocks.ICategoriaApp)
+	}{
+		"deve retornar sucesso": {ExpectedData: http.StatusOK, PrepareMock: func(mock *mocks.ICategoriaApp) {
+			mock.On("GetCategoriaByCodigo", ctx, "tv").Return(&res[1], nil)
+		}},
+		"deve retornar not found": {ExpectedData: http.StatusNotFound, PrepareMock: func(mock *mocks.ICategoriaApp) {
+			mock.On("GetCategoriaByCodigo", ctx, "tv").Return(&model.Categoria{}, nil)
+		}},
+		"deve retornar erro com a mensagem: ocorreu um erro": {ExpectedData: http.StatusInternalServerError, PrepareMock: func(mock *mocks.ICategoriaApp) {
+			mock.On("GetCategoriaByCodigo", ctx, "tv").Return(nil, erro)
+		}},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			mock := new(mocks.ICategoriaApp)
+
+			cs.PrepareMock(mock)
+
+			request, err := http.NewRequest(http.MethodGet, "/categorias/tv", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rr := httptest.NewRecorder()
+			h := handler{
+				apps: &app.Container{Categoria: mock},
+			}
+
+			c := e.NewContext(request, rr)
+			c.SetParamNames("codigo")
+			c.SetParamValues("tv")
+
+			if assert.NoError(t, h.getCategoriaByCodigo(c)) {
+				assert.Equal(t, cs.ExpectedData, rr.Code)
+			}
+		})
+	}
+}
+
+func Test_createCategoria(t *testing.T) {
+	e := echo.New()
+	ctx := context.Background()
+
+	cases := map[string]struct {
+		ExpectedData int
+		InputBody    string
+
+		PrepareMock func(mock *mocks.ICategoriaApp)
+	}{
+		"deve retornar sucesso": {ExpectedData: http.StatusOK, InputBody: `{"nome":"Televisores","pai":"eletro"}`, PrepareMock: func(m *mocks.ICategoriaApp) {
+			m.On("CreateCategoria", ctx, mock.Anything).Return(&res[1], nil)
+		}},
+		"deve retornar erro com o pai inexistente": {ExpectedData: http.StatusBadRequest, InputBody: `{"nome":"Televisores","pai":"xpto"}`, PrepareMock: func(m *mocks.ICategoriaApp) {
+			m.On("CreateCategoria", ctx, mock.Anything).Return(nil, categoriaApp.ErrCategoriaPaiNaoEncontrada)
+		}},
+		"deve retornar erro com o corpo inválido": {ExpectedData: http.StatusBadRequest, InputBody: `{`, PrepareMock: func(m *mocks.ICategoriaApp) {}},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			mock := new(mocks.ICategoriaApp)
+
+			cs.PrepareMock(mock)
+
+			request, err := http.NewRequest(http.MethodPost, "/categorias", strings.NewReader(cs.InputBody))
+			if err != nil {
+				t.Fatal(err)
+			}
+			request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+			rr := httptest.NewRecorder()
+			h := handler{
+				apps: &app.Container{Categoria: mock},
+			}
+
+			c := e.NewContext(request, rr)
+
+			if assert.NoError(t, h.createCategoria(c)) {
+				assert.Equal(t, cs.ExpectedData, rr.Code)
+			}
+		})
+	}
+}
+
+func Test_deleteCategoria(t *testing.T) {
+	e := echo.New()
+	ctx := context.Background()
+
+	cases := map[string]struct {
+		ExpectedData int
+
+		PrepareMock func(mock *mocks.ICategoriaApp)
+	}{
+		"deve retornar sucesso": {ExpectedData: http.StatusOK, PrepareMock: func(mock *mocks.ICategoriaApp) {
+			mock.On("DeleteCategoria", ctx, "eletro").Return(&res[0], nil)
+		}},
+		"deve retornar conflito com filhas": {ExpectedData: http.StatusConflict, PrepareMock: func(mock *mocks.ICategoriaApp) {
+			mock.On("DeleteCategoria", ctx, "eletro").Return(nil, categoriaApp.ErrCategoriaComFilhas)
+		}},
+		"deve retornar not found": {ExpectedData: http.StatusNotFound, PrepareMock: func(mock *mocks.ICategoriaApp) {
+			mock.On("DeleteCategoria", ctx, "eletro").Return(nil, categoriaApp.ErrCategoriaNaoEncontrada)
+		}},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			mock := new(mocks.ICategoriaApp)
+
+			cs.PrepareMock(mock)
+
+			request, err := http.NewRequest(http.MethodDelete, "/categorias/eletro", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rr := httptest.NewRecorder()
+			h := handler{
+				apps: &app.Container{Categoria: mock},
+			}
+
+			c := e.NewContext(request, rr)
+			c.SetParamNames("codigo")
+			c.SetParamValues("eletro")
+
+			if assert.NoError(t, h.deleteCategoria(c)) {
+				assert.Equal(t, cs.ExpectedData, rr.Code)
+			}
+		})
+	}
+}
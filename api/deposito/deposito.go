@@ -0,0 +1,157 @@
+package deposito
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/GianGoulart/CrudProdutos/app"
+	depositoApp "github.com/GianGoulart/CrudProdutos/app/deposito"
+	"github.com/GianGoulart/CrudProdutos/logger"
+	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/labstack/echo/v4"
+)
+
+// Register group item check
+func Register(g *echo.Group, apps *app.Container) {
+	h := &handler{
+		apps: apps,
+	}
+
+	g.GET("", h.getDepositos)
+	g.GET("/:codigo", h.getDepositoByCodigo)
+	g.POST("", h.createDeposito)
+	g.PUT("", h.updateDeposito)
+	g.DELETE("/:codigo", h.deleteDeposito)
+}
+
+type handler struct {
+	apps *app.Container
+}
+
+func (h *handler) getDepositos(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	resp, err := h.apps.Deposito.GetDepositos(ctx)
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).Error("api.deposito.getDepositos")
+		return c.JSON(http.StatusInternalServerError, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.Response{
+		Data: resp,
+	})
+}
+
+func (h *handler) getDepositoByCodigo(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	resp, err := h.apps.Deposito.GetDepositoByCodigo(ctx, c.Param("codigo"))
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).Error("api.deposito.getDepositoByCodigo")
+		return c.JSON(http.StatusInternalServerError, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+
+	if resp.Codigo == "" {
+		return c.JSON(http.StatusNotFound, model.Response{
+			Data: nil,
+			Err:  depositoApp.ErrDepositoNaoEncontrado.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.Response{
+		Data: resp,
+	})
+}
+
+func (h *handler) createDeposito(c echo.Context) error {
+	ctx := c.Request().Context()
+	payload := new(model.Deposito)
+
+	if err := c.Bind(payload); err != nil {
+		logger.FromContext(ctx).WithError(err).Warn("api.deposito.createDeposito")
+		return c.JSON(http.StatusBadRequest, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+
+	response, err := h.apps.Deposito.CreateDeposito(ctx, payload)
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).Error("api.deposito.createDeposito")
+		return c.JSON(http.StatusBadRequest, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.Response{
+		Data: response,
+	})
+}
+
+func (h *handler) updateDeposito(c echo.Context) error {
+	ctx := c.Request().Context()
+	payload := new(model.Deposito)
+
+	if err := c.Bind(payload); err != nil {
+		logger.FromContext(ctx).WithError(err).Warn("api.deposito.updateDeposito")
+		return c.JSON(http.StatusBadRequest, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+
+	response, err := h.apps.Deposito.UpdateDeposito(ctx, payload)
+	if errors.Is(err, depositoApp.ErrDepositoNaoEncontrado) {
+		return c.JSON(http.StatusNotFound, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).Error("api.deposito.updateDeposito")
+		return c.JSON(http.StatusBadRequest, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.Response{
+		Data: response,
+	})
+}
+
+func (h *handler) deleteDeposito(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	resp, err := h.apps.Deposito.DeleteDeposito(ctx, c.Param("codigo"))
+	if errors.Is(err, depositoApp.ErrDepositoNaoEncontrado) {
+		return c.JSON(http.StatusNotFound, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+	if errors.Is(err, depositoApp.ErrDepositoComEstoque) {
+		return c.JSON(http.StatusConflict, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).Error("api.deposito.deleteDeposito")
+		return c.JSON(http.StatusBadRequest, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.Response{
+		Data: resp,
+	})
+}
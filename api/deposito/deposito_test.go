@@ -0,0 +1,119 @@
+package deposito
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/GianGoulart/CrudProdutos/app"
+	depositoApp "github.com/GianGoulart/CrudProdutos/app/deposito"
+	"github.com/GianGoulart/CrudProdutos/mocks"
+	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	erro = errors.New("ocorreu um erro")
+
+	res = []model.Deposito{
+		{Codigo: "cd-sp", Nome: "CD São Paulo"},
+		{Codigo: "cd-rj", Nome: "CD Rio de Janeiro", Inativo: true},
+	}
+)
+
+func Test_getDepositoByCodigo(t *testing.T) {
+	e := echo.New()
+	ctx := context.Background()
+
+	cases := map[string]struct {
+		ExpectedData int
+
+		PrepareMock func(mock *mocks.IDepositoApp)
+	}{
+		"deve retornar sucesso": {ExpectedData: http.StatusOK, PrepareMock: func(mock *mocks.IDepositoApp) {
+			mock.On("GetDepositoByCodigo", ctx, "cd-rj").Return(&res[1], nil)
+		}},
+		"deve retornar not found": {ExpectedData: http.StatusNotFound, PrepareMock: func(mock *mocks.IDepositoApp) {
+			mock.On("GetDepositoByCodigo", ctx, "cd-rj").Return(&model.Deposito{}, nil)
+		}},
+		"deve retornar erro com a mensagem: ocorreu um erro": {ExpectedData: http.StatusInternalServerError, PrepareMock: func(mock *mocks.IDepositoApp) {
+			mock.On("GetDepositoByCodigo", ctx, "cd-rj").Return(nil, erro)
+		}},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			mock := new(mocks.IDepositoApp)
+
+			cs.PrepareMock(mock)
+
+			request, err := http.NewRequest(http.MethodGet, "/depositos/cd-rj", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rr := httptest.NewRecorder()
+			h := handler{
+				apps: &app.Container{Deposito: mock},
+			}
+
+			c := e.NewContext(request, rr)
+			c.SetParamNames("codigo")
+			c.SetParamValues("cd-rj")
+
+			if assert.NoError(t, h.getDepositoByCodigo(c)) {
+				assert.Equal(t, cs.ExpectedData, rr.Code)
+			}
+		})
+	}
+}
+
+func Test_deleteDeposito(t *testing.T) {
+	e := echo.New()
+	ctx := context.Background()
+
+	cases := map[string]struct {
+		ExpectedData int
+
+		PrepareMock func(mock *mocks.IDepositoApp)
+	}{
+		"deve retornar sucesso": {ExpectedData: http.StatusOK, PrepareMock: func(mock *mocks.IDepositoApp) {
+			mock.On("DeleteDeposito", ctx, "cd-sp").Return(&res[0], nil)
+		}},
+		"deve retornar not found": {ExpectedData: http.StatusNotFound, PrepareMock: func(mock *mocks.IDepositoApp) {
+			mock.On("DeleteDeposito", ctx, "cd-sp").Return(nil, depositoApp.ErrDepositoNaoEncontrado)
+		}},
+		"deve retornar conflito com estoque": {ExpectedData: http.StatusConflict, PrepareMock: func(mock *mocks.IDepositoApp) {
+			mock.On("DeleteDeposito", ctx, "cd-sp").Return(nil, depositoApp.ErrDepositoComEstoque)
+		}},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			mock := new(mocks.IDepositoApp)
+
+			cs.PrepareMock(mock)
+
+			request, err := http.NewRequest(http.MethodDelete, "/depositos/cd-sp", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rr := httptest.NewRecorder()
+			h := handler{
+				apps: &app.Container{Deposito: mock},
+			}
+
+			c := e.NewContext(request, rr)
+			c.SetParamNames("codigo")
+			c.SetParamValues("cd-sp")
+
+			if assert.NoError(t, h.deleteDeposito(c)) {
+				assert.Equal(t, cs.ExpectedData, rr.Code)
+			}
+		})
+	}
+}
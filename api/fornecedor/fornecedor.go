@@ -0,0 +1,151 @@
+package fornecedor
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/GianGoulart/CrudProdutos/app"
+	fornecedorApp "github.com/GianGoulart/CrudProdutos/app/fornecedor"
+	"github.com/GianGoulart/CrudProdutos/logger"
+	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/labstack/echo/v4"
+)
+
+// Register group item check
+func Register(g *echo.Group, apps *app.Container) {
+	h := &handler{
+		apps: apps,
+	}
+
+	g.GET("", h.getFornecedores)
+	g.GET("/:codigo", h.getFornecedorByCodigo)
+	g.POST("", h.createFornecedor)
+	g.PUT("", h.updateFornecedor)
+	g.DELETE("/:codigo", h.deleteFornecedor)
+}
+
+type handler struct {
+	apps *app.Container
+}
+
+func (h *handler) getFornecedores(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	resp, err := h.apps.Fornecedor.GetFornecedores(ctx)
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).Error("api.fornecedor.getFornecedores")
+		return c.JSON(http.StatusInternalServerError, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.Response{
+		Data: resp,
+	})
+}
+
+func (h *handler) getFornecedorByCodigo(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	resp, err := h.apps.Fornecedor.GetFornecedorByCodigo(ctx, c.Param("codigo"))
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).Error("api.fornecedor.getFornecedorByCodigo")
+		return c.JSON(http.StatusInternalServerError, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+
+	if resp.Codigo == "" {
+		return c.JSON(http.StatusNotFound, model.Response{
+			Data: nil,
+			Err:  fornecedorApp.ErrFornecedorNaoEncontrado.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.Response{
+		Data: resp,
+	})
+}
+
+func (h *handler) createFornecedor(c echo.Context) error {
+	ctx := c.Request().Context()
+	payload := new(model.Fornecedor)
+
+	if err := c.Bind(payload); err != nil {
+		logger.FromContext(ctx).WithError(err).Warn("api.fornecedor.createFornecedor")
+		return c.JSON(http.StatusBadRequest, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+
+	response, err := h.apps.Fornecedor.CreateFornecedor(ctx, payload)
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).Error("api.fornecedor.createFornecedor")
+		return c.JSON(http.StatusBadRequest, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.Response{
+		Data: response,
+	})
+}
+
+func (h *handler) updateFornecedor(c echo.Context) error {
+	ctx := c.Request().Context()
+	payload := new(model.Fornecedor)
+
+	if err := c.Bind(payload); err != nil {
+		logger.FromContext(ctx).WithError(err).Warn("api.fornecedor.updateFornecedor")
+		return c.JSON(http.StatusBadRequest, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+
+	response, err := h.apps.Fornecedor.UpdateFornecedor(ctx, payload)
+	if errors.Is(err, fornecedorApp.ErrFornecedorNaoEncontrado) {
+		return c.JSON(http.StatusNotFound, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).Error("api.fornecedor.updateFornecedor")
+		return c.JSON(http.StatusBadRequest, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.Response{
+		Data: response,
+	})
+}
+
+func (h *handler) deleteFornecedor(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	resp, err := h.apps.Fornecedor.DeleteFornecedor(ctx, c.Param("codigo"))
+	if errors.Is(err, fornecedorApp.ErrFornecedorNaoEncontrado) {
+		return c.JSON(http.StatusNotFound, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).Error("api.fornecedor.deleteFornecedor")
+		return c.JSON(http.StatusBadRequest, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.Response{
+		Data: resp,
+	})
+}
@@ -0,0 +1,116 @@
+package fornecedor
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/GianGoulart/CrudProdutos/app"
+	fornecedorApp "github.com/GianGoulart/CrudProdutos/app/fornecedor"
+	"github.com/GianGoulart/CrudProdutos/mocks"
+	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	erro = errors.New("ocorreu um erro")
+
+	res = []model.Fornecedor{
+		{Codigo: "f1", Nome: "Distribuidora A"},
+		{Codigo: "f2", Nome: "Distribuidora B"},
+	}
+)
+
+func Test_getFornecedorByCodigo(t *testing.T) {
+	e := echo.New()
+	ctx := context.Background()
+
+	cases := map[string]struct {
+		ExpectedData int
+
+		PrepareMock func(mock *mocks.IFornecedorApp)
+	}{
+		"deve retornar sucesso": {ExpectedData: http.StatusOK, PrepareMock: func(mock *mocks.IFornecedorApp) {
+			mock.On("GetFornecedorByCodigo", ctx, "f2").Return(&res[1], nil)
+		}},
+		"deve retornar not found": {ExpectedData: http.StatusNotFound, PrepareMock: func(mock *mocks.IFornecedorApp) {
+			mock.On("GetFornecedorByCodigo", ctx, "f2").Return(&model.Fornecedor{}, nil)
+		}},
+		"deve retornar erro com a mensagem: ocorreu um erro": {ExpectedData: http.StatusInternalServerError, PrepareMock: func(mock *mocks.IFornecedorApp) {
+			mock.On("GetFornecedorByCodigo", ctx, "f2").Return(nil, erro)
+		}},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			mock := new(mocks.IFornecedorApp)
+
+			cs.PrepareMock(mock)
+
+			request, err := http.NewRequest(http.MethodGet, "/fornecedores/f2", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rr := httptest.NewRecorder()
+			h := handler{
+				apps: &app.Container{Fornecedor: mock},
+			}
+
+			c := e.NewContext(request, rr)
+			c.SetParamNames("codigo")
+			c.SetParamValues("f2")
+
+			if assert.NoError(t, h.getFornecedorByCodigo(c)) {
+				assert.Equal(t, cs.ExpectedData, rr.Code)
+			}
+		})
+	}
+}
+
+func Test_deleteFornecedor(t *testing.T) {
+	e := echo.New()
+	ctx := context.Background()
+
+	cases := map[string]struct {
+		ExpectedData int
+
+		PrepareMock func(mock *mocks.IFornecedorApp)
+	}{
+		"deve retornar sucesso": {ExpectedData: http.StatusOK, PrepareMock: func(mock *mocks.IFornecedorApp) {
+			mock.On("DeleteFornecedor", ctx, "f1").Return(&res[0], nil)
+		}},
+		"deve retornar not found": {ExpectedData: http.StatusNotFound, PrepareMock: func(mock *mocks.IFornecedorApp) {
+			mock.On("DeleteFornecedor", ctx, "f1").Return(nil, fornecedorApp.ErrFornecedorNaoEncontrado)
+		}},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			mock := new(mocks.IFornecedorApp)
+
+			cs.PrepareMock(mock)
+
+			request, err := http.NewRequest(http.MethodDelete, "/fornecedores/f1", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rr := httptest.NewRecorder()
+			h := handler{
+				apps: &app.Container{Fornecedor: mock},
+			}
+
+			c := e.NewContext(request, rr)
+			c.SetParamNames("codigo")
+			c.SetParamValues("f1")
+
+			if assert.NoError(t, h.deleteFornecedor(c)) {
+				assert.Equal(t, cs.ExpectedData, rr.Code)
+			}
+		})
+	}
+}
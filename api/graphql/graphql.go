@@ -0,0 +1,117 @@
+package graphql
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/GianGoulart/CrudProdutos/app"
+	"github.com/GianGoulart/CrudProdutos/logger"
+	"github.com/GianGoulart/CrudProdutos/ratelimit"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/graphql-go/graphql/language/source"
+	"github.com/labstack/echo/v4"
+)
+
+// Limites limites de profundidade e complexidade das consultas, zero desabilita
+type Limites struct {
+	Profundidade int
+	Complexidade int
+}
+
+// Register registra o endpoint GraphQL, que aceita a consulta por POST em json
+// ou por GET nos parametros query, variables e operationName. O schema só tem
+// consultas, então o POST é declarado como leitura no rate limit, quando houver
+func Register(g *echo.Group, apps *app.Container, limites Limites, limiter *ratelimit.Limiter) {
+	schema, err := newSchema(apps)
+	if err != nil {
+		panic(err)
+	}
+
+	h := &handler{
+		schema:  schema,
+		limites: limites,
+	}
+
+	g.GET("", h.consultar)
+	post := g.POST("", h.consultar)
+
+	if limiter != nil {
+		limiter.Leitura(post.Method, post.Path)
+	}
+}
+
+type handler struct {
+	schema  graphql.Schema
+	limites Limites
+}
+
+// requisicao corpo padrão das requisições GraphQL
+type requisicao struct {
+	Query         string                 `json:"query"`
+	Variables     map[string]interface{} `json:"variables"`
+	OperationName string                 `json:"operationName"`
+}
+
+func (h *handler) consultar(c echo.Context) error {
+	ctx := c.Request().Context()
+	req := new(requisicao)
+
+	if c.Request().Method == http.MethodGet {
+		req.Query = c.QueryParam("query")
+		req.OperationName = c.QueryParam("operationName")
+		if v := c.QueryParam("variables"); v != "" {
+			if err := json.Unmarshal([]byte(v), &req.Variables); err != nil {
+				return c.JSON(http.StatusBadRequest, resultadoErro(err))
+			}
+		}
+	} else if err := c.Bind(req); err != nil {
+		logger.FromContext(ctx).WithError(err).Warn("api.graphql.consultar")
+		return c.JSON(http.StatusBadRequest, resultadoErro(err))
+	}
+
+	res, executada := h.executar(c, req)
+	if !executada {
+		logger.FromContext(ctx).WithField("erros", res.Errors).Warn("api.graphql.consultar")
+		return c.JSON(http.StatusBadRequest, res)
+	}
+
+	if res.HasErrors() {
+		logger.FromContext(ctx).WithField("erros", res.Errors).Error("api.graphql.consultar")
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+// executar valida a consulta e os limites antes de executá-la, indicando se ela
+// chegou a ser executada
+func (h *handler) executar(c echo.Context, req *requisicao) (*graphql.Result, bool) {
+	doc, err := parser.Parse(parser.ParseParams{Source: source.NewSource(&source.Source{
+		Body: []byte(req.Query),
+		Name: "GraphQL request",
+	})})
+	if err != nil {
+		return resultadoErro(err), false
+	}
+
+	if v := graphql.ValidateDocument(&h.schema, doc, nil); !v.IsValid {
+		return &graphql.Result{Errors: v.Errors}, false
+	}
+
+	if err := h.limites.verificar(doc, req.OperationName, req.Variables); err != nil {
+		return resultadoErro(err), false
+	}
+
+	return graphql.Execute(graphql.ExecuteParams{
+		Schema:        h.schema,
+		AST:           doc,
+		OperationName: req.OperationName,
+		Args:          req.Variables,
+		Context:       c.Request().Context(),
+	}), true
+}
+
+func resultadoErro(err error) *graphql.Result {
+	return &graphql.Result{Errors: gqlerrors.FormatErrors(err)}
+}
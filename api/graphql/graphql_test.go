@@ -0,0 +1,226 @@
+package graphql
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/GianGoulart/CrudProdutos/app"
+	"github.com/GianGoulart/CrudProdutos/mocks"
+	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/GianGoulart/CrudProdutos/ratelimit"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	res = []model.Produto{
+		{Codigo: "p1", Nome: "Televisao SAMSUNG", PrecoDe: 2500, PrecoPor: 2000, EstoqueTotal: 100, EstoqueDisponivel: 100},
+		{Codigo: "p2", Nome: "Televisao LG", PrecoDe: 3000, PrecoPor: 3000},
+		{Codigo: "p3", Nome: "Geladeira", PrecoDe: 4000, PrecoPor: 3500, EstoqueTotal: 5, EstoqueDisponivel: 5},
+	}
+	erro = errors.New("ocorreu um erro")
+)
+
+// resposta corpo das respostas GraphQL com os dados ainda em json
+type resposta struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+func Test_consultar(t *testing.T) {
+	cases := map[string]struct {
+		Query     string
+		Variables string
+		Limites   *Limites
+
+		ExpectedStatus int
+		ExpectedData   string
+		ExpectedErr    string
+
+		PrepareMock func(produto *mocks.IProdutoApp, variacao *mocks.IVariacaoApp)
+	}{
+		"deve listar com filtros e paginação": {
+			Query:          `{ produtos(filtro: {nome: "televisao", em_estoque: true}, limite: 1) { total itens { codigo desconto } } }`,
+			ExpectedStatus: http.StatusOK,
+			ExpectedData:   `{"produtos":{"total":1,"itens":[{"codigo":"p1","desconto":20}]}}`,
+			PrepareMock: func(p *mocks.IProdutoApp, v *mocks.IVariacaoApp) {
+				p.On("GetProdutos", mock.Anything).Return(&res, nil)
+			},
+		},
+		"deve aplicar os filtros do serviço com variáveis": {
+			Query:          `query ($marca: String, $offset: Int) { produtos(filtro: {marca: $marca, preco_max: 3000}, offset: $offset) { total offset itens { codigo } } }`,
+			Variables:      `{"marca": "samsung", "offset": 1}`,
+			ExpectedStatus: http.StatusOK,
+			ExpectedData:   `{"produtos":{"total":2,"offset":1,"itens":[{"codigo":"p2"}]}}`,
+			PrepareMock: func(p *mocks.IProdutoApp, v *mocks.IVariacaoApp) {
+				p.On("FiltrarProdutos", mock.Anything, model.FiltroProduto{Marca: "samsung"}).Return(&res, nil)
+			},
+		},
+		"deve buscar as variações da página de uma vez": {
+			Query:          `{ produtos(limite: 2) { itens { codigo variacoes { sku } } } }`,
+			ExpectedStatus: http.StatusOK,
+			ExpectedData:   `{"produtos":{"itens":[{"codigo":"p1","variacoes":[{"sku":"p1-azul"}]},{"codigo":"p2","variacoes":[]}]}}`,
+			PrepareMock: func(p *mocks.IProdutoApp, v *mocks.IVariacaoApp) {
+				p.On("GetProdutos", mock.Anything).Return(&res, nil)
+				v.On("GetVariacoesByProdutos", mock.Anything, []string{"p1", "p2"}).Return(map[string][]model.Variacao{"p1": {{SKU: "p1-azul"}}, "p2": {}}, nil).Once()
+			},
+		},
+		"deve buscar as variações selecionadas por fragmento": {
+			Query:          `{ produtos(limite: 1) { ...pagina } } fragment pagina on ProdutoPagina { itens { ... on Produto { variacoes { sku } } } }`,
+			ExpectedStatus: http.StatusOK,
+			ExpectedData:   `{"produtos":{"itens":[{"variacoes":[{"sku":"p1-azul"}]}]}}`,
+			PrepareMock: func(p *mocks.IProdutoApp, v *mocks.IVariacaoApp) {
+				p.On("GetProdutos", mock.Anything).Return(&res, nil)
+				v.On("GetVariacoesByProdutos", mock.Anything, []string{"p1"}).Return(map[string][]model.Variacao{"p1": {{SKU: "p1-azul"}}}, nil).Once()
+			},
+		},
+		"deve retornar o produto por codigo": {
+			Query:          `{ produto(codigo: "p3") { nome estoque_disponivel } }`,
+			ExpectedStatus: http.StatusOK,
+			ExpectedData:   `{"produto":{"nome":"Geladeira","estoque_disponivel":5}}`,
+			PrepareMock: func(p *mocks.IProdutoApp, v *mocks.IVariacaoApp) {
+				p.On("GetProdutoByCodigo", mock.Anything, "p3").Return(&res[2], nil)
+			},
+		},
+		"deve retornar null com o produto inexistente": {
+			Query:          `{ produto(codigo: "xpto") { nome } }`,
+			ExpectedStatus: http.StatusOK,
+			ExpectedData:   `{"produto":null}`,
+			PrepareMock: func(p *mocks.IProdutoApp, v *mocks.IVariacaoApp) {
+				p.On("GetProdutoByCodigo", mock.Anything, "xpto").Return(&model.Produto{}, nil)
+			},
+		},
+		"deve retornar o erro do serviço": {
+			Query:          `{ produto(codigo: "p1") { nome } }`,
+			ExpectedStatus: http.StatusOK,
+			ExpectedErr:    "ocorreu um erro",
+			PrepareMock: func(p *mocks.IProdutoApp, v *mocks.IVariacaoApp) {
+				p.On("GetProdutoByCodigo", mock.Anything, "p1").Return(nil, erro)
+			},
+		},
+		"deve rejeitar o limite acima do máximo": {
+			Query:          `{ produtos(limite: 500) { total } }`,
+			ExpectedStatus: http.StatusOK,
+			ExpectedErr:    "limite deve ser um número entre 1 e 100",
+			PrepareMock:    func(p *mocks.IProdutoApp, v *mocks.IVariacaoApp) {},
+		},
+		"deve rejeitar a consulta inválida": {
+			Query:          `{ produtos { preco } }`,
+			ExpectedStatus: http.StatusBadRequest,
+			ExpectedErr:    `Cannot query field "preco" on type "ProdutoPagina".`,
+			PrepareMock:    func(p *mocks.IProdutoApp, v *mocks.IVariacaoApp) {},
+		},
+		"deve rejeitar a consulta profunda": {
+			Query:          `{ produtos { itens { variacoes { sku } } } }`,
+			ExpectedStatus: http.StatusBadRequest,
+			Limites:        &Limites{Profundidade: 3},
+			ExpectedErr:    "consulta com profundidade 4 excede o máximo de 3",
+			PrepareMock:    func(p *mocks.IProdutoApp, v *mocks.IVariacaoApp) {},
+		},
+		"deve rejeitar a consulta complexa": {
+			Query:          `query ($limite: Int) { produtos(limite: $limite) { itens { ...campos } } } fragment campos on Produto { codigo nome preco_de preco_por }`,
+			Variables:      `{"limite": 100}`,
+			ExpectedStatus: http.StatusBadRequest,
+			ExpectedErr:    "consulta com complexidade 501 excede o máximo de 300",
+			PrepareMock:    func(p *mocks.IProdutoApp, v *mocks.IVariacaoApp) {},
+		},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			produto := new(mocks.IProdutoApp)
+			variacao := new(mocks.IVariacaoApp)
+			cs.PrepareMock(produto, variacao)
+
+			e := echo.New()
+			limites := Limites{Profundidade: 4, Complexidade: 300}
+			if cs.Limites != nil {
+				limites = *cs.Limites
+			}
+			Register(e.Group("/graphql"), &app.Container{Produto: produto, Variacao: variacao}, limites, nil)
+
+			body := `{"query": ` + quote(cs.Query)
+			if cs.Variables != "" {
+				body += `, "variables": ` + cs.Variables
+			}
+			body += `}`
+
+			req := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, cs.ExpectedStatus, rec.Code)
+
+			var resp resposta
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+
+			if cs.ExpectedErr != "" {
+				if assert.NotEmpty(t, resp.Errors) {
+					assert.Equal(t, cs.ExpectedErr, resp.Errors[0].Message)
+				}
+			} else {
+				assert.Empty(t, resp.Errors)
+				assert.JSONEq(t, cs.ExpectedData, string(resp.Data))
+			}
+
+			produto.AssertExpectations(t)
+			variacao.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_consultarGet(t *testing.T) {
+	produto := new(mocks.IProdutoApp)
+	produto.On("GetProdutoByCodigo", mock.Anything, "p1").Return(&res[0], nil)
+
+	e := echo.New()
+	Register(e.Group("/graphql"), &app.Container{Produto: produto}, Limites{}, nil)
+
+	q := url.Values{}
+	q.Set("query", `query ($codigo: String!) { produto(codigo: $codigo) { codigo variacoes { sku } } }`)
+	q.Set("variables", `{"codigo": "p1"}`)
+
+	req := httptest.NewRequest(http.MethodGet, "/graphql?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	// sem o serviço de variações a lista fica vazia
+	assert.JSONEq(t, `{"data":{"produto":{"codigo":"p1","variacoes":[]}}}`, rec.Body.String())
+}
+
+func quote(s string) string {
+	b, _ := json.Marshal(s)
+	return string(b)
+}
+
+func Test_RegisterLeitura(t *testing.T) {
+	limiter := ratelimit.New(ratelimit.Options{
+		Read:  ratelimit.Limit{Rate: 1, Burst: 1},
+		Write: ratelimit.Limit{Rate: 1, Burst: 10},
+	})
+
+	e := echo.New()
+	e.Use(limiter.Middleware())
+	// mesmo agrupamento do main, com o prefixo sem a barra
+	Register(e.Group("").Group("graphql"), &app.Container{}, Limites{}, limiter)
+
+	// o POST consome o limite de leitura, então a segunda consulta é bloqueada
+	for _, expected := range []int{http.StatusOK, http.StatusTooManyRequests} {
+		req := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(`{"query": "{ __typename }"}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, expected, rec.Code)
+	}
+}
@@ -0,0 +1,124 @@
+package graphql
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// verificar rejeita a operação que excede os limites. A profundidade conta os
+// campos aninhados e a complexidade soma um por campo, multiplicando os campos
+// abaixo de uma listagem pelo seu limite. Os campos de introspecção não contam
+func (l Limites) verificar(doc *ast.Document, operacao string, variaveis map[string]interface{}) error {
+	fragmentos := map[string]*ast.FragmentDefinition{}
+	for _, def := range doc.Definitions {
+		if f, ok := def.(*ast.FragmentDefinition); ok {
+			fragmentos[f.Name.Value] = f
+		}
+	}
+
+	for _, def := range doc.Definitions {
+		op, ok := def.(*ast.OperationDefinition)
+		if !ok || (operacao != "" && (op.Name == nil || op.Name.Value != operacao)) {
+			continue
+		}
+
+		c := &custo{fragmentos: fragmentos, variaveis: variaveis}
+		complexidade := c.selecao(op.SelectionSet, 1, 1)
+
+		if l.Profundidade > 0 && c.profundidade > l.Profundidade {
+			return fmt.Errorf("consulta com profundidade %d excede o máximo de %d", c.profundidade, l.Profundidade)
+		}
+		if l.Complexidade > 0 && complexidade > l.Complexidade {
+			return fmt.Errorf("consulta com complexidade %d excede o máximo de %d", complexidade, l.Complexidade)
+		}
+	}
+
+	return nil
+}
+
+type custo struct {
+	fragmentos   map[string]*ast.FragmentDefinition
+	variaveis    map[string]interface{}
+	profundidade int
+}
+
+// selecao retorna a complexidade da seleção, com os campos no nivel informado
+// e repetidos pelo multiplicador das listagens acima deles
+func (c *custo) selecao(set *ast.SelectionSet, nivel, multiplicador int) int {
+	if set == nil {
+		return 0
+	}
+
+	total := 0
+	for _, sel := range set.Selections {
+		switch s := sel.(type) {
+		case *ast.Field:
+			if strings.HasPrefix(s.Name.Value, "__") {
+				continue
+			}
+
+			if nivel > c.profundidade {
+				c.profundidade = nivel
+			}
+
+			total += multiplicador + c.selecao(s.SelectionSet, nivel+1, multiplicador*c.limite(s))
+		case *ast.InlineFragment:
+			total += c.selecao(s.SelectionSet, nivel, multiplicador)
+		case *ast.FragmentSpread:
+			// os ciclos entre fragmentos já foram rejeitados na validação
+			if f, ok := c.fragmentos[s.Name.Value]; ok {
+				total += c.selecao(f.SelectionSet, nivel, multiplicador)
+			}
+		}
+	}
+
+	return total
+}
+
+// listagens campos paginados pelo argumento limite
+var listagens = map[string]bool{"produtos": true}
+
+// limite retorna o número de itens que o campo pode retornar, pelo argumento limite
+func (c *custo) limite(field *ast.Field) int {
+	if !listagens[field.Name.Value] {
+		return 1
+	}
+
+	for _, arg := range field.Arguments {
+		if arg.Name.Value != "limite" {
+			continue
+		}
+
+		var valor interface{}
+		switch v := arg.Value.(type) {
+		case *ast.IntValue:
+			valor = v.Value
+		case *ast.Variable:
+			valor = c.variaveis[v.Name.Value]
+		}
+
+		n := 0
+		switch v := valor.(type) {
+		case string:
+			n, _ = strconv.Atoi(v)
+		case float64:
+			n = int(v)
+		case int:
+			n = v
+		}
+
+		// o limite fora da faixa é rejeitado pela listagem com a mensagem própria
+		if n > 0 && n <= limiteMaximo {
+			return n
+		}
+		if n > limiteMaximo {
+			return limiteMaximo
+		}
+		break
+	}
+
+	return limitePadrao
+}
@@ -0,0 +1,274 @@
+package graphql
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/GianGoulart/CrudProdutos/app"
+	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+const (
+	limitePadrao = 20
+	limiteMaximo = 100
+)
+
+// Os campos usam os mesmos nomes do json da api http e são resolvidos pelas tags
+// json dos modelos, exceto os calculados
+var variacaoType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "Variacao",
+	Fields: graphql.Fields{
+		"sku":                &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+		"produto":            &graphql.Field{Type: graphql.String},
+		"tamanho":            &graphql.Field{Type: graphql.String},
+		"cor":                &graphql.Field{Type: graphql.String},
+		"voltagem":           &graphql.Field{Type: graphql.String},
+		"preco_de":           &graphql.Field{Type: graphql.Float},
+		"preco_por":          &graphql.Field{Type: graphql.Float},
+		"estoque_total":      &graphql.Field{Type: graphql.Int},
+		"estoque_corte":      &graphql.Field{Type: graphql.Int},
+		"estoque_disponivel": &graphql.Field{Type: graphql.Int},
+		"criado_em":          &graphql.Field{Type: graphql.String},
+		"ultima_alteracao":   &graphql.Field{Type: graphql.String},
+	},
+})
+
+var promocaoAplicadaType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "PromocaoAplicada",
+	Fields: graphql.Fields{
+		"codigo":   &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+		"nome":     &graphql.Field{Type: graphql.String},
+		"desconto": &graphql.Field{Type: graphql.Float, Description: "valor descontado pela promoção"},
+	},
+})
+
+var filtroType = graphql.NewInputObject(graphql.InputObjectConfig{
+	Name: "FiltroProduto",
+	Fields: graphql.InputObjectConfigFieldMap{
+		"categoria":  &graphql.InputObjectFieldConfig{Type: graphql.String, Description: "inclui as categorias descendentes"},
+		"marca":      &graphql.InputObjectFieldConfig{Type: graphql.String},
+		"fornecedor": &graphql.InputObjectFieldConfig{Type: graphql.String},
+		"nome":       &graphql.InputObjectFieldConfig{Type: graphql.String, Description: "parte do nome, ignorando a caixa"},
+		"preco_min":  &graphql.InputObjectFieldConfig{Type: graphql.Float, Description: "preço por mínimo"},
+		"preco_max":  &graphql.InputObjectFieldConfig{Type: graphql.Float, Description: "preço por máximo"},
+		"em_estoque": &graphql.InputObjectFieldConfig{Type: graphql.Boolean, Description: "apenas com estoque disponível"},
+	},
+})
+
+// newSchema monta o schema das consultas de produto sobre os serviços
+func newSchema(apps *app.Container) (graphql.Schema, error) {
+	produtoType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Produto",
+		Fields: graphql.Fields{
+			"codigo":             &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"nome":               &graphql.Field{Type: graphql.String},
+			"marca":              &graphql.Field{Type: graphql.String},
+			"preco_de":           &graphql.Field{Type: graphql.Float},
+			"preco_por":          &graphql.Field{Type: graphql.Float},
+			"desconto":           &graphql.Field{Type: graphql.Float, Description: "percentual do preço por sobre o preço de", Resolve: resolveDesconto},
+			"preco_promocional":  &graphql.Field{Type: graphql.Float, Description: "preço por com as promoções vigentes"},
+			"promocoes":          &graphql.Field{Type: graphql.NewList(graphql.NewNonNull(promocaoAplicadaType))},
+			"estoque_total":      &graphql.Field{Type: graphql.Int},
+			"estoque_corte":      &graphql.Field{Type: graphql.Int},
+			"estoque_disponivel": &graphql.Field{Type: graphql.Int},
+			"limite_alerta":      &graphql.Field{Type: graphql.Int},
+			"criado_em":          &graphql.Field{Type: graphql.String},
+			"ultima_alteracao":   &graphql.Field{Type: graphql.String},
+			"variacoes": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(variacaoType))),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					produto := p.Source.(model.Produto)
+					// a consulta por codigo já traz as variações e a listagem as carrega
+					// de uma vez para a página
+					if produto.Variacoes != nil || apps.Variacao == nil {
+						return produto.Variacoes, nil
+					}
+
+					variacoes, err := apps.Variacao.GetVariacoes(p.Context, produto.Codigo)
+					if err != nil {
+						return nil, err
+					}
+					return *variacoes, nil
+				},
+			},
+		},
+	})
+
+	paginaType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "ProdutoPagina",
+		Fields: graphql.Fields{
+			"total":  &graphql.Field{Type: graphql.NewNonNull(graphql.Int), Description: "total de produtos que atendem ao filtro"},
+			"limite": &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"offset": &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"itens":  &graphql.Field{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(produtoType)))},
+		},
+	})
+
+	query := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"produto": &graphql.Field{
+				Type: produtoType,
+				Args: graphql.FieldConfigArgument{
+					"codigo": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					produto, err := apps.Produto.GetProdutoByCodigo(p.Context, p.Args["codigo"].(string))
+					if err != nil {
+						return nil, err
+					}
+					if produto == nil || produto.Codigo == "" {
+						return nil, nil
+					}
+					return *produto, nil
+				},
+			},
+			"produtos": &graphql.Field{
+				Type: graphql.NewNonNull(paginaType),
+				Args: graphql.FieldConfigArgument{
+					"filtro": &graphql.ArgumentConfig{Type: filtroType},
+					"limite": &graphql.ArgumentConfig{Type: graphql.Int, DefaultValue: limitePadrao},
+					"offset": &graphql.ArgumentConfig{Type: graphql.Int, DefaultValue: 0},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return listar(p, apps)
+				},
+			},
+		},
+	})
+
+	return graphql.NewSchema(graphql.SchemaConfig{Query: query})
+}
+
+// pagina resultado paginado da listagem de produtos
+type pagina struct {
+	Total  int             `json:"total"`
+	Limite int             `json:"limite"`
+	Offset int             `json:"offset"`
+	Itens  []model.Produto `json:"itens"`
+}
+
+// listar aplica os filtros da api http pelo serviço e os demais sobre o resultado
+func listar(p graphql.ResolveParams, apps *app.Container) (interface{}, error) {
+	limite, _ := p.Args["limite"].(int)
+	offset, _ := p.Args["offset"].(int)
+	if limite <= 0 || limite > limiteMaximo {
+		return nil, fmt.Errorf("limite deve ser um número entre 1 e %d", limiteMaximo)
+	}
+	if offset < 0 {
+		return nil, fmt.Errorf("offset não pode ser negativo")
+	}
+
+	args, _ := p.Args["filtro"].(map[string]interface{})
+	filtro := model.FiltroProduto{}
+	filtro.Categoria, _ = args["categoria"].(string)
+	filtro.Marca, _ = args["marca"].(string)
+	filtro.Fornecedor, _ = args["fornecedor"].(string)
+
+	var produtos *[]model.Produto
+	var err error
+	if filtro.Vazio() {
+		produtos, err = apps.Produto.GetProdutos(p.Context)
+	} else {
+		produtos, err = apps.Produto.FiltrarProdutos(p.Context, filtro)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	nome, _ := args["nome"].(string)
+	nome = strings.ToLower(nome)
+	precoMin, temMin := args["preco_min"].(float64)
+	precoMax, temMax := args["preco_max"].(float64)
+	emEstoque, _ := args["em_estoque"].(bool)
+
+	filtrados := make([]model.Produto, 0, len(*produtos))
+	for _, produto := range *produtos {
+		if nome != "" && !strings.Contains(strings.ToLower(produto.Nome), nome) {
+			continue
+		}
+		if (temMin && produto.PrecoPor < precoMin) || (temMax && produto.PrecoPor > precoMax) {
+			continue
+		}
+		if emEstoque && produto.EstoqueDisponivel <= 0 {
+			continue
+		}
+		filtrados = append(filtrados, produto)
+	}
+
+	res := pagina{Total: len(filtrados), Limite: limite, Offset: offset, Itens: []model.Produto{}}
+	if offset < len(filtrados) {
+		fim := offset + limite
+		if fim > len(filtrados) {
+			fim = len(filtrados)
+		}
+		res.Itens = filtrados[offset:fim]
+	}
+
+	// carrega as variações da página em uma única consulta, em vez de uma por produto
+	if apps.Variacao != nil && len(res.Itens) > 0 && selecionado(p, "itens", "variacoes") {
+		codigos := make([]string, 0, len(res.Itens))
+		for _, produto := range res.Itens {
+			codigos = append(codigos, produto.Codigo)
+		}
+
+		variacoes, err := apps.Variacao.GetVariacoesByProdutos(p.Context, codigos)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range res.Itens {
+			res.Itens[i].Variacoes = variacoes[res.Itens[i].Codigo]
+		}
+	}
+
+	return res, nil
+}
+
+// selecionado indica se a consulta seleciona o caminho de campos abaixo do campo
+// sendo resolvido, considerando os fragmentos
+func selecionado(p graphql.ResolveParams, caminho ...string) bool {
+	for _, field := range p.Info.FieldASTs {
+		if contem(field.SelectionSet, p.Info.Fragments, caminho) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func contem(set *ast.SelectionSet, fragmentos map[string]ast.Definition, caminho []string) bool {
+	if set == nil {
+		return false
+	}
+
+	for _, sel := range set.Selections {
+		switch s := sel.(type) {
+		case *ast.Field:
+			if s.Name.Value == caminho[0] && (len(caminho) == 1 || contem(s.SelectionSet, fragmentos, caminho[1:])) {
+				return true
+			}
+		case *ast.InlineFragment:
+			if contem(s.SelectionSet, fragmentos, caminho) {
+				return true
+			}
+		case *ast.FragmentSpread:
+			if f, ok := fragmentos[s.Name.Value].(*ast.FragmentDefinition); ok && contem(f.SelectionSet, fragmentos, caminho) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func resolveDesconto(p graphql.ResolveParams) (interface{}, error) {
+	produto := p.Source.(model.Produto)
+	if produto.PrecoDe <= 0 {
+		return 0.0, nil
+	}
+
+	return (produto.PrecoDe - produto.PrecoPor) / produto.PrecoDe * 100, nil
+}
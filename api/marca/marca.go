@@ -0,0 +1,157 @@
+package marca
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/GianGoulart/CrudProdutos/app"
+	marcaApp "github.com/GianGoulart/CrudProdutos/app/marca"
+	"github.com/GianGoulart/CrudProdutos/logger"
+	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/labstack/echo/v4"
+)
+
+// Register group item check
+func Register(g *echo.Group, apps *app.Container) {
+	h := &handler{
+		apps: apps,
+	}
+
+	g.GET("", h.getMarcas)
+	g.GET("/:codigo", h.getMarcaByCodigo)
+	g.POST("", h.createMarca)
+	g.PUT("", h.updateMarca)
+	g.DELETE("/:codigo", h.deleteMarca)
+}
+
+type handler struct {
+	apps *app.Container
+}
+
+func (h *handler) getMarcas(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	resp, err := h.apps.Marca.GetMarcas(ctx)
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).Error("api.marca.getMarcas")
+		return c.JSON(http.StatusInternalServerError, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.Response{
+		Data: resp,
+	})
+}
+
+func (h *handler) getMarcaByCodigo(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	resp, err := h.apps.Marca.GetMarcaByCodigo(ctx, c.Param("codigo"))
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).Error("api.marca.getMarcaByCodigo")
+		return c.JSON(http.StatusInternalServerError, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+
+	if resp.Codigo == "" {
+		return c.JSON(http.StatusNotFound, model.Response{
+			Data: nil,
+			Err:  marcaApp.ErrMarcaNaoEncontrada.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.Response{
+		Data: resp,
+	})
+}
+
+func (h *handler) createMarca(c echo.Context) error {
+	ctx := c.Request().Context()
+	payload := new(model.Marca)
+
+	if err := c.Bind(payload); err != nil {
+		logger.FromContext(ctx).WithError(err).Warn("api.marca.createMarca")
+		return c.JSON(http.StatusBadRequest, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+
+	response, err := h.apps.Marca.CreateMarca(ctx, payload)
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).Error("api.marca.createMarca")
+		return c.JSON(http.StatusBadRequest, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.Response{
+		Data: response,
+	})
+}
+
+func (h *handler) updateMarca(c echo.Context) error {
+	ctx := c.Request().Context()
+	payload := new(model.Marca)
+
+	if err := c.Bind(payload); err != nil {
+		logger.FromContext(ctx).WithError(err).Warn("api.marca.updateMarca")
+		return c.JSON(http.StatusBadRequest, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+
+	response, err := h.apps.Marca.UpdateMarca(ctx, payload)
+	if errors.Is(err, marcaApp.ErrMarcaNaoEncontrada) {
+		return c.JSON(http.StatusNotFound, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).Error("api.marca.updateMarca")
+		return c.JSON(http.StatusBadRequest, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.Response{
+		Data: response,
+	})
+}
+
+func (h *handler) deleteMarca(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	resp, err := h.apps.Marca.DeleteMarca(ctx, c.Param("codigo"))
+	if errors.Is(err, marcaApp.ErrMarcaNaoEncontrada) {
+		return c.JSON(http.StatusNotFound, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+	if errors.Is(err, marcaApp.ErrMarcaEmUso) {
+		return c.JSON(http.StatusConflict, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).Error("api.marca.deleteMarca")
+		return c.JSON(http.StatusBadRequest, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.Response{
+		Data: resp,
+	})
+}
@@ -0,0 +1,119 @@
+package marca
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/GianGoulart/CrudProdutos/app"
+	marcaApp "github.com/GianGoulart/CrudProdutos/app/marca"
+	"github.com/GianGoulart/CrudProdutos/mocks"
+	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	erro = errors.New("ocorreu um erro")
+
+	res = []model.Marca{
+		{Codigo: "sony", Nome: "Sony"},
+		{Codigo: "lg", Nome: "LG"},
+	}
+)
+
+func Test_getMarcaByCodigo(t *testing.T) {
+	e := echo.New()
+	ctx := context.Background()
+
+	cases := map[string]struct {
+		ExpectedData int
+
+		PrepareMock func(mock *mocks.IMarcaApp)
+	}{
+		"deve retornar sucesso": {ExpectedData: http.StatusOK, PrepareMock: func(mock *mocks.IMarcaApp) {
+			mock.On("GetMarcaByCodigo", ctx, "lg").Return(&res[1], nil)
+		}},
+		"deve retornar not found": {ExpectedData: http.StatusNotFound, PrepareMock: func(mock *mocks.IMarcaApp) {
+			mock.On("GetMarcaByCodigo", ctx, "lg").Return(&model.Marca{}, nil)
+		}},
+		"deve retornar erro com a mensagem: ocorreu um erro": {ExpectedData: http.StatusInternalServerError, PrepareMock: func(mock *mocks.IMarcaApp) {
+			mock.On("GetMarcaByCodigo", ctx, "lg").Return(nil, erro)
+		}},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			mock := new(mocks.IMarcaApp)
+
+			cs.PrepareMock(mock)
+
+			request, err := http.NewRequest(http.MethodGet, "/marcas/lg", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rr := httptest.NewRecorder()
+			h := handler{
+				apps: &app.Container{Marca: mock},
+			}
+
+			c := e.NewContext(request, rr)
+			c.SetParamNames("codigo")
+			c.SetParamValues("lg")
+
+			if assert.NoError(t, h.getMarcaByCodigo(c)) {
+				assert.Equal(t, cs.ExpectedData, rr.Code)
+			}
+		})
+	}
+}
+
+func Test_deleteMarca(t *testing.T) {
+	e := echo.New()
+	ctx := context.Background()
+
+	cases := map[string]struct {
+		ExpectedData int
+
+		PrepareMock func(mock *mocks.IMarcaApp)
+	}{
+		"deve retornar sucesso": {ExpectedData: http.StatusOK, PrepareMock: func(mock *mocks.IMarcaApp) {
+			mock.On("DeleteMarca", ctx, "sony").Return(&res[0], nil)
+		}},
+		"deve retornar conflito com produtos associados": {ExpectedData: http.StatusConflict, PrepareMock: func(mock *mocks.IMarcaApp) {
+			mock.On("DeleteMarca", ctx, "sony").Return(nil, marcaApp.ErrMarcaEmUso)
+		}},
+		"deve retornar not found": {ExpectedData: http.StatusNotFound, PrepareMock: func(mock *mocks.IMarcaApp) {
+			mock.On("DeleteMarca", ctx, "sony").Return(nil, marcaApp.ErrMarcaNaoEncontrada)
+		}},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			mock := new(mocks.IMarcaApp)
+
+			cs.PrepareMock(mock)
+
+			request, err := http.NewRequest(http.MethodDelete, "/marcas/sony", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rr := httptest.NewRecorder()
+			h := handler{
+				apps: &app.Container{Marca: mock},
+			}
+
+			c := e.NewContext(request, rr)
+			c.SetParamNames("codigo")
+			c.SetParamValues("sony")
+
+			if assert.NoError(t, h.deleteMarca(c)) {
+				assert.Equal(t, cs.ExpectedData, rr.Code)
+			}
+		})
+	}
+}
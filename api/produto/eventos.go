@@ -0,0 +1,118 @@
+package produto
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	produtoApp "github.com/GianGoulart/CrudProdutos/app/produto"
+	"github.com/GianGoulart/CrudProdutos/logger"
+	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/GianGoulart/CrudProdutos/stream"
+	"github.com/labstack/echo/v4"
+)
+
+// heartbeat intervalo dos comentários enviados para manter a conexão aberta em proxies
+var heartbeat = 15 * time.Second
+
+// streamEventos envia os eventos de produto como Server-Sent Events. O cliente
+// retoma o stream pelo header Last-Event-ID, ou pelo parâmetro last_event_id, e
+// recebe o evento reiniciar quando parte dos eventos perdidos não está mais
+// disponível e os dados devem ser recarregados
+func (h *handler) streamEventos(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	filtro := stream.Filtro{
+		Codigos: listaQuery(c, "codigo"),
+		Tipos:   listaQuery(c, "tipo"),
+	}
+
+	for _, tipo := range filtro.Tipos {
+		if !eventoValido(tipo) {
+			return c.JSON(http.StatusBadRequest, model.Response{
+				Data: nil,
+				Err:  fmt.Sprintf("evento não suportado: %s", tipo),
+			})
+		}
+	}
+
+	ultimoID := c.Request().Header.Get("Last-Event-ID")
+	if ultimoID == "" {
+		ultimoID = c.QueryParam("last_event_id")
+	}
+
+	assinatura, completo, err := h.apps.Produto.AssinarEventos(ctx, filtro, ultimoID)
+	if errors.Is(err, produtoApp.ErrStreamDesabilitado) {
+		return c.JSON(http.StatusNotFound, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).Error("api.produto.streamEventos")
+		return c.JSON(http.StatusInternalServerError, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+	defer assinatura.Close()
+
+	res := c.Response()
+	res.Header().Set(echo.HeaderContentType, "text/event-stream")
+	res.Header().Set("Cache-Control", "no-cache")
+	res.Header().Set("Connection", "keep-alive")
+	// desliga o buffer do nginx, que seguraria os eventos
+	res.Header().Set("X-Accel-Buffering", "no")
+	res.WriteHeader(http.StatusOK)
+
+	if !completo {
+		fmt.Fprint(res, "event: reiniciar\ndata: {}\n\n")
+	}
+	res.Flush()
+
+	ticker := time.NewTicker(heartbeat)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			fmt.Fprint(res, ": ping\n\n")
+		case evento, ok := <-assinatura.Eventos:
+			// canal fechado: o cliente não acompanhou os eventos e deve se reconectar
+			if !ok {
+				return nil
+			}
+			fmt.Fprintf(res, "id: %s\nevent: %s\ndata: %s\n\n", evento.ID, evento.Tipo, evento.Dados)
+		}
+
+		res.Flush()
+	}
+}
+
+// listaQuery junta os valores do parâmetro, repetido ou separado por vírgula
+func listaQuery(c echo.Context, nome string) []string {
+	valores := []string{}
+	for _, param := range c.QueryParams()[nome] {
+		for _, valor := range strings.Split(param, ",") {
+			if valor = strings.TrimSpace(valor); valor != "" {
+				valores = append(valores, valor)
+			}
+		}
+	}
+
+	return valores
+}
+
+func eventoValido(tipo string) bool {
+	for _, evento := range model.Eventos {
+		if evento == tipo {
+			return true
+		}
+	}
+
+	return false
+}
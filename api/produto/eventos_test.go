@@ -0,0 +1,93 @@
+package produto
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/GianGoulart/CrudProdutos/app"
+	produtoApp "github.com/GianGoulart/CrudProdutos/app/produto"
+	"github.com/GianGoulart/CrudProdutos/mocks"
+	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/GianGoulart/CrudProdutos/stream"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_streamEventos(t *testing.T) {
+	e := echo.New()
+	ctx := context.Background()
+
+	// assinatura com dois eventos pendentes, encerrada para que o handler retorne
+	assinatura := func(context.Context, stream.Filtro, string) *stream.Assinatura {
+		h := stream.New(10, 10)
+		a, _ := h.Assinar(stream.Filtro{}, "")
+		h.Publicar(model.EventoProdutoCriado, &model.Produto{Codigo: "p1"})
+		h.Publicar(model.EventoEstoqueAlterado, &model.Produto{Codigo: "p1"})
+		a.Close()
+		return a
+	}
+
+	cases := map[string]struct {
+		InputQuery     string
+		InputHeader    string
+		ExpectedData   int
+		ExpectedBody   []string
+		ExpectedNoBody []string
+
+		PrepareMock func(mock *mocks.IProdutoApp)
+	}{
+		"deve enviar os eventos com os filtros": {InputQuery: "codigo=p1,p2&tipo=produto.criado&tipo=estoque.alterado", InputHeader: "abc-1", ExpectedData: http.StatusOK,
+			ExpectedBody:   []string{"event: produto.criado\ndata: {", "event: estoque.alterado\n", "id: "},
+			ExpectedNoBody: []string{"event: reiniciar"},
+			PrepareMock: func(mock *mocks.IProdutoApp) {
+				filtro := stream.Filtro{Codigos: []string{"p1", "p2"}, Tipos: []string{model.EventoProdutoCriado, model.EventoEstoqueAlterado}}
+				mock.On("AssinarEventos", ctx, filtro, "abc-1").Return(assinatura, true, nil)
+			}},
+		"deve pedir para recarregar quando faltam eventos": {InputQuery: "last_event_id=abc-1", ExpectedData: http.StatusOK,
+			ExpectedBody: []string{"event: reiniciar\n"},
+			PrepareMock: func(mock *mocks.IProdutoApp) {
+				mock.On("AssinarEventos", ctx, stream.Filtro{Codigos: []string{}, Tipos: []string{}}, "abc-1").Return(assinatura, false, nil)
+			}},
+		"deve retornar erro com o tipo inválido": {InputQuery: "tipo=xpto", ExpectedData: http.StatusBadRequest, PrepareMock: func(mock *mocks.IProdutoApp) {}},
+		"deve retornar not found com o stream desabilitado": {ExpectedData: http.StatusNotFound, PrepareMock: func(mock *mocks.IProdutoApp) {
+			mock.On("AssinarEventos", ctx, stream.Filtro{Codigos: []string{}, Tipos: []string{}}, "").Return(nil, false, produtoApp.ErrStreamDesabilitado)
+		}},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			mock := new(mocks.IProdutoApp)
+
+			cs.PrepareMock(mock)
+
+			request, err := http.NewRequest(http.MethodGet, "/produtos/eventos?"+cs.InputQuery, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if cs.InputHeader != "" {
+				request.Header.Set("Last-Event-ID", cs.InputHeader)
+			}
+
+			rr := httptest.NewRecorder()
+			h := handler{
+				apps: &app.Container{Produto: mock},
+			}
+
+			c := e.NewContext(request, rr)
+
+			if assert.NoError(t, h.streamEventos(c)) {
+				assert.Equal(t, cs.ExpectedData, rr.Code)
+				for _, trecho := range cs.ExpectedBody {
+					assert.Contains(t, rr.Body.String(), trecho)
+				}
+				for _, trecho := range cs.ExpectedNoBody {
+					assert.NotContains(t, rr.Body.String(), trecho)
+				}
+			}
+
+			mock.AssertExpectations(t)
+		})
+	}
+}
@@ -1,36 +1,106 @@
 package produto
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/GianGoulart/CrudProdutos/app"
+	categoriaApp "github.com/GianGoulart/CrudProdutos/app/categoria"
+	depositoApp "github.com/GianGoulart/CrudProdutos/app/deposito"
+	fornecedorApp "github.com/GianGoulart/CrudProdutos/app/fornecedor"
+	produtoApp "github.com/GianGoulart/CrudProdutos/app/produto"
+	variacaoApp "github.com/GianGoulart/CrudProdutos/app/variacao"
+	"github.com/GianGoulart/CrudProdutos/logger"
 	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/GianGoulart/CrudProdutos/ratelimit"
 	"github.com/labstack/echo/v4"
 )
 
-// Register group item check
-func Register(g *echo.Group, apps *app.Container) {
+// Register group item check. A busca por nome é uma consulta por POST, então é
+// declarada como leitura no rate limit, quando houver
+func Register(g *echo.Group, apps *app.Container, idempotency echo.MiddlewareFunc, limiter *ratelimit.Limiter) {
 	h := &handler{
 		apps: apps,
 	}
+
+	idempotent := []echo.MiddlewareFunc{}
+	if idempotency != nil {
+		idempotent = append(idempotent, idempotency)
+	}
+
 	g.GET("", h.getProdutos)
+	g.GET("/cache/stats", h.getCacheStats)
+	g.GET("/busca", h.buscarProdutos)
+	g.GET("/sugestoes", h.getSugestoes)
+	g.GET("/eventos", h.streamEventos)
 	g.GET("/:codigo", h.getProdutoByCodigo)
-	g.POST("/produtosByNome", h.getProdutoByNome)
-	g.POST("", h.createProduto)
+	g.GET("/:codigo/categorias", h.getCategoriasProduto)
+	g.PUT("/:codigo/categorias", h.setCategoriasProduto)
+	g.GET("/:codigo/fornecedores", h.getFornecimentos)
+	g.PUT("/:codigo/fornecedores/:fornecedor", h.saveFornecimento)
+	g.DELETE("/:codigo/fornecedores/:fornecedor", h.deleteFornecimento)
+	g.GET("/:codigo/estoque", h.getEstoque)
+	g.PUT("/:codigo/estoque/:deposito", h.saveEstoque, idempotent...)
+	g.DELETE("/:codigo/estoque/:deposito", h.deleteEstoque, idempotent...)
+	g.GET("/:codigo/variacoes", h.getVariacoes)
+	g.POST("/:codigo/variacoes", h.createVariacao, idempotent...)
+	g.PUT("/:codigo/variacoes/:sku", h.updateVariacao, idempotent...)
+	g.DELETE("/:codigo/variacoes/:sku", h.deleteVariacao)
+	porNome := g.POST("/produtosByNome", h.getProdutoByNome)
+	g.POST("/reajuste/previa", h.preverReajuste)
+	g.POST("/reajuste", h.aplicarReajuste, idempotent...)
+	g.POST("", h.createProduto, idempotent...)
 	g.PUT("", h.updateProduto)
+	g.PATCH("/:codigo", h.patchProduto)
 	g.DELETE("/:codigo", h.deleteProduto)
 
+	if limiter != nil {
+		limiter.Leitura(porNome.Method, porNome.Path)
+	}
 }
 
 type handler struct {
 	apps *app.Container
 }
 
+// paramIgnorarLimites query param com que a requisição pede explicitamente para
+// salvar os preços fora dos limites configurados
+const paramIgnorarLimites = "ignorar_limites"
+
+// contextoEscrita retorna o contexto da requisição que altera os preços, marcado
+// para ignorar os limites de preço quando a requisição pede
+func contextoEscrita(c echo.Context) context.Context {
+	ctx := c.Request().Context()
+	if ignorar, _ := strconv.ParseBool(c.QueryParam(paramIgnorarLimites)); ignorar {
+		ctx = produtoApp.IgnorarLimitesPreco(ctx)
+	}
+
+	return ctx
+}
+
 func (h *handler) getProdutos(c echo.Context) error {
 	ctx := c.Request().Context()
 
-	resp, err := h.apps.Produto.GetProdutos(ctx)
+	filtro := model.FiltroProduto{
+		Categoria:  c.QueryParam("categoria"),
+		Marca:      c.QueryParam("marca"),
+		Fornecedor: c.QueryParam("fornecedor"),
+	}
+
+	var resp *[]model.Produto
+	var err error
+	if filtro.Vazio() {
+		resp, err = h.apps.Produto.GetProdutos(ctx)
+	} else {
+		resp, err = h.apps.Produto.FiltrarProdutos(ctx, filtro)
+	}
 	if err != nil {
+		logger.FromContext(ctx).WithError(err).Error("api.produto.getProdutos")
 		return c.JSON(http.StatusInternalServerError, model.Response{
 			Data: nil,
 			Err:  err.Error(),
@@ -47,6 +117,221 @@ func (h *handler) getProdutoByCodigo(c echo.Context) error {
 
 	resp, err := h.apps.Produto.GetProdutoByCodigo(ctx, c.Param("codigo"))
 	if err != nil {
+		logger.FromContext(ctx).WithError(err).Error("api.produto.getProdutoByCodigo")
+		return c.JSON(http.StatusInternalServerError, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.Response{
+		Data: resp,
+	})
+}
+
+// categoriasPayload corpo da associação de categorias a um produto
+type categoriasPayload struct {
+	Categorias []string `json:"categorias"`
+}
+
+func (h *handler) getCategoriasProduto(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	resp, err := h.apps.Categoria.GetCategoriasProduto(ctx, c.Param("codigo"))
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).Error("api.produto.getCategoriasProduto")
+		return c.JSON(http.StatusInternalServerError, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.Response{
+		Data: resp,
+	})
+}
+
+func (h *handler) setCategoriasProduto(c echo.Context) error {
+	ctx := c.Request().Context()
+	payload := new(categoriasPayload)
+
+	if err := c.Bind(payload); err != nil {
+		logger.FromContext(ctx).WithError(err).Warn("api.produto.setCategoriasProduto")
+		return c.JSON(http.StatusBadRequest, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+
+	resp, err := h.apps.Categoria.SetCategoriasProduto(ctx, c.Param("codigo"), payload.Categorias)
+	if errors.Is(err, categoriaApp.ErrProdutoNaoEncontrado) {
+		return c.JSON(http.StatusNotFound, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).Error("api.produto.setCategoriasProduto")
+		return c.JSON(http.StatusBadRequest, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.Response{
+		Data: resp,
+	})
+}
+
+func (h *handler) getFornecimentos(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	resp, err := h.apps.Fornecedor.GetFornecimentos(ctx, c.Param("codigo"))
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).Error("api.produto.getFornecimentos")
+		return c.JSON(http.StatusInternalServerError, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.Response{
+		Data: resp,
+	})
+}
+
+func (h *handler) saveFornecimento(c echo.Context) error {
+	ctx := c.Request().Context()
+	payload := new(model.ProdutoFornecedor)
+
+	if err := c.Bind(payload); err != nil {
+		logger.FromContext(ctx).WithError(err).Warn("api.produto.saveFornecimento")
+		return c.JSON(http.StatusBadRequest, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+
+	// o produto e o fornecedor vêm sempre da rota
+	payload.ProdutoCodigo = c.Param("codigo")
+	payload.FornecedorCodigo = c.Param("fornecedor")
+
+	resp, err := h.apps.Fornecedor.SaveFornecimento(ctx, payload)
+	if errors.Is(err, fornecedorApp.ErrProdutoNaoEncontrado) || errors.Is(err, fornecedorApp.ErrFornecedorNaoEncontrado) {
+		return c.JSON(http.StatusNotFound, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).Error("api.produto.saveFornecimento")
+		return c.JSON(http.StatusBadRequest, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.Response{
+		Data: resp,
+	})
+}
+
+func (h *handler) deleteFornecimento(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	if err := h.apps.Fornecedor.DeleteFornecimento(ctx, c.Param("codigo"), c.Param("fornecedor")); err != nil {
+		logger.FromContext(ctx).WithError(err).Error("api.produto.deleteFornecimento")
+		return c.JSON(http.StatusBadRequest, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.Response{
+		Data: nil,
+	})
+}
+
+func (h *handler) getEstoque(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	resp, err := h.apps.Deposito.GetEstoque(ctx, c.Param("codigo"))
+	if errors.Is(err, depositoApp.ErrProdutoNaoEncontrado) {
+		return c.JSON(http.StatusNotFound, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).Error("api.produto.getEstoque")
+		return c.JSON(http.StatusInternalServerError, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.Response{
+		Data: resp,
+	})
+}
+
+func (h *handler) saveEstoque(c echo.Context) error {
+	ctx := c.Request().Context()
+	payload := new(model.EstoqueDeposito)
+
+	if err := c.Bind(payload); err != nil {
+		logger.FromContext(ctx).WithError(err).Warn("api.produto.saveEstoque")
+		return c.JSON(http.StatusBadRequest, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+
+	// o produto e o depósito vêm sempre da rota
+	payload.ProdutoCodigo = c.Param("codigo")
+	payload.DepositoCodigo = c.Param("deposito")
+
+	resp, err := h.apps.Deposito.SaveEstoque(ctx, payload)
+	return h.estoqueResponse(c, "api.produto.saveEstoque", resp, err)
+}
+
+func (h *handler) deleteEstoque(c echo.Context) error {
+	resp, err := h.apps.Deposito.DeleteEstoque(c.Request().Context(), c.Param("codigo"), c.Param("deposito"))
+	return h.estoqueResponse(c, "api.produto.deleteEstoque", resp, err)
+}
+
+// estoqueResponse traduz os erros das operações de escrita do estoque por depósito
+func (h *handler) estoqueResponse(c echo.Context, origem string, resp *model.EstoqueProduto, err error) error {
+	switch {
+	case errors.Is(err, depositoApp.ErrProdutoNaoEncontrado), errors.Is(err, depositoApp.ErrDepositoNaoEncontrado), errors.Is(err, depositoApp.ErrEstoqueNaoEncontrado):
+		return c.JSON(http.StatusNotFound, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	case errors.Is(err, depositoApp.ErrProdutoComVariacoes):
+		return c.JSON(http.StatusConflict, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	case err != nil:
+		logger.FromContext(c.Request().Context()).WithError(err).Error(origem)
+		return c.JSON(http.StatusBadRequest, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.Response{
+		Data: resp,
+	})
+}
+
+func (h *handler) getVariacoes(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	resp, err := h.apps.Variacao.GetVariacoes(ctx, c.Param("codigo"))
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).Error("api.produto.getVariacoes")
 		return c.JSON(http.StatusInternalServerError, model.Response{
 			Data: nil,
 			Err:  err.Error(),
@@ -58,11 +343,82 @@ func (h *handler) getProdutoByCodigo(c echo.Context) error {
 	})
 }
 
+func (h *handler) createVariacao(c echo.Context) error {
+	ctx := contextoEscrita(c)
+	payload := new(model.Variacao)
+
+	if err := c.Bind(payload); err != nil {
+		logger.FromContext(ctx).WithError(err).Warn("api.produto.createVariacao")
+		return c.JSON(http.StatusBadRequest, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+
+	payload.ProdutoCodigo = c.Param("codigo")
+
+	resp, err := h.apps.Variacao.CreateVariacao(ctx, payload)
+	return h.variacaoResponse(c, "api.produto.createVariacao", resp, err)
+}
+
+func (h *handler) updateVariacao(c echo.Context) error {
+	ctx := contextoEscrita(c)
+	payload := new(model.Variacao)
+
+	if err := c.Bind(payload); err != nil {
+		logger.FromContext(ctx).WithError(err).Warn("api.produto.updateVariacao")
+		return c.JSON(http.StatusBadRequest, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+
+	// o produto e o sku vêm sempre da rota
+	payload.ProdutoCodigo = c.Param("codigo")
+	payload.SKU = c.Param("sku")
+
+	resp, err := h.apps.Variacao.UpdateVariacao(ctx, payload)
+	return h.variacaoResponse(c, "api.produto.updateVariacao", resp, err)
+}
+
+func (h *handler) deleteVariacao(c echo.Context) error {
+	resp, err := h.apps.Variacao.DeleteVariacao(c.Request().Context(), c.Param("codigo"), c.Param("sku"))
+	return h.variacaoResponse(c, "api.produto.deleteVariacao", resp, err)
+}
+
+// variacaoResponse traduz os erros das operações de escrita das variações
+func (h *handler) variacaoResponse(c echo.Context, origem string, resp *model.Variacao, err error) error {
+	switch {
+	case errors.Is(err, variacaoApp.ErrProdutoNaoEncontrado), errors.Is(err, variacaoApp.ErrVariacaoNaoEncontrada):
+		return c.JSON(http.StatusNotFound, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	case errors.Is(err, variacaoApp.ErrSKUDuplicado), errors.Is(err, variacaoApp.ErrAtributosDuplicados),
+		errors.Is(err, variacaoApp.ErrProdutoComDepositos):
+		return c.JSON(http.StatusConflict, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	case err != nil:
+		logger.FromContext(c.Request().Context()).WithError(err).Error(origem)
+		return c.JSON(http.StatusBadRequest, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.Response{
+		Data: resp,
+	})
+}
+
 func (h *handler) getProdutoByNome(c echo.Context) error {
 	ctx := c.Request().Context()
 	payload := new(model.Produto)
 
 	if err := c.Bind(payload); err != nil {
+		logger.FromContext(ctx).WithError(err).Warn("api.produto.getProdutoByNome")
 		return c.JSON(http.StatusBadRequest, model.Response{
 			Data: nil,
 			Err:  err.Error(),
@@ -70,6 +426,7 @@ func (h *handler) getProdutoByNome(c echo.Context) error {
 	}
 	resp, err := h.apps.Produto.GetProdutoByNome(ctx, payload.Nome)
 	if err != nil {
+		logger.FromContext(ctx).WithError(err).Error("api.produto.getProdutoByNome")
 		return c.JSON(http.StatusInternalServerError, model.Response{
 			Data: nil,
 			Err:  err.Error(),
@@ -81,11 +438,98 @@ func (h *handler) getProdutoByNome(c echo.Context) error {
 	})
 }
 
-func (h *handler) createProduto(c echo.Context) error {
+const (
+	limiteBuscaPadrao = 20
+	limiteBuscaMaximo = 100
+)
+
+func (h *handler) buscarProdutos(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	q := strings.TrimSpace(c.QueryParam("q"))
+	if q == "" {
+		return c.JSON(http.StatusBadRequest, model.Response{
+			Data: nil,
+			Err:  "parametro q é obrigatório",
+		})
+	}
+
+	limite := limiteBuscaPadrao
+	if l := c.QueryParam("limite"); l != "" {
+		v, err := strconv.Atoi(l)
+		if err != nil || v <= 0 || v > limiteBuscaMaximo {
+			return c.JSON(http.StatusBadRequest, model.Response{
+				Data: nil,
+				Err:  fmt.Sprintf("parametro limite deve ser um número entre 1 e %d", limiteBuscaMaximo),
+			})
+		}
+		limite = v
+	}
+
+	resp, err := h.apps.Produto.BuscarProdutos(ctx, q, limite)
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).Error("api.produto.buscarProdutos")
+		return c.JSON(http.StatusInternalServerError, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.Response{
+		Data: resp,
+		Meta: map[string]interface{}{
+			"q":     q,
+			"total": len(*resp),
+		},
+	})
+}
+
+const (
+	limiteSugestoesPadrao = 10
+	limiteSugestoesMaximo = 50
+)
+
+func (h *handler) getSugestoes(c echo.Context) error {
 	ctx := c.Request().Context()
+
+	limite := limiteSugestoesPadrao
+	if l := c.QueryParam("limite"); l != "" {
+		v, err := strconv.Atoi(l)
+		if err != nil || v <= 0 || v > limiteSugestoesMaximo {
+			return c.JSON(http.StatusBadRequest, model.Response{
+				Data: nil,
+				Err:  fmt.Sprintf("parametro limite deve ser um número entre 1 e %d", limiteSugestoesMaximo),
+			})
+		}
+		limite = v
+	}
+
+	resp, err := h.apps.Produto.GetSugestoes(ctx, c.QueryParam("q"), limite)
+	if errors.Is(err, produtoApp.ErrIndiceDesabilitado) {
+		return c.JSON(http.StatusNotFound, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).Error("api.produto.getSugestoes")
+		return c.JSON(http.StatusInternalServerError, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.Response{
+		Data: resp,
+	})
+}
+
+func (h *handler) createProduto(c echo.Context) error {
+	ctx := contextoEscrita(c)
 	payload := new(model.Produto)
 
 	if err := c.Bind(payload); err != nil {
+		logger.FromContext(ctx).WithError(err).Warn("api.produto.createProduto")
 		return c.JSON(http.StatusBadRequest, model.Response{
 			Data: nil,
 			Err:  err.Error(),
@@ -94,6 +538,7 @@ func (h *handler) createProduto(c echo.Context) error {
 
 	response, err := h.apps.Produto.CreateProduto(ctx, payload)
 	if err != nil {
+		logger.FromContext(ctx).WithError(err).Error("api.produto.createProduto")
 		return c.JSON(http.StatusBadRequest, model.Response{
 			Data: nil,
 			Err:  err.Error(),
@@ -106,10 +551,11 @@ func (h *handler) createProduto(c echo.Context) error {
 }
 
 func (h *handler) updateProduto(c echo.Context) error {
-	ctx := c.Request().Context()
+	ctx := contextoEscrita(c)
 	payload := new(model.Produto)
 
 	if err := c.Bind(payload); err != nil {
+		logger.FromContext(ctx).WithError(err).Warn("api.produto.updateProduto")
 		return c.JSON(http.StatusBadRequest, model.Response{
 			Data: nil,
 			Err:  err.Error(),
@@ -117,7 +563,14 @@ func (h *handler) updateProduto(c echo.Context) error {
 	}
 
 	response, err := h.apps.Produto.UpdateProduto(ctx, payload)
+	if errors.Is(err, produtoApp.ErrProdutoNaoEncontrado) {
+		return c.JSON(http.StatusNotFound, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
 	if err != nil {
+		logger.FromContext(ctx).WithError(err).Error("api.produto.updateProduto")
 		return c.JSON(http.StatusBadRequest, model.Response{
 			Data: nil,
 			Err:  err.Error(),
@@ -129,18 +582,147 @@ func (h *handler) updateProduto(c echo.Context) error {
 	})
 }
 
+// mimeMergePatch content type dos merge patches (RFC 7396)
+const mimeMergePatch = "application/merge-patch+json"
+
+func (h *handler) patchProduto(c echo.Context) error {
+	ctx := contextoEscrita(c)
+
+	contentType := strings.TrimSpace(strings.Split(c.Request().Header.Get(echo.HeaderContentType), ";")[0])
+	if contentType != mimeMergePatch && contentType != echo.MIMEApplicationJSON {
+		return c.JSON(http.StatusUnsupportedMediaType, model.Response{
+			Data: nil,
+			Err:  fmt.Sprintf("content type deve ser %s", mimeMergePatch),
+		})
+	}
+
+	patch, err := io.ReadAll(c.Request().Body)
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).Warn("api.produto.patchProduto")
+		return c.JSON(http.StatusBadRequest, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+
+	resp, err := h.apps.Produto.PatchProduto(ctx, c.Param("codigo"), patch)
+	if errors.Is(err, produtoApp.ErrProdutoNaoEncontrado) {
+		return c.JSON(http.StatusNotFound, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).Error("api.produto.patchProduto")
+		return c.JSON(http.StatusBadRequest, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.Response{
+		Data: resp,
+	})
+}
+
 func (h *handler) deleteProduto(c echo.Context) error {
 	ctx := c.Request().Context()
 
 	resp, err := h.apps.Produto.DeleteProduto(ctx, c.Param("codigo"))
 	if err != nil {
+		logger.FromContext(ctx).WithError(err).Error("api.produto.deleteProduto")
+		return c.JSON(http.StatusBadRequest, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.Response{
+		Data: resp,
+	})
+}
+
+func (h *handler) preverReajuste(c echo.Context) error {
+	ctx := contextoEscrita(c)
+	payload := new(model.Reajuste)
+
+	if err := c.Bind(payload); err != nil {
+		logger.FromContext(ctx).WithError(err).Warn("api.produto.preverReajuste")
 		return c.JSON(http.StatusBadRequest, model.Response{
 			Data: nil,
 			Err:  err.Error(),
 		})
 	}
 
+	resp, err := h.apps.Produto.PreverReajuste(ctx, *payload)
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).Error("api.produto.preverReajuste")
+		return c.JSON(http.StatusBadRequest, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.Response{
+		Data: resp,
+	})
+}
+
+func (h *handler) aplicarReajuste(c echo.Context) error {
+	ctx := contextoEscrita(c)
+	payload := new(model.Reajuste)
+
+	if err := c.Bind(payload); err != nil {
+		logger.FromContext(ctx).WithError(err).Warn("api.produto.aplicarReajuste")
+		return c.JSON(http.StatusBadRequest, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+
+	resp, err := h.apps.Produto.AplicarReajuste(ctx, *payload)
+	if errors.Is(err, produtoApp.ErrPreviaDesatualizada) {
+		return c.JSON(http.StatusConflict, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+	if err != nil {
+		// com produtos inválidos a resposta traz a prévia com os erros de cada um
+		logger.FromContext(ctx).WithError(err).Error("api.produto.aplicarReajuste")
+		return c.JSON(http.StatusBadRequest, model.Response{
+			Data: resp,
+			Err:  err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.Response{
+		Data: resp,
+	})
+}
+
+func (h *handler) getCacheStats(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	resp, err := h.apps.Produto.GetCacheStats(ctx)
+	if errors.Is(err, produtoApp.ErrCacheDesabilitado) {
+		return c.JSON(http.StatusNotFound, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).Error("api.produto.getCacheStats")
+		return c.JSON(http.StatusInternalServerError, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+
 	return c.JSON(http.StatusOK, model.Response{
 		Data: resp,
+		Meta: map[string]interface{}{
+			"hit_ratio": resp.HitRatio(),
+		},
 	})
 }
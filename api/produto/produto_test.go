@@ -12,8 +12,13 @@ import (
 	"time"
 
 	"github.com/GianGoulart/CrudProdutos/app"
+	depositoApp "github.com/GianGoulart/CrudProdutos/app/deposito"
+	fornecedorApp "github.com/GianGoulart/CrudProdutos/app/fornecedor"
+	produtoApp "github.com/GianGoulart/CrudProdutos/app/produto"
+	variacaoApp "github.com/GianGoulart/CrudProdutos/app/variacao"
 	"github.com/GianGoulart/CrudProdutos/mocks"
 	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/GianGoulart/CrudProdutos/ratelimit"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -47,6 +52,7 @@ func Test_getProdutos(t *testing.T) {
 
 		InputVersion  string
 		InputDatetime time.Time
+		InputQuery    string
 
 		PrepareMock func(mock *mocks.IProdutoApp)
 	}{
@@ -54,6 +60,9 @@ func Test_getProdutos(t *testing.T) {
 			mock.On("GetProdutos", ctx).Return(&res, nil)
 
 		}},
+		"deve filtrar por categoria": {InputVersion: "1", InputDatetime: startedAt, ExpectedData: http.StatusOK, InputQuery: "?categoria=tv", PrepareMock: func(mock *mocks.IProdutoApp) {
+			mock.On("FiltrarProdutos", ctx, model.FiltroProduto{Categoria: "tv"}).Return(&res, nil)
+		}},
 		"deve retornar erro com a mensagem: ocorreu um erro": {InputVersion: "1", InputDatetime: startedAt, ExpectedData: http.StatusInternalServerError, PrepareMock: func(mock *mocks.IProdutoApp) {
 			mock.On("GetProdutos", ctx).Return(nil, erro)
 		}},
@@ -65,7 +74,7 @@ func Test_getProdutos(t *testing.T) {
 
 			cs.PrepareMock(mock)
 
-			request, err := http.NewRequest(http.MethodGet, "/produtos", nil)
+			request, err := http.NewRequest(http.MethodGet, "/produtos"+cs.InputQuery, nil)
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -258,6 +267,7 @@ func Test_updateProduto(t *testing.T) {
 		ExpectedErr  error
 		ExpectedData int
 		BodyReq      io.Reader
+		Query        string
 
 		InputVersion  string
 		InputDatetime time.Time
@@ -271,6 +281,15 @@ func Test_updateProduto(t *testing.T) {
 		"deve retornar erro com a mensagem: ocorreu um erro": {InputVersion: "1", InputDatetime: startedAt, ExpectedData: http.StatusBadRequest, BodyReq: strings.NewReader(string(body)), PrepareMock: func(mocks *mocks.IProdutoApp) {
 			mocks.On("UpdateProduto", ctx, mock.Anything).Return(nil, erro)
 		}},
+		"deve ignorar os limites de preço quando pedido": {InputVersion: "1", InputDatetime: startedAt, Query: "?ignorar_limites=true", ExpectedData: http.StatusOK, BodyReq: strings.NewReader(string(body)), PrepareMock: func(mocks *mocks.IProdutoApp) {
+			mocks.On("UpdateProduto", mock.MatchedBy(produtoApp.LimitesIgnorados), mock.Anything).Return(&res[0], nil)
+		}},
+		"deve retornar erro com os limites de preço violados": {InputVersion: "1", InputDatetime: startedAt, ExpectedData: http.StatusBadRequest, BodyReq: strings.NewReader(string(body)), PrepareMock: func(mocks *mocks.IProdutoApp) {
+			mocks.On("UpdateProduto", ctx, mock.Anything).Return(nil, model.ErrVariacaoAcimaDaMaxima)
+		}},
+		"deve retornar não encontrado com o produto inexistente": {InputVersion: "1", InputDatetime: startedAt, ExpectedData: http.StatusNotFound, BodyReq: strings.NewReader(string(body)), PrepareMock: func(mocks *mocks.IProdutoApp) {
+			mocks.On("UpdateProduto", ctx, mock.Anything).Return(nil, produtoApp.ErrProdutoNaoEncontrado)
+		}},
 	}
 
 	for name, cs := range cases {
@@ -279,7 +298,7 @@ func Test_updateProduto(t *testing.T) {
 
 			cs.PrepareMock(mock)
 
-			request, err := http.NewRequest(http.MethodPut, "/produtos", cs.BodyReq)
+			request, err := http.NewRequest(http.MethodPut, "/produtos"+cs.Query, cs.BodyReq)
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -298,6 +317,7 @@ func Test_updateProduto(t *testing.T) {
 				assert.Equal(t, cs.ExpectedData, rr.Code)
 			}
 
+			mock.AssertExpectations(t)
 		})
 	}
 }
@@ -353,3 +373,423 @@ func Test_deleteProduto(t *testing.T) {
 		})
 	}
 }
+
+func Test_buscarProdutos(t *testing.T) {
+	e := echo.New()
+	ctx := context.Background()
+
+	resultados := []model.ResultadoBusca{{Produto: res[0], Relevancia: 1, Destaque: "<em>Televisao</em> SAMSUNG"}}
+
+	cases := map[string]struct {
+		ExpectedData int
+		InputQuery   string
+
+		PrepareMock func(mock *mocks.IProdutoApp)
+	}{
+		"deve retornar sucesso": {ExpectedData: http.StatusOK, InputQuery: "q=televis%C3%A3o&limite=5", PrepareMock: func(mocks *mocks.IProdutoApp) {
+			mocks.On("BuscarProdutos", ctx, "televisão", 5).Return(&resultados, nil)
+		}},
+		"deve retornar erro sem o parametro q":   {ExpectedData: http.StatusBadRequest, InputQuery: "q=", PrepareMock: func(mocks *mocks.IProdutoApp) {}},
+		"deve retornar erro com limite inválido": {ExpectedData: http.StatusBadRequest, InputQuery: "q=tv&limite=1000", PrepareMock: func(mocks *mocks.IProdutoApp) {}},
+		"deve retornar erro com a mensagem: ocorreu um erro": {ExpectedData: http.StatusInternalServerError, InputQuery: "q=tv", PrepareMock: func(mocks *mocks.IProdutoApp) {
+			mocks.On("BuscarProdutos", ctx, "tv", 20).Return(nil, erro)
+		}},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			mock := new(mocks.IProdutoApp)
+
+			cs.PrepareMock(mock)
+
+			request, err := http.NewRequest(http.MethodGet, "/produtos/busca?"+cs.InputQuery, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rr := httptest.NewRecorder()
+			h := handler{
+				apps: &app.Container{Produto: mock},
+			}
+
+			c := e.NewContext(request, rr)
+
+			if assert.NoError(t, h.buscarProdutos(c)) {
+				assert.Equal(t, cs.ExpectedData, rr.Code)
+			}
+		})
+	}
+}
+
+func Test_getSugestoes(t *testing.T) {
+	e := echo.New()
+	ctx := context.Background()
+
+	sugestoes := []model.Sugestao{{Codigo: res[0].Codigo, Nome: res[0].Nome, Score: 1}}
+
+	cases := map[string]struct {
+		ExpectedData int
+		InputQuery   string
+
+		PrepareMock func(mock *mocks.IProdutoApp)
+	}{
+		"deve retornar sucesso": {ExpectedData: http.StatusOK, InputQuery: "q=telev&limite=5", PrepareMock: func(mocks *mocks.IProdutoApp) {
+			mocks.On("GetSugestoes", ctx, "telev", 5).Return(&sugestoes, nil)
+		}},
+		"deve retornar erro com limite inválido": {ExpectedData: http.StatusBadRequest, InputQuery: "q=tv&limite=0", PrepareMock: func(mocks *mocks.IProdutoApp) {}},
+		"deve retornar not found com o indice desabilitado": {ExpectedData: http.StatusNotFound, InputQuery: "q=tv", PrepareMock: func(mocks *mocks.IProdutoApp) {
+			mocks.On("GetSugestoes", ctx, "tv", 10).Return(nil, produtoApp.ErrIndiceDesabilitado)
+		}},
+		"deve retornar erro com a mensagem: ocorreu um erro": {ExpectedData: http.StatusInternalServerError, InputQuery: "q=tv", PrepareMock: func(mocks *mocks.IProdutoApp) {
+			mocks.On("GetSugestoes", ctx, "tv", 10).Return(nil, erro)
+		}},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			mock := new(mocks.IProdutoApp)
+
+			cs.PrepareMock(mock)
+
+			request, err := http.NewRequest(http.MethodGet, "/produtos/sugestoes?"+cs.InputQuery, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rr := httptest.NewRecorder()
+			h := handler{
+				apps: &app.Container{Produto: mock},
+			}
+
+			c := e.NewContext(request, rr)
+
+			if assert.NoError(t, h.getSugestoes(c)) {
+				assert.Equal(t, cs.ExpectedData, rr.Code)
+			}
+		})
+	}
+}
+
+func Test_saveFornecimento(t *testing.T) {
+	e := echo.New()
+	ctx := context.Background()
+
+	fornecimento := model.ProdutoFornecedor{ProdutoCodigo: res[0].Codigo, FornecedorCodigo: "f1", SKU: "A-1", Custo: 1800, PrazoEntrega: 10}
+
+	cases := map[string]struct {
+		ExpectedData int
+		InputBody    string
+
+		PrepareMock func(mock *mocks.IFornecedorApp)
+	}{
+		"deve retornar sucesso usando os codigos da rota": {ExpectedData: http.StatusOK, InputBody: `{"produto":"outro","fornecedor":"outro","sku":"A-1","custo":1800,"prazo_entrega_dias":10}`, PrepareMock: func(mock *mocks.IFornecedorApp) {
+			mock.On("SaveFornecimento", ctx, &fornecimento).Return(&fornecimento, nil)
+		}},
+		"deve retornar not found com o fornecedor inexistente": {ExpectedData: http.StatusNotFound, InputBody: `{"sku":"A-1","custo":1800,"prazo_entrega_dias":10}`, PrepareMock: func(m *mocks.IFornecedorApp) {
+			m.On("SaveFornecimento", ctx, mock.Anything).Return(nil, fornecedorApp.ErrFornecedorNaoEncontrado)
+		}},
+		"deve retornar erro com a mensagem: ocorreu um erro": {ExpectedData: http.StatusBadRequest, InputBody: `{"sku":""}`, PrepareMock: func(m *mocks.IFornecedorApp) {
+			m.On("SaveFornecimento", ctx, mock.Anything).Return(nil, erro)
+		}},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			mock := new(mocks.IFornecedorApp)
+
+			cs.PrepareMock(mock)
+
+			request, err := http.NewRequest(http.MethodPut, "/produtos/"+res[0].Codigo+"/fornecedores/f1", strings.NewReader(cs.InputBody))
+			if err != nil {
+				t.Fatal(err)
+			}
+			request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+			rr := httptest.NewRecorder()
+			h := handler{
+				apps: &app.Container{Fornecedor: mock},
+			}
+
+			c := e.NewContext(request, rr)
+			c.SetParamNames("codigo", "fornecedor")
+			c.SetParamValues(res[0].Codigo, "f1")
+
+			if assert.NoError(t, h.saveFornecimento(c)) {
+				assert.Equal(t, cs.ExpectedData, rr.Code)
+			}
+		})
+	}
+}
+
+func Test_updateVariacao(t *testing.T) {
+	e := echo.New()
+	ctx := context.Background()
+
+	variacao := model.Variacao{SKU: "tv-110", ProdutoCodigo: res[0].Codigo, Voltagem: "110", PrecoDe: 2000, PrecoPor: 1800, EstoqueTotal: 3}
+
+	cases := map[string]struct {
+		ExpectedData int
+		InputBody    string
+		Query        string
+
+		PrepareMock func(mock *mocks.IVariacaoApp)
+	}{
+		"deve retornar sucesso usando o produto e o sku da rota": {ExpectedData: http.StatusOK, InputBody: `{"sku":"outro","produto":"outro","voltagem":"110","preco_de":2000,"preco_por":1800,"estoque_total":3}`, PrepareMock: func(mock *mocks.IVariacaoApp) {
+			mock.On("UpdateVariacao", ctx, &variacao).Return(&variacao, nil)
+		}},
+		"deve retornar not found com a variação inexistente": {ExpectedData: http.StatusNotFound, InputBody: `{"voltagem":"110"}`, PrepareMock: func(m *mocks.IVariacaoApp) {
+			m.On("UpdateVariacao", ctx, mock.Anything).Return(nil, variacaoApp.ErrVariacaoNaoEncontrada)
+		}},
+		"deve retornar conflito com os atributos repetidos": {ExpectedData: http.StatusConflict, InputBody: `{"voltagem":"220"}`, PrepareMock: func(m *mocks.IVariacaoApp) {
+			m.On("UpdateVariacao", ctx, mock.Anything).Return(nil, variacaoApp.ErrAtributosDuplicados)
+		}},
+		"deve retornar erro com a mensagem: ocorreu um erro": {ExpectedData: http.StatusBadRequest, InputBody: `{}`, PrepareMock: func(m *mocks.IVariacaoApp) {
+			m.On("UpdateVariacao", ctx, mock.Anything).Return(nil, erro)
+		}},
+		"deve ignorar os limites de preço quando pedido": {ExpectedData: http.StatusOK, Query: "?ignorar_limites=true", InputBody: `{"voltagem":"110","preco_de":20,"preco_por":18}`, PrepareMock: func(m *mocks.IVariacaoApp) {
+			m.On("UpdateVariacao", mock.MatchedBy(produtoApp.LimitesIgnorados), mock.Anything).Return(&variacao, nil)
+		}},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			mock := new(mocks.IVariacaoApp)
+
+			cs.PrepareMock(mock)
+
+			request, err := http.NewRequest(http.MethodPut, "/produtos/"+res[0].Codigo+"/variacoes/tv-110"+cs.Query, strings.NewReader(cs.InputBody))
+			if err != nil {
+				t.Fatal(err)
+			}
+			request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+			rr := httptest.NewRecorder()
+			h := handler{
+				apps: &app.Container{Variacao: mock},
+			}
+
+			c := e.NewContext(request, rr)
+			c.SetParamNames("codigo", "sku")
+			c.SetParamValues(res[0].Codigo, "tv-110")
+
+			if assert.NoError(t, h.updateVariacao(c)) {
+				assert.Equal(t, cs.ExpectedData, rr.Code)
+			}
+		})
+	}
+}
+
+func Test_saveEstoque(t *testing.T) {
+	e := echo.New()
+	ctx := context.Background()
+
+	estoque := model.EstoqueDeposito{ProdutoCodigo: res[0].Codigo, DepositoCodigo: "cd-sp", EstoqueTotal: 10, EstoqueCorte: 2}
+
+	cases := map[string]struct {
+		ExpectedData int
+		InputBody    string
+
+		PrepareMock func(mock *mocks.IDepositoApp)
+	}{
+		"deve retornar sucesso usando os codigos da rota": {ExpectedData: http.StatusOK, InputBody: `{"produto":"outro","deposito":"outro","estoque_total":10,"estoque_corte":2}`, PrepareMock: func(mock *mocks.IDepositoApp) {
+			mock.On("SaveEstoque", ctx, &estoque).Return(&model.EstoqueProduto{Produto: res[0].Codigo, EstoqueTotal: 10, EstoqueCorte: 2, EstoqueDisponivel: 8}, nil)
+		}},
+		"deve retornar not found com o depósito inexistente": {ExpectedData: http.StatusNotFound, InputBody: `{"estoque_total":10}`, PrepareMock: func(m *mocks.IDepositoApp) {
+			m.On("SaveEstoque", ctx, mock.Anything).Return(nil, depositoApp.ErrDepositoNaoEncontrado)
+		}},
+		"deve retornar conflito com o produto com variações": {ExpectedData: http.StatusConflict, InputBody: `{"estoque_total":10}`, PrepareMock: func(m *mocks.IDepositoApp) {
+			m.On("SaveEstoque", ctx, mock.Anything).Return(nil, depositoApp.ErrProdutoComVariacoes)
+		}},
+		"deve retornar erro com a mensagem: ocorreu um erro": {ExpectedData: http.StatusBadRequest, InputBody: `{"estoque_total":1,"estoque_corte":2}`, PrepareMock: func(m *mocks.IDepositoApp) {
+			m.On("SaveEstoque", ctx, mock.Anything).Return(nil, erro)
+		}},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			mock := new(mocks.IDepositoApp)
+
+			cs.PrepareMock(mock)
+
+			request, err := http.NewRequest(http.MethodPut, "/produtos/"+res[0].Codigo+"/estoque/cd-sp", strings.NewReader(cs.InputBody))
+			if err != nil {
+				t.Fatal(err)
+			}
+			request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+			rr := httptest.NewRecorder()
+			h := handler{
+				apps: &app.Container{Deposito: mock},
+			}
+
+			c := e.NewContext(request, rr)
+			c.SetParamNames("codigo", "deposito")
+			c.SetParamValues(res[0].Codigo, "cd-sp")
+
+			if assert.NoError(t, h.saveEstoque(c)) {
+				assert.Equal(t, cs.ExpectedData, rr.Code)
+			}
+		})
+	}
+}
+
+func Test_aplicarReajuste(t *testing.T) {
+	e := echo.New()
+	ctx := context.Background()
+	body := `{"codigos":["p1"],"campo":"preco_por","tipo":"percentual","valor":-10,"confirmacao":"abc"}`
+	reajuste := model.Reajuste{Codigos: []string{"p1"}, Campo: model.CampoPrecoPor, Tipo: model.ReajustePercentual, Valor: -10, Confirmacao: "abc"}
+
+	cases := map[string]struct {
+		ExpectedData int
+
+		PrepareMock func(mock *mocks.IProdutoApp)
+	}{
+		"deve retornar sucesso": {ExpectedData: http.StatusOK, PrepareMock: func(mock *mocks.IProdutoApp) {
+			mock.On("AplicarReajuste", ctx, reajuste).Return(&model.PreviaReajuste{Alterados: 1}, nil)
+		}},
+		"deve retornar conflito com a prévia desatualizada": {ExpectedData: http.StatusConflict, PrepareMock: func(mock *mocks.IProdutoApp) {
+			mock.On("AplicarReajuste", ctx, reajuste).Return(nil, produtoApp.ErrPreviaDesatualizada)
+		}},
+		"deve retornar erro com produtos inválidos": {ExpectedData: http.StatusBadRequest, PrepareMock: func(mock *mocks.IProdutoApp) {
+			mock.On("AplicarReajuste", ctx, reajuste).Return(&model.PreviaReajuste{Invalidos: 1}, produtoApp.ErrReajusteInvalido)
+		}},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			mock := new(mocks.IProdutoApp)
+
+			cs.PrepareMock(mock)
+
+			request := httptest.NewRequest(http.MethodPost, "/produtos/reajuste", strings.NewReader(body))
+			request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+			rr := httptest.NewRecorder()
+			h := handler{
+				apps: &app.Container{Produto: mock},
+			}
+
+			c := e.NewContext(request, rr)
+
+			if assert.NoError(t, h.aplicarReajuste(c)) {
+				assert.Equal(t, cs.ExpectedData, rr.Code)
+			}
+
+			mock.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_patchProduto(t *testing.T) {
+	e := echo.New()
+	ctx := context.Background()
+	body := `{"estoque_corte":0}`
+
+	cases := map[string]struct {
+		ContentType  string
+		ExpectedData int
+
+		PrepareMock func(mock *mocks.IProdutoApp)
+	}{
+		"deve retornar sucesso": {ContentType: "application/merge-patch+json", ExpectedData: http.StatusOK, PrepareMock: func(mock *mocks.IProdutoApp) {
+			mock.On("PatchProduto", ctx, "p1", []byte(body)).Return(&model.Produto{Codigo: "p1"}, nil)
+		}},
+		"deve aceitar application/json": {ContentType: echo.MIMEApplicationJSONCharsetUTF8, ExpectedData: http.StatusOK, PrepareMock: func(mock *mocks.IProdutoApp) {
+			mock.On("PatchProduto", ctx, "p1", []byte(body)).Return(&model.Produto{Codigo: "p1"}, nil)
+		}},
+		"deve retornar não encontrado": {ContentType: "application/merge-patch+json", ExpectedData: http.StatusNotFound, PrepareMock: func(mock *mocks.IProdutoApp) {
+			mock.On("PatchProduto", ctx, "p1", []byte(body)).Return(nil, produtoApp.ErrProdutoNaoEncontrado)
+		}},
+		"deve retornar erro com o patch inválido": {ContentType: "application/merge-patch+json", ExpectedData: http.StatusBadRequest, PrepareMock: func(mock *mocks.IProdutoApp) {
+			mock.On("PatchProduto", ctx, "p1", []byte(body)).Return(nil, model.ErrPatchInvalido)
+		}},
+		"deve rejeitar o content type": {ContentType: echo.MIMETextPlain, ExpectedData: http.StatusUnsupportedMediaType, PrepareMock: func(mock *mocks.IProdutoApp) {}},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			mock := new(mocks.IProdutoApp)
+
+			cs.PrepareMock(mock)
+
+			request := httptest.NewRequest(http.MethodPatch, "/", strings.NewReader(body))
+			request.Header.Set(echo.HeaderContentType, cs.ContentType)
+
+			rr := httptest.NewRecorder()
+			h := handler{
+				apps: &app.Container{Produto: mock},
+			}
+
+			c := e.NewContext(request, rr)
+			c.SetPath("/produtos/:codigo")
+			c.SetParamNames("codigo")
+			c.SetParamValues("p1")
+
+			if assert.NoError(t, h.patchProduto(c)) {
+				assert.Equal(t, cs.ExpectedData, rr.Code)
+			}
+
+			mock.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_RegisterIdempotency(t *testing.T) {
+	e := echo.New()
+
+	// o middleware responde direto, sem chegar aos handlers
+	idempotency := func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			return c.NoContent(http.StatusTeapot)
+		}
+	}
+	Register(e.Group("/produtos"), &app.Container{}, idempotency, nil)
+
+	cases := map[string]struct {
+		Method string
+		Path   string
+	}{
+		"criação do produto":    {Method: http.MethodPost, Path: "/produtos"},
+		"reajuste":              {Method: http.MethodPost, Path: "/produtos/reajuste"},
+		"criação da variação":   {Method: http.MethodPost, Path: "/produtos/p1/variacoes"},
+		"alteração da variação": {Method: http.MethodPut, Path: "/produtos/p1/variacoes/sku1"},
+		"estoque no depósito":   {Method: http.MethodPut, Path: "/produtos/p1/estoque/d1"},
+		"remoção do estoque":    {Method: http.MethodDelete, Path: "/produtos/p1/estoque/d1"},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			e.ServeHTTP(rr, httptest.NewRequest(cs.Method, cs.Path, nil))
+
+			assert.Equal(t, http.StatusTeapot, rr.Code)
+		})
+	}
+}
+
+func Test_RegisterLeitura(t *testing.T) {
+	limiter := ratelimit.New(ratelimit.Options{
+		Read:  ratelimit.Limit{Rate: 1, Burst: 1},
+		Write: ratelimit.Limit{Rate: 1, Burst: 10},
+	})
+
+	m := new(mocks.IProdutoApp)
+	m.On("GetProdutoByNome", mock.Anything, "tv").Return(&res, nil)
+
+	e := echo.New()
+	e.Use(limiter.Middleware())
+	// mesmo agrupamento do main, com o prefixo sem a barra
+	Register(e.Group("").Group("produtos"), &app.Container{Produto: m}, nil, limiter)
+
+	// a busca por nome consome o limite de leitura, então a segunda busca é bloqueada
+	for _, expected := range []int{http.StatusOK, http.StatusTooManyRequests} {
+		req := httptest.NewRequest(http.MethodPost, "/produtos/produtosByNome", strings.NewReader(`{"nome": "tv"}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, expected, rec.Code)
+	}
+
+	m.AssertExpectations(t)
+}
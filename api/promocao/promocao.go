@@ -0,0 +1,151 @@
+package promocao
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/GianGoulart/CrudProdutos/app"
+	promocaoApp "github.com/GianGoulart/CrudProdutos/app/promocao"
+	"github.com/GianGoulart/CrudProdutos/logger"
+	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/labstack/echo/v4"
+)
+
+// Register group item check
+func Register(g *echo.Group, apps *app.Container) {
+	h := &handler{
+		apps: apps,
+	}
+
+	g.GET("", h.getPromocoes)
+	g.GET("/:codigo", h.getPromocaoByCodigo)
+	g.POST("", h.createPromocao)
+	g.PUT("", h.updatePromocao)
+	g.DELETE("/:codigo", h.deletePromocao)
+}
+
+type handler struct {
+	apps *app.Container
+}
+
+func (h *handler) getPromocoes(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	resp, err := h.apps.Promocao.GetPromocoes(ctx)
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).Error("api.promocao.getPromocoes")
+		return c.JSON(http.StatusInternalServerError, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.Response{
+		Data: resp,
+	})
+}
+
+func (h *handler) getPromocaoByCodigo(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	resp, err := h.apps.Promocao.GetPromocaoByCodigo(ctx, c.Param("codigo"))
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).Error("api.promocao.getPromocaoByCodigo")
+		return c.JSON(http.StatusInternalServerError, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+
+	if resp.Codigo == "" {
+		return c.JSON(http.StatusNotFound, model.Response{
+			Data: nil,
+			Err:  promocaoApp.ErrPromocaoNaoEncontrada.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.Response{
+		Data: resp,
+	})
+}
+
+func (h *handler) createPromocao(c echo.Context) error {
+	ctx := c.Request().Context()
+	payload := new(model.Promocao)
+
+	if err := c.Bind(payload); err != nil {
+		logger.FromContext(ctx).WithError(err).Warn("api.promocao.createPromocao")
+		return c.JSON(http.StatusBadRequest, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+
+	response, err := h.apps.Promocao.CreatePromocao(ctx, payload)
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).Error("api.promocao.createPromocao")
+		return c.JSON(http.StatusBadRequest, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.Response{
+		Data: response,
+	})
+}
+
+func (h *handler) updatePromocao(c echo.Context) error {
+	ctx := c.Request().Context()
+	payload := new(model.Promocao)
+
+	if err := c.Bind(payload); err != nil {
+		logger.FromContext(ctx).WithError(err).Warn("api.promocao.updatePromocao")
+		return c.JSON(http.StatusBadRequest, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+
+	response, err := h.apps.Promocao.UpdatePromocao(ctx, payload)
+	if errors.Is(err, promocaoApp.ErrPromocaoNaoEncontrada) {
+		return c.JSON(http.StatusNotFound, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).Error("api.promocao.updatePromocao")
+		return c.JSON(http.StatusBadRequest, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.Response{
+		Data: response,
+	})
+}
+
+func (h *handler) deletePromocao(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	resp, err := h.apps.Promocao.DeletePromocao(ctx, c.Param("codigo"))
+	if errors.Is(err, promocaoApp.ErrPromocaoNaoEncontrada) {
+		return c.JSON(http.StatusNotFound, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).Error("api.promocao.deletePromocao")
+		return c.JSON(http.StatusBadRequest, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.Response{
+		Data: resp,
+	})
+}
@@ -0,0 +1,163 @@
+package promocao
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/GianGoulart/CrudProdutos/app"
+	promocaoApp "github.com/GianGoulart/CrudProdutos/app/promocao"
+	"github.com/GianGoulart/CrudProdutos/mocks"
+	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	erro = errors.New("ocorreu um erro")
+
+	res = []model.Promocao{
+		{Codigo: "natal", Nome: "Natal", Tipo: model.DescontoPercentual, Valor: 10, Inicio: "2021-12-01T00:00:00Z", Fim: "2021-12-26T00:00:00Z", Categorias: []string{"tv"}},
+	}
+)
+
+func Test_getPromocaoByCodigo(t *testing.T) {
+	e := echo.New()
+	ctx := context.Background()
+
+	cases := map[string]struct {
+		ExpectedData int
+
+		PrepareMock func(mock *mocks.IPromocaoApp)
+	}{
+		"deve retornar sucesso": {ExpectedData: http.StatusOK, PrepareMock: func(mock *mocks.IPromocaoApp) {
+			mock.On("GetPromocaoByCodigo", ctx, "natal").Return(&res[0], nil)
+		}},
+		"deve retornar not found": {ExpectedData: http.StatusNotFound, PrepareMock: func(mock *mocks.IPromocaoApp) {
+			mock.On("GetPromocaoByCodigo", ctx, "natal").Return(&model.Promocao{}, nil)
+		}},
+		"deve retornar erro com a mensagem: ocorreu um erro": {ExpectedData: http.StatusInternalServerError, PrepareMock: func(mock *mocks.IPromocaoApp) {
+			mock.On("GetPromocaoByCodigo", ctx, "natal").Return(nil, erro)
+		}},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			mock := new(mocks.IPromocaoApp)
+
+			cs.PrepareMock(mock)
+
+			request, err := http.NewRequest(http.MethodGet, "/promocoes/natal", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rr := httptest.NewRecorder()
+			h := handler{
+				apps: &app.Container{Promocao: mock},
+			}
+
+			c := e.NewContext(request, rr)
+			c.SetParamNames("codigo")
+			c.SetParamValues("natal")
+
+			if assert.NoError(t, h.getPromocaoByCodigo(c)) {
+				assert.Equal(t, cs.ExpectedData, rr.Code)
+			}
+		})
+	}
+}
+
+func Test_updatePromocao(t *testing.T) {
+	e := echo.New()
+	ctx := context.Background()
+	body := `{"codigo":"natal","nome":"Natal","tipo":"percentual","valor":10,"inicio":"2021-12-01T00:00:00Z","fim":"2021-12-26T00:00:00Z","categorias":["tv"]}`
+
+	cases := map[string]struct {
+		ExpectedData int
+
+		PrepareMock func(m *mocks.IPromocaoApp)
+	}{
+		"deve retornar sucesso": {ExpectedData: http.StatusOK, PrepareMock: func(m *mocks.IPromocaoApp) {
+			m.On("UpdatePromocao", ctx, &res[0]).Return(&res[0], nil)
+		}},
+		"deve retornar not found": {ExpectedData: http.StatusNotFound, PrepareMock: func(m *mocks.IPromocaoApp) {
+			m.On("UpdatePromocao", ctx, mock.Anything).Return(nil, promocaoApp.ErrPromocaoNaoEncontrada)
+		}},
+		"deve retornar erro com a categoria inexistente": {ExpectedData: http.StatusBadRequest, PrepareMock: func(m *mocks.IPromocaoApp) {
+			m.On("UpdatePromocao", ctx, mock.Anything).Return(nil, promocaoApp.ErrCategoriaNaoEncontrada)
+		}},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			m := new(mocks.IPromocaoApp)
+
+			cs.PrepareMock(m)
+
+			request := httptest.NewRequest(http.MethodPut, "/promocoes", strings.NewReader(body))
+			request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+			rr := httptest.NewRecorder()
+			h := handler{
+				apps: &app.Container{Promocao: m},
+			}
+
+			c := e.NewContext(request, rr)
+
+			if assert.NoError(t, h.updatePromocao(c)) {
+				assert.Equal(t, cs.ExpectedData, rr.Code)
+			}
+
+			m.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_deletePromocao(t *testing.T) {
+	e := echo.New()
+	ctx := context.Background()
+
+	cases := map[string]struct {
+		ExpectedData int
+
+		PrepareMock func(mock *mocks.IPromocaoApp)
+	}{
+		"deve retornar sucesso": {ExpectedData: http.StatusOK, PrepareMock: func(mock *mocks.IPromocaoApp) {
+			mock.On("DeletePromocao", ctx, "natal").Return(&res[0], nil)
+		}},
+		"deve retornar not found": {ExpectedData: http.StatusNotFound, PrepareMock: func(mock *mocks.IPromocaoApp) {
+			mock.On("DeletePromocao", ctx, "natal").Return(nil, promocaoApp.ErrPromocaoNaoEncontrada)
+		}},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			mock := new(mocks.IPromocaoApp)
+
+			cs.PrepareMock(mock)
+
+			request, err := http.NewRequest(http.MethodDelete, "/promocoes/natal", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rr := httptest.NewRecorder()
+			h := handler{
+				apps: &app.Container{Promocao: mock},
+			}
+
+			c := e.NewContext(request, rr)
+			c.SetParamNames("codigo")
+			c.SetParamValues("natal")
+
+			if assert.NoError(t, h.deletePromocao(c)) {
+				assert.Equal(t, cs.ExpectedData, rr.Code)
+			}
+		})
+	}
+}
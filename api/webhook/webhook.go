@@ -0,0 +1,199 @@
+package webhook
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/GianGoulart/CrudProdutos/app"
+	webhookApp "github.com/GianGoulart/CrudProdutos/app/webhook"
+	"github.com/GianGoulart/CrudProdutos/logger"
+	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/labstack/echo/v4"
+)
+
+// Register group item check
+func Register(g *echo.Group, apps *app.Container) {
+	h := &handler{
+		apps: apps,
+	}
+
+	g.GET("", h.getWebhooks)
+	g.GET("/:codigo", h.getWebhookByCodigo)
+	g.POST("", h.createWebhook)
+	g.PUT("", h.updateWebhook)
+	g.DELETE("/:codigo", h.deleteWebhook)
+	g.GET("/entregas", h.getEntregas)
+	g.POST("/entregas/:codigo/reenviar", h.reenviarEntrega)
+}
+
+type handler struct {
+	apps *app.Container
+}
+
+func (h *handler) getWebhooks(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	resp, err := h.apps.Webhook.GetWebhooks(ctx)
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).Error("api.webhook.getWebhooks")
+		return c.JSON(http.StatusInternalServerError, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.Response{
+		Data: resp,
+	})
+}
+
+func (h *handler) getWebhookByCodigo(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	resp, err := h.apps.Webhook.GetWebhookByCodigo(ctx, c.Param("codigo"))
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).Error("api.webhook.getWebhookByCodigo")
+		return c.JSON(http.StatusInternalServerError, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+
+	if resp.Codigo == "" {
+		return c.JSON(http.StatusNotFound, model.Response{
+			Data: nil,
+			Err:  webhookApp.ErrWebhookNaoEncontrado.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.Response{
+		Data: resp,
+	})
+}
+
+func (h *handler) createWebhook(c echo.Context) error {
+	ctx := c.Request().Context()
+	payload := new(model.Webhook)
+
+	if err := c.Bind(payload); err != nil {
+		logger.FromContext(ctx).WithError(err).Warn("api.webhook.createWebhook")
+		return c.JSON(http.StatusBadRequest, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+
+	response, err := h.apps.Webhook.CreateWebhook(ctx, payload)
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).Error("api.webhook.createWebhook")
+		return c.JSON(http.StatusBadRequest, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.Response{
+		Data: response,
+	})
+}
+
+func (h *handler) updateWebhook(c echo.Context) error {
+	ctx := c.Request().Context()
+	payload := new(model.Webhook)
+
+	if err := c.Bind(payload); err != nil {
+		logger.FromContext(ctx).WithError(err).Warn("api.webhook.updateWebhook")
+		return c.JSON(http.StatusBadRequest, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+
+	response, err := h.apps.Webhook.UpdateWebhook(ctx, payload)
+	if errors.Is(err, webhookApp.ErrWebhookNaoEncontrado) {
+		return c.JSON(http.StatusNotFound, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).Error("api.webhook.updateWebhook")
+		return c.JSON(http.StatusBadRequest, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.Response{
+		Data: response,
+	})
+}
+
+func (h *handler) deleteWebhook(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	resp, err := h.apps.Webhook.DeleteWebhook(ctx, c.Param("codigo"))
+	if errors.Is(err, webhookApp.ErrWebhookNaoEncontrado) {
+		return c.JSON(http.StatusNotFound, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).Error("api.webhook.deleteWebhook")
+		return c.JSON(http.StatusBadRequest, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.Response{
+		Data: resp,
+	})
+}
+
+// getEntregas lista as entregas, status=falha retorna as que esgotaram as tentativas
+func (h *handler) getEntregas(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	filtro := model.FiltroEntrega{
+		Webhook: c.QueryParam("webhook"),
+		Status:  c.QueryParam("status"),
+	}
+
+	resp, err := h.apps.Webhook.GetEntregas(ctx, filtro)
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).Error("api.webhook.getEntregas")
+		return c.JSON(http.StatusInternalServerError, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.Response{
+		Data: resp,
+	})
+}
+
+func (h *handler) reenviarEntrega(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	resp, err := h.apps.Webhook.ReenviarEntrega(ctx, c.Param("codigo"))
+	if errors.Is(err, webhookApp.ErrEntregaNaoEncontrada) {
+		return c.JSON(http.StatusNotFound, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).Error("api.webhook.reenviarEntrega")
+		return c.JSON(http.StatusBadRequest, model.Response{
+			Data: nil,
+			Err:  err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.Response{
+		Data: resp,
+	})
+}
@@ -0,0 +1,114 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/GianGoulart/CrudProdutos/app"
+	webhookApp "github.com/GianGoulart/CrudProdutos/app/webhook"
+	"github.com/GianGoulart/CrudProdutos/mocks"
+	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	erro = errors.New("ocorreu um erro")
+
+	res = []model.Entrega{
+		{Codigo: "e1", WebhookCodigo: "w1", Evento: model.EventoProdutoCriado, Status: model.EntregaFalha, Tentativas: 8},
+	}
+)
+
+func Test_getEntregas(t *testing.T) {
+	e := echo.New()
+	ctx := context.Background()
+
+	cases := map[string]struct {
+		Query        string
+		ExpectedData int
+
+		PrepareMock func(mock *mocks.IWebhookApp)
+	}{
+		"deve retornar sucesso com os filtros": {Query: "?webhook=w1&status=falha", ExpectedData: http.StatusOK, PrepareMock: func(mock *mocks.IWebhookApp) {
+			mock.On("GetEntregas", ctx, model.FiltroEntrega{Webhook: "w1", Status: model.EntregaFalha}).Return(&res, nil)
+		}},
+		"deve retornar erro com a mensagem: ocorreu um erro": {ExpectedData: http.StatusInternalServerError, PrepareMock: func(mock *mocks.IWebhookApp) {
+			mock.On("GetEntregas", ctx, model.FiltroEntrega{}).Return(nil, erro)
+		}},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			mock := new(mocks.IWebhookApp)
+
+			cs.PrepareMock(mock)
+
+			request, err := http.NewRequest(http.MethodGet, "/webhooks/entregas"+cs.Query, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rr := httptest.NewRecorder()
+			h := handler{
+				apps: &app.Container{Webhook: mock},
+			}
+
+			c := e.NewContext(request, rr)
+
+			if assert.NoError(t, h.getEntregas(c)) {
+				assert.Equal(t, cs.ExpectedData, rr.Code)
+			}
+		})
+	}
+}
+
+func Test_reenviarEntrega(t *testing.T) {
+	e := echo.New()
+	ctx := context.Background()
+
+	cases := map[string]struct {
+		ExpectedData int
+
+		PrepareMock func(mock *mocks.IWebhookApp)
+	}{
+		"deve retornar sucesso": {ExpectedData: http.StatusOK, PrepareMock: func(mock *mocks.IWebhookApp) {
+			mock.On("ReenviarEntrega", ctx, "e1").Return(&model.Entrega{Codigo: "e1", Status: model.EntregaPendente}, nil)
+		}},
+		"deve retornar não encontrado": {ExpectedData: http.StatusNotFound, PrepareMock: func(mock *mocks.IWebhookApp) {
+			mock.On("ReenviarEntrega", ctx, "e1").Return(nil, webhookApp.ErrEntregaNaoEncontrada)
+		}},
+		"deve retornar erro com a mensagem: ocorreu um erro": {ExpectedData: http.StatusBadRequest, PrepareMock: func(mock *mocks.IWebhookApp) {
+			mock.On("ReenviarEntrega", ctx, "e1").Return(nil, erro)
+		}},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			mock := new(mocks.IWebhookApp)
+
+			cs.PrepareMock(mock)
+
+			request, err := http.NewRequest(http.MethodPost, "/webhooks/entregas/e1/reenviar", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rr := httptest.NewRecorder()
+			h := handler{
+				apps: &app.Container{Webhook: mock},
+			}
+
+			c := e.NewContext(request, rr)
+			c.SetParamNames("codigo")
+			c.SetParamValues("e1")
+
+			if assert.NoError(t, h.reenviarEntrega(c)) {
+				assert.Equal(t, cs.ExpectedData, rr.Code)
+			}
+		})
+	}
+}
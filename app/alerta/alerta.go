@@ -0,0 +1,108 @@
+package alerta
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GianGoulart/CrudProdutos/logger"
+	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/GianGoulart/CrudProdutos/store"
+)
+
+// IAlertaApp interface de alerta para implementação
+type IAlertaApp interface {
+	GetAlertas(ctx context.Context, filtro model.FiltroAlerta) (*[]model.Alerta, error)
+	ReconhecerAlerta(ctx context.Context, codigo string) (*model.Alerta, error)
+}
+
+// ErrAlertaNaoEncontrado erro retornado quando o alerta informado não existe
+var ErrAlertaNaoEncontrado = errors.New("alerta não encontrado")
+
+// NewApp cria uma nova instancia do serviço de alerta
+func NewApp(store *store.Container) IAlertaApp {
+	return &appImpl{
+		stores: store,
+	}
+}
+
+type appImpl struct {
+	stores *store.Container
+}
+
+func (p *appImpl) GetAlertas(ctx context.Context, filtro model.FiltroAlerta) (*[]model.Alerta, error) {
+	return p.stores.Alerta.FindAlertas(ctx, filtro)
+}
+
+func (p *appImpl) ReconhecerAlerta(ctx context.Context, codigo string) (*model.Alerta, error) {
+	alerta, err := p.stores.Alerta.FindAlertaByCodigo(ctx, codigo)
+	if err != nil {
+		return nil, err
+	}
+
+	if alerta.Codigo == "" {
+		return nil, ErrAlertaNaoEncontrado
+	}
+
+	if alerta.Reconhecido {
+		return alerta, nil
+	}
+
+	logger.FromContext(ctx).WithField("codigo", codigo).Info("app.alerta.ReconhecerAlerta")
+
+	return p.stores.Alerta.ReconhecerAlerta(ctx, alerta)
+}
+
+// Avaliar compara o estoque disponível do produto com o seu limite de alerta, ou
+// com o limite padrão, e registra e notifica um alerta quando a gravação leva o
+// estoque para um nível pior que o do produto anterior, nil no cadastro. As
+// alterações que não mudam o nível, como a de nome ou de preço, não geram alerta,
+// e enquanto não for reconhecido o alerta cobre as alterações seguintes do mesmo
+// nível. Falhas são apenas registradas e não interrompem a operação do produto
+func Avaliar(ctx context.Context, stores *store.Container, anterior, produto *model.Produto) *model.Alerta {
+	if stores.Alerta == nil || produto == nil || produto.Codigo == "" {
+		return nil
+	}
+
+	limite := produto.LimiteAlerta
+	if limite == 0 {
+		limite = stores.LimiteAlerta
+	}
+
+	alerta := model.NovoAlerta(produto, limite)
+	if alerta == nil {
+		return nil
+	}
+
+	if anterior != nil && anterior.Codigo != "" {
+		if antes := model.NovoAlerta(anterior, limite); antes != nil && (antes.Nivel == alerta.Nivel || antes.Nivel == model.AlertaEstoqueEsgotado) {
+			return nil
+		}
+	}
+
+	log := logger.FromContext(ctx).WithField("produto", produto.Codigo)
+
+	reconhecido := false
+	abertos, err := stores.Alerta.FindAlertas(ctx, model.FiltroAlerta{Produto: produto.Codigo, Reconhecido: &reconhecido})
+	if err != nil {
+		log.WithError(err).Error("app.alerta.Avaliar")
+		return nil
+	}
+
+	for _, aberto := range *abertos {
+		if aberto.Nivel == alerta.Nivel {
+			return nil
+		}
+	}
+
+	alerta, err = stores.Alerta.CreateAlerta(ctx, alerta)
+	if err != nil {
+		log.WithError(err).Error("app.alerta.Avaliar")
+		return nil
+	}
+
+	if stores.Notificador != nil {
+		stores.Notificador.Notificar(ctx, *alerta)
+	}
+
+	return alerta
+}
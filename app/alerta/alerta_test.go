@@ -0,0 +1,121 @@
+package alerta_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/GianGoulart/CrudProdutos/app/alerta"
+	"github.com/GianGoulart/CrudProdutos/mocks"
+	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/GianGoulart/CrudProdutos/store"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_Avaliar(t *testing.T) {
+	ctx := context.Background()
+	reconhecido := false
+	abertos := model.FiltroAlerta{Produto: "p1", Reconhecido: &reconhecido}
+
+	cases := map[string]struct {
+		Anterior      *model.Produto
+		Input         model.Produto
+		ExpectedNivel string
+
+		PrepareMock func(alertas *mocks.IAlertaStore, notificador *mocks.INotificador)
+	}{
+		"deve alertar com o limite do produto": {Input: model.Produto{Codigo: "p1", EstoqueDisponivel: 5, LimiteAlerta: 5}, ExpectedNivel: model.AlertaEstoqueBaixo, PrepareMock: func(a *mocks.IAlertaStore, n *mocks.INotificador) {
+			a.On("FindAlertas", ctx, abertos).Return(&[]model.Alerta{}, nil)
+			a.On("CreateAlerta", ctx, mock.MatchedBy(func(a *model.Alerta) bool { return a.Limite == 5 })).Return(func(_ context.Context, a *model.Alerta) *model.Alerta { return a }, nil)
+			n.On("Notificar", ctx, mock.Anything).Return()
+		}},
+		"deve alertar com o limite padrão": {Input: model.Produto{Codigo: "p1", EstoqueDisponivel: 2}, ExpectedNivel: model.AlertaEstoqueBaixo, PrepareMock: func(a *mocks.IAlertaStore, n *mocks.INotificador) {
+			a.On("FindAlertas", ctx, abertos).Return(&[]model.Alerta{}, nil)
+			a.On("CreateAlerta", ctx, mock.MatchedBy(func(a *model.Alerta) bool { return a.Limite == 3 })).Return(func(_ context.Context, a *model.Alerta) *model.Alerta { return a }, nil)
+			n.On("Notificar", ctx, mock.Anything).Return()
+		}},
+		"não deve alertar acima do limite": {Input: model.Produto{Codigo: "p1", EstoqueDisponivel: 10}, PrepareMock: func(a *mocks.IAlertaStore, n *mocks.INotificador) {}},
+		"não deve repetir um alerta aberto do mesmo nível": {Input: model.Produto{Codigo: "p1", EstoqueDisponivel: 1}, PrepareMock: func(a *mocks.IAlertaStore, n *mocks.INotificador) {
+			a.On("FindAlertas", ctx, abertos).Return(&[]model.Alerta{{Codigo: "a1", ProdutoCodigo: "p1", Nivel: model.AlertaEstoqueBaixo}}, nil)
+		}},
+		"deve alertar quando o estoque atinge o limite": {Anterior: &model.Produto{Codigo: "p1", EstoqueDisponivel: 10}, Input: model.Produto{Codigo: "p1", EstoqueDisponivel: 2}, ExpectedNivel: model.AlertaEstoqueBaixo, PrepareMock: func(a *mocks.IAlertaStore, n *mocks.INotificador) {
+			a.On("FindAlertas", ctx, abertos).Return(&[]model.Alerta{}, nil)
+			a.On("CreateAlerta", ctx, mock.Anything).Return(func(_ context.Context, a *model.Alerta) *model.Alerta { return a }, nil)
+			n.On("Notificar", ctx, mock.Anything).Return()
+		}},
+		"não deve alertar com o estoque que já estava abaixo do limite":  {Anterior: &model.Produto{Codigo: "p1", Nome: "TV", EstoqueDisponivel: 2}, Input: model.Produto{Codigo: "p1", Nome: "TV SAMSUNG", EstoqueDisponivel: 2}, PrepareMock: func(a *mocks.IAlertaStore, n *mocks.INotificador) {}},
+		"não deve alertar quando o estoque esgotado volta a ficar baixo": {Anterior: &model.Produto{Codigo: "p1", EstoqueDisponivel: 0}, Input: model.Produto{Codigo: "p1", EstoqueDisponivel: 2}, PrepareMock: func(a *mocks.IAlertaStore, n *mocks.INotificador) {}},
+		"deve alertar quando o estoque baixo esgota": {Anterior: &model.Produto{Codigo: "p1", EstoqueDisponivel: 2}, Input: model.Produto{Codigo: "p1", EstoqueDisponivel: 0}, ExpectedNivel: model.AlertaEstoqueEsgotado, PrepareMock: func(a *mocks.IAlertaStore, n *mocks.INotificador) {
+			a.On("FindAlertas", ctx, abertos).Return(&[]model.Alerta{}, nil)
+			a.On("CreateAlerta", ctx, mock.Anything).Return(func(_ context.Context, a *model.Alerta) *model.Alerta { return a }, nil)
+			n.On("Notificar", ctx, mock.Anything).Return()
+		}},
+		"deve alertar quando o estoque esgota": {Input: model.Produto{Codigo: "p1", EstoqueDisponivel: 0}, ExpectedNivel: model.AlertaEstoqueEsgotado, PrepareMock: func(a *mocks.IAlertaStore, n *mocks.INotificador) {
+			a.On("FindAlertas", ctx, abertos).Return(&[]model.Alerta{{Codigo: "a1", ProdutoCodigo: "p1", Nivel: model.AlertaEstoqueBaixo}}, nil)
+			a.On("CreateAlerta", ctx, mock.Anything).Return(func(_ context.Context, a *model.Alerta) *model.Alerta { return a }, nil)
+			n.On("Notificar", ctx, mock.Anything).Return()
+		}},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			alertas := new(mocks.IAlertaStore)
+			notificador := new(mocks.INotificador)
+
+			cs.PrepareMock(alertas, notificador)
+
+			res := alerta.Avaliar(ctx, &store.Container{Alerta: alertas, Notificador: notificador, LimiteAlerta: 3}, cs.Anterior, &cs.Input)
+
+			if cs.ExpectedNivel == "" {
+				assert.Nil(t, res)
+			} else if assert.NotNil(t, res) {
+				assert.Equal(t, cs.ExpectedNivel, res.Nivel)
+			}
+
+			alertas.AssertExpectations(t)
+			notificador.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_ReconhecerAlerta(t *testing.T) {
+	ctx := context.Background()
+
+	cases := map[string]struct {
+		Input       string
+		ExpectedErr error
+
+		PrepareMock func(mock *mocks.IAlertaStore)
+	}{
+		"deve reconhecer o alerta": {Input: "a1", PrepareMock: func(m *mocks.IAlertaStore) {
+			m.On("FindAlertaByCodigo", ctx, "a1").Return(&model.Alerta{Codigo: "a1"}, nil)
+			m.On("ReconhecerAlerta", ctx, &model.Alerta{Codigo: "a1"}).Return(&model.Alerta{Codigo: "a1", Reconhecido: true}, nil)
+		}},
+		"não deve reconhecer de novo": {Input: "a1", PrepareMock: func(m *mocks.IAlertaStore) {
+			m.On("FindAlertaByCodigo", ctx, "a1").Return(&model.Alerta{Codigo: "a1", Reconhecido: true}, nil)
+		}},
+		"deve retornar erro com o alerta inexistente": {Input: "xpto", ExpectedErr: alerta.ErrAlertaNaoEncontrado, PrepareMock: func(m *mocks.IAlertaStore) {
+			m.On("FindAlertaByCodigo", ctx, "xpto").Return(&model.Alerta{}, nil)
+		}},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			m := new(mocks.IAlertaStore)
+
+			cs.PrepareMock(m)
+
+			app := alerta.NewApp(&store.Container{Alerta: m})
+
+			_, err := app.ReconhecerAlerta(ctx, cs.Input)
+
+			if diff := cmp.Diff(err, cs.ExpectedErr, cmpopts.EquateErrors()); diff != "" {
+				t.Error(diff)
+			}
+
+			m.AssertExpectations(t)
+		})
+	}
+}
@@ -3,14 +3,30 @@ package app
 import (
 	"time"
 
+	"github.com/GianGoulart/CrudProdutos/app/alerta"
+	"github.com/GianGoulart/CrudProdutos/app/categoria"
+	"github.com/GianGoulart/CrudProdutos/app/deposito"
+	"github.com/GianGoulart/CrudProdutos/app/fornecedor"
+	"github.com/GianGoulart/CrudProdutos/app/marca"
 	"github.com/GianGoulart/CrudProdutos/app/produto"
+	"github.com/GianGoulart/CrudProdutos/app/promocao"
+	"github.com/GianGoulart/CrudProdutos/app/variacao"
+	"github.com/GianGoulart/CrudProdutos/app/webhook"
 	"github.com/GianGoulart/CrudProdutos/store"
 	"github.com/sirupsen/logrus"
 )
 
 // Container modelo para exportação dos serviços instanciados
 type Container struct {
-	Produto produto.IProdutoApp
+	Produto    produto.IProdutoApp
+	Categoria  categoria.ICategoriaApp
+	Marca      marca.IMarcaApp
+	Fornecedor fornecedor.IFornecedorApp
+	Variacao   variacao.IVariacaoApp
+	Deposito   deposito.IDepositoApp
+	Alerta     alerta.IAlertaApp
+	Webhook    webhook.IWebhookApp
+	Promocao   promocao.IPromocaoApp
 }
 
 // Options struct de opções para a criação de uma instancia dos serviços
@@ -26,7 +42,15 @@ func New(opts Options) *Container {
 
 	container := &Container{
 		// Health:            health.NewApp(opts.Stores, opts.Version, opts.StartedAt),
-		Produto: produto.NewApp(opts.Stores),
+		Produto:    produto.NewApp(opts.Stores),
+		Categoria:  categoria.NewApp(opts.Stores),
+		Marca:      marca.NewApp(opts.Stores),
+		Fornecedor: fornecedor.NewApp(opts.Stores),
+		Variacao:   variacao.NewApp(opts.Stores),
+		Deposito:   deposito.NewApp(opts.Stores),
+		Alerta:     alerta.NewApp(opts.Stores),
+		Webhook:    webhook.NewApp(opts.Stores),
+		Promocao:   promocao.NewApp(opts.Stores),
 	}
 
 	logrus.Info("Registered -> App")
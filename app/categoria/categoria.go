@@ -0,0 +1,206 @@
+package categoria
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GianGoulart/CrudProdutos/logger"
+	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/GianGoulart/CrudProdutos/store"
+)
+
+// ICategoriaApp interface de categoria para implementação
+type ICategoriaApp interface {
+	GetCategorias(ctx context.Context) (*[]model.Categoria, error)
+	GetArvore(ctx context.Context) (*[]model.Categoria, error)
+	GetCategoriaByCodigo(ctx context.Context, codigo string) (*model.Categoria, error)
+	CreateCategoria(ctx context.Context, categoria *model.Categoria) (*model.Categoria, error)
+	UpdateCategoria(ctx context.Context, categoria *model.Categoria) (*model.Categoria, error)
+	DeleteCategoria(ctx context.Context, codigo string) (*model.Categoria, error)
+	GetCategoriasProduto(ctx context.Context, produto string) (*[]model.Categoria, error)
+	SetCategoriasProduto(ctx context.Context, produto string, categorias []string) (*[]model.Categoria, error)
+}
+
+var (
+	// ErrCategoriaNaoEncontrada erro retornado quando a categoria informada não existe
+	ErrCategoriaNaoEncontrada = errors.New("categoria não encontrada")
+	// ErrCategoriaPaiNaoEncontrada erro retornado quando a categoria pai informada não existe
+	ErrCategoriaPaiNaoEncontrada = errors.New("categoria pai não encontrada")
+	// ErrCategoriaCiclo erro retornado ao mover uma categoria para baixo dela mesma
+	ErrCategoriaCiclo = errors.New("categoria não pode ser movida para uma de suas descendentes")
+	// ErrCategoriaComFilhas erro retornado ao remover uma categoria que possui filhas
+	ErrCategoriaComFilhas = errors.New("categoria possui categorias filhas")
+	// ErrProdutoNaoEncontrado erro retornado ao associar categorias a um produto inexistente
+	ErrProdutoNaoEncontrado = errors.New("produto não encontrado")
+)
+
+// NewApp cria uma nova instancia do serviço de categoria
+func NewApp(store *store.Container) ICategoriaApp {
+	return &appImpl{
+		stores: store,
+	}
+}
+
+type appImpl struct {
+	stores *store.Container
+}
+
+func (p *appImpl) GetCategorias(ctx context.Context) (*[]model.Categoria, error) {
+	return p.stores.Categoria.FindCategorias(ctx)
+}
+
+// GetArvore retorna as categorias raiz com as descendentes aninhadas em filhas
+func (p *appImpl) GetArvore(ctx context.Context) (*[]model.Categoria, error) {
+	categorias, err := p.stores.Categoria.FindCategorias(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	arvore := model.Arvore(*categorias)
+
+	return &arvore, nil
+}
+
+func (p *appImpl) GetCategoriaByCodigo(ctx context.Context, codigo string) (*model.Categoria, error) {
+	return p.stores.Categoria.FindCategoriaByCodigo(ctx, codigo)
+}
+
+func (p *appImpl) CreateCategoria(ctx context.Context, categoria *model.Categoria) (*model.Categoria, error) {
+	categoria.PreSave()
+
+	if err := p.validar(ctx, categoria); err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("codigo", categoria.Codigo).Warn("app.categoria.CreateCategoria")
+		return nil, err
+	}
+
+	return p.stores.Categoria.CreateCategoria(ctx, categoria)
+}
+
+func (p *appImpl) UpdateCategoria(ctx context.Context, categoria *model.Categoria) (*model.Categoria, error) {
+	atual, err := p.stores.Categoria.FindCategoriaByCodigo(ctx, categoria.Codigo)
+	if err != nil {
+		return nil, err
+	}
+
+	if atual.Codigo == "" {
+		return nil, ErrCategoriaNaoEncontrada
+	}
+
+	if err := p.validar(ctx, categoria); err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("codigo", categoria.Codigo).Warn("app.categoria.UpdateCategoria")
+		return nil, err
+	}
+
+	return p.stores.Categoria.UpdateCategoria(ctx, categoria)
+}
+
+func (p *appImpl) DeleteCategoria(ctx context.Context, codigo string) (*model.Categoria, error) {
+	categoria, err := p.stores.Categoria.FindCategoriaByCodigo(ctx, codigo)
+	if err != nil {
+		return nil, err
+	}
+
+	if categoria.Codigo == "" {
+		return nil, ErrCategoriaNaoEncontrada
+	}
+
+	categorias, err := p.stores.Categoria.FindCategorias(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, c := range *categorias {
+		if c.Pai == codigo {
+			return nil, ErrCategoriaComFilhas
+		}
+	}
+
+	if err := p.stores.Categoria.DeleteCategoriaByCodigo(ctx, categoria); err != nil {
+		return nil, err
+	}
+
+	logger.FromContext(ctx).WithField("codigo", codigo).Info("app.categoria.DeleteCategoria")
+
+	return categoria, nil
+}
+
+func (p *appImpl) GetCategoriasProduto(ctx context.Context, produto string) (*[]model.Categoria, error) {
+	return p.stores.Categoria.FindCategoriasByProduto(ctx, produto)
+}
+
+// SetCategoriasProduto substitui as categorias do produto, que devem existir
+func (p *appImpl) SetCategoriasProduto(ctx context.Context, produto string, categorias []string) (*[]model.Categoria, error) {
+	atual, err := p.stores.Produto.FindProdutoByCodigo(ctx, produto)
+	if err != nil {
+		return nil, err
+	}
+
+	if atual.Codigo == "" {
+		return nil, ErrProdutoNaoEncontrado
+	}
+
+	cadastradas, err := p.stores.Categoria.FindCategorias(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	existe := map[string]bool{}
+	for _, c := range *cadastradas {
+		existe[c.Codigo] = true
+	}
+
+	unicas := []string{}
+	vistas := map[string]bool{}
+	for _, codigo := range categorias {
+		if !existe[codigo] {
+			return nil, ErrCategoriaNaoEncontrada
+		}
+
+		if !vistas[codigo] {
+			vistas[codigo] = true
+			unicas = append(unicas, codigo)
+		}
+	}
+
+	if err := p.stores.Categoria.SetProdutoCategorias(ctx, produto, unicas); err != nil {
+		return nil, err
+	}
+
+	return p.stores.Categoria.FindCategoriasByProduto(ctx, produto)
+}
+
+// validar garante que a categoria pai existe e que ela não está abaixo da própria categoria
+func (p *appImpl) validar(ctx context.Context, categoria *model.Categoria) error {
+	if err := categoria.Validate(); err != nil {
+		return err
+	}
+
+	if categoria.Pai == "" {
+		return nil
+	}
+
+	categorias, err := p.stores.Categoria.FindCategorias(ctx)
+	if err != nil {
+		return err
+	}
+
+	existe := false
+	for _, c := range *categorias {
+		if c.Codigo == categoria.Pai {
+			existe = true
+			break
+		}
+	}
+
+	if !existe {
+		return ErrCategoriaPaiNaoEncontrada
+	}
+
+	for _, codigo := range model.Descendentes(*categorias, categoria.Codigo) {
+		if codigo == categoria.Pai {
+			return ErrCategoriaCiclo
+		}
+	}
+
+	return nil
+}
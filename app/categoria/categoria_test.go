@@ -0,0 +1,187 @@
+package categoria_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/GianGoulart/CrudProdutos/app/categoria"
+	"github.com/GianGoulart/CrudProdutos/mocks"
+	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/GianGoulart/CrudProdutos/store"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/stretchr/testify/mock"
+)
+
+var categorias = []model.Categoria{
+	{Codigo: "eletro", Nome: "Eletrodomésticos"},
+	{Codigo: "tv", Nome: "Televisores", Pai: "eletro"},
+	{Codigo: "oled", Nome: "OLED", Pai: "tv"},
+}
+
+func Test_CreateCategoria(t *testing.T) {
+	ctx := context.Background()
+
+	cases := map[string]struct {
+		Input       model.Categoria
+		ExpectedErr error
+
+		PrepareMock func(mock *mocks.ICategoriaStore)
+	}{
+		"deve criar uma categoria raiz": {Input: model.Categoria{Nome: "Informática"}, PrepareMock: func(m *mocks.ICategoriaStore) {
+			m.On("CreateCategoria", ctx, mock.Anything).Return(&model.Categoria{Nome: "Informática"}, nil)
+		}},
+		"deve criar uma categoria filha": {Input: model.Categoria{Nome: "QLED", Pai: "tv"}, PrepareMock: func(m *mocks.ICategoriaStore) {
+			m.On("FindCategorias", ctx).Return(&categorias, nil)
+			m.On("CreateCategoria", ctx, mock.Anything).Return(&model.Categoria{Nome: "QLED", Pai: "tv"}, nil)
+		}},
+		"deve retornar erro com o pai inexistente": {Input: model.Categoria{Nome: "QLED", Pai: "xpto"}, ExpectedErr: categoria.ErrCategoriaPaiNaoEncontrada, PrepareMock: func(m *mocks.ICategoriaStore) {
+			m.On("FindCategorias", ctx).Return(&categorias, nil)
+		}},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			m := new(mocks.ICategoriaStore)
+
+			cs.PrepareMock(m)
+
+			app := categoria.NewApp(&store.Container{Categoria: m})
+
+			_, err := app.CreateCategoria(ctx, &cs.Input)
+
+			if diff := cmp.Diff(err, cs.ExpectedErr, cmpopts.EquateErrors()); diff != "" {
+				t.Error(diff)
+			}
+
+			m.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_UpdateCategoria(t *testing.T) {
+	ctx := context.Background()
+
+	cases := map[string]struct {
+		Input       model.Categoria
+		ExpectedErr error
+
+		PrepareMock func(mock *mocks.ICategoriaStore)
+	}{
+		"deve mover a categoria": {Input: model.Categoria{Codigo: "oled", Nome: "OLED", Pai: "eletro"}, PrepareMock: func(m *mocks.ICategoriaStore) {
+			m.On("FindCategoriaByCodigo", ctx, "oled").Return(&categorias[2], nil)
+			m.On("FindCategorias", ctx).Return(&categorias, nil)
+			m.On("UpdateCategoria", ctx, mock.Anything).Return(&categorias[2], nil)
+		}},
+		"deve retornar erro ao mover para uma descendente": {Input: model.Categoria{Codigo: "eletro", Nome: "Eletro", Pai: "oled"}, ExpectedErr: categoria.ErrCategoriaCiclo, PrepareMock: func(m *mocks.ICategoriaStore) {
+			m.On("FindCategoriaByCodigo", ctx, "eletro").Return(&categorias[0], nil)
+			m.On("FindCategorias", ctx).Return(&categorias, nil)
+		}},
+		"deve retornar erro com a categoria inexistente": {Input: model.Categoria{Codigo: "xpto", Nome: "Xpto"}, ExpectedErr: categoria.ErrCategoriaNaoEncontrada, PrepareMock: func(m *mocks.ICategoriaStore) {
+			m.On("FindCategoriaByCodigo", ctx, "xpto").Return(&model.Categoria{}, nil)
+		}},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			m := new(mocks.ICategoriaStore)
+
+			cs.PrepareMock(m)
+
+			app := categoria.NewApp(&store.Container{Categoria: m})
+
+			_, err := app.UpdateCategoria(ctx, &cs.Input)
+
+			if diff := cmp.Diff(err, cs.ExpectedErr, cmpopts.EquateErrors()); diff != "" {
+				t.Error(diff)
+			}
+
+			m.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_DeleteCategoria(t *testing.T) {
+	ctx := context.Background()
+
+	cases := map[string]struct {
+		Input       string
+		ExpectedErr error
+
+		PrepareMock func(mock *mocks.ICategoriaStore)
+	}{
+		"deve remover uma folha": {Input: "oled", PrepareMock: func(m *mocks.ICategoriaStore) {
+			m.On("FindCategoriaByCodigo", ctx, "oled").Return(&categorias[2], nil)
+			m.On("FindCategorias", ctx).Return(&categorias, nil)
+			m.On("DeleteCategoriaByCodigo", ctx, &categorias[2]).Return(nil)
+		}},
+		"deve retornar erro com filhas": {Input: "tv", ExpectedErr: categoria.ErrCategoriaComFilhas, PrepareMock: func(m *mocks.ICategoriaStore) {
+			m.On("FindCategoriaByCodigo", ctx, "tv").Return(&categorias[1], nil)
+			m.On("FindCategorias", ctx).Return(&categorias, nil)
+		}},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			m := new(mocks.ICategoriaStore)
+
+			cs.PrepareMock(m)
+
+			app := categoria.NewApp(&store.Container{Categoria: m})
+
+			_, err := app.DeleteCategoria(ctx, cs.Input)
+
+			if diff := cmp.Diff(err, cs.ExpectedErr, cmpopts.EquateErrors()); diff != "" {
+				t.Error(diff)
+			}
+
+			m.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_SetCategoriasProduto(t *testing.T) {
+	ctx := context.Background()
+	produto := &model.Produto{Codigo: "p1", Nome: "TV"}
+
+	cases := map[string]struct {
+		Input       []string
+		ExpectedErr error
+
+		PrepareMock func(produtos *mocks.IProdutoStore, categorias *mocks.ICategoriaStore)
+	}{
+		"deve associar sem repetir": {Input: []string{"tv", "oled", "tv"}, PrepareMock: func(p *mocks.IProdutoStore, c *mocks.ICategoriaStore) {
+			p.On("FindProdutoByCodigo", ctx, "p1").Return(produto, nil)
+			c.On("FindCategorias", ctx).Return(&categorias, nil)
+			c.On("SetProdutoCategorias", ctx, "p1", []string{"tv", "oled"}).Return(nil)
+			c.On("FindCategoriasByProduto", ctx, "p1").Return(&[]model.Categoria{categorias[2], categorias[1]}, nil)
+		}},
+		"deve retornar erro com a categoria inexistente": {Input: []string{"xpto"}, ExpectedErr: categoria.ErrCategoriaNaoEncontrada, PrepareMock: func(p *mocks.IProdutoStore, c *mocks.ICategoriaStore) {
+			p.On("FindProdutoByCodigo", ctx, "p1").Return(produto, nil)
+			c.On("FindCategorias", ctx).Return(&categorias, nil)
+		}},
+		"deve retornar erro com o produto inexistente": {Input: []string{"tv"}, ExpectedErr: categoria.ErrProdutoNaoEncontrado, PrepareMock: func(p *mocks.IProdutoStore, c *mocks.ICategoriaStore) {
+			p.On("FindProdutoByCodigo", ctx, "p1").Return(&model.Produto{}, nil)
+		}},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			produtos := new(mocks.IProdutoStore)
+			categorias := new(mocks.ICategoriaStore)
+
+			cs.PrepareMock(produtos, categorias)
+
+			app := categoria.NewApp(&store.Container{Produto: produtos, Categoria: categorias})
+
+			_, err := app.SetCategoriasProduto(ctx, "p1", cs.Input)
+
+			if diff := cmp.Diff(err, cs.ExpectedErr, cmpopts.EquateErrors()); diff != "" {
+				t.Error(diff)
+			}
+
+			produtos.AssertExpectations(t)
+			categorias.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,281 @@
+package deposito
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GianGoulart/CrudProdutos/app/alerta"
+	"github.com/GianGoulart/CrudProdutos/app/evento"
+	"github.com/GianGoulart/CrudProdutos/logger"
+	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/GianGoulart/CrudProdutos/store"
+)
+
+// IDepositoApp interface de depósito para implementação
+type IDepositoApp interface {
+	GetDepositos(ctx context.Context) (*[]model.Deposito, error)
+	GetDepositoByCodigo(ctx context.Context, codigo string) (*model.Deposito, error)
+	CreateDeposito(ctx context.Context, deposito *model.Deposito) (*model.Deposito, error)
+	UpdateDeposito(ctx context.Context, deposito *model.Deposito) (*model.Deposito, error)
+	DeleteDeposito(ctx context.Context, codigo string) (*model.Deposito, error)
+	GetEstoque(ctx context.Context, produto string) (*model.EstoqueProduto, error)
+	SaveEstoque(ctx context.Context, estoque *model.EstoqueDeposito) (*model.EstoqueProduto, error)
+	DeleteEstoque(ctx context.Context, produto, deposito string) (*model.EstoqueProduto, error)
+}
+
+var (
+	// ErrDepositoNaoEncontrado erro retornado quando o depósito informado não existe
+	ErrDepositoNaoEncontrado = errors.New("depósito não encontrado")
+	// ErrDepositoComEstoque erro retornado ao remover um depósito que ainda possui estoque de produtos
+	ErrDepositoComEstoque = errors.New("depósito possui estoque de produtos")
+	// ErrProdutoNaoEncontrado erro retornado ao consultar ou gravar o estoque de um produto inexistente
+	ErrProdutoNaoEncontrado = errors.New("produto não encontrado")
+	// ErrProdutoComVariacoes erro retornado ao gravar estoque por depósito de um produto com variações,
+	// cujo estoque já é a soma das variações
+	ErrProdutoComVariacoes = errors.New("produto com variações não possui estoque por depósito")
+	// ErrEstoqueNaoEncontrado erro retornado ao remover um estoque que o produto não possui no depósito
+	ErrEstoqueNaoEncontrado = errors.New("produto não possui estoque no depósito")
+)
+
+// NewApp cria uma nova instancia do serviço de depósito
+func NewApp(store *store.Container) IDepositoApp {
+	return &appImpl{
+		stores: store,
+	}
+}
+
+type appImpl struct {
+	stores *store.Container
+}
+
+func (p *appImpl) GetDepositos(ctx context.Context) (*[]model.Deposito, error) {
+	return p.stores.Deposito.FindDepositos(ctx)
+}
+
+func (p *appImpl) GetDepositoByCodigo(ctx context.Context, codigo string) (*model.Deposito, error) {
+	return p.stores.Deposito.FindDepositoByCodigo(ctx, codigo)
+}
+
+func (p *appImpl) CreateDeposito(ctx context.Context, deposito *model.Deposito) (*model.Deposito, error) {
+	deposito.PreSave()
+
+	if err := deposito.Validate(); err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("codigo", deposito.Codigo).Warn("app.deposito.CreateDeposito")
+		return nil, err
+	}
+
+	return p.stores.Deposito.CreateDeposito(ctx, deposito)
+}
+
+// UpdateDeposito altera o depósito e, quando ele é ativado ou desativado,
+// recalcula o estoque dos produtos que possuem estoque nele
+func (p *appImpl) UpdateDeposito(ctx context.Context, deposito *model.Deposito) (*model.Deposito, error) {
+	if err := deposito.Validate(); err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("codigo", deposito.Codigo).Warn("app.deposito.UpdateDeposito")
+		return nil, err
+	}
+
+	atual, err := p.stores.Deposito.FindDepositoByCodigo(ctx, deposito.Codigo)
+	if err != nil {
+		return nil, err
+	}
+
+	if atual.Codigo == "" {
+		return nil, ErrDepositoNaoEncontrado
+	}
+
+	deposito, err = p.stores.Deposito.UpdateDeposito(ctx, deposito)
+	if err != nil {
+		return nil, err
+	}
+
+	if atual.Inativo == deposito.Inativo {
+		return deposito, nil
+	}
+
+	produtos, err := p.stores.Deposito.FindProdutosByDeposito(ctx, deposito.Codigo)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, produto := range produtos {
+		if _, err := p.agregar(ctx, produto); err != nil {
+			return nil, err
+		}
+	}
+
+	logger.FromContext(ctx).WithField("codigo", deposito.Codigo).WithField("produtos", len(produtos)).Info("app.deposito.UpdateDeposito")
+
+	return deposito, nil
+}
+
+func (p *appImpl) DeleteDeposito(ctx context.Context, codigo string) (*model.Deposito, error) {
+	deposito, err := p.stores.Deposito.FindDepositoByCodigo(ctx, codigo)
+	if err != nil {
+		return nil, err
+	}
+
+	if deposito.Codigo == "" {
+		return nil, ErrDepositoNaoEncontrado
+	}
+
+	produtos, err := p.stores.Deposito.FindProdutosByDeposito(ctx, codigo)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(produtos) > 0 {
+		return nil, ErrDepositoComEstoque
+	}
+
+	if err := p.stores.Deposito.DeleteDepositoByCodigo(ctx, deposito); err != nil {
+		return nil, err
+	}
+
+	logger.FromContext(ctx).WithField("codigo", codigo).Info("app.deposito.DeleteDeposito")
+
+	return deposito, nil
+}
+
+func (p *appImpl) GetEstoque(ctx context.Context, produto string) (*model.EstoqueProduto, error) {
+	pai, err := p.stores.Produto.FindProdutoByCodigo(ctx, produto)
+	if err != nil {
+		return nil, err
+	}
+
+	if pai.Codigo == "" {
+		return nil, ErrProdutoNaoEncontrado
+	}
+
+	estoques, err := p.stores.Deposito.FindEstoquesByProduto(ctx, produto)
+	if err != nil {
+		return nil, err
+	}
+
+	depositos, err := p.stores.Deposito.FindDepositos(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	model.AgregarEstoqueDepositos(pai, *estoques, *depositos)
+
+	return estoqueProduto(pai, *estoques), nil
+}
+
+// SaveEstoque grava o estoque do produto no depósito e recalcula o estoque do produto
+func (p *appImpl) SaveEstoque(ctx context.Context, estoque *model.EstoqueDeposito) (*model.EstoqueProduto, error) {
+	estoque.PreSave()
+
+	if err := estoque.Validate(); err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("produto", estoque.ProdutoCodigo).Warn("app.deposito.SaveEstoque")
+		return nil, err
+	}
+
+	produto, err := p.stores.Produto.FindProdutoByCodigo(ctx, estoque.ProdutoCodigo)
+	if err != nil {
+		return nil, err
+	}
+
+	if produto.Codigo == "" {
+		return nil, ErrProdutoNaoEncontrado
+	}
+
+	if p.stores.Variacao != nil {
+		variacoes, err := p.stores.Variacao.FindVariacoesByProduto(ctx, produto.Codigo)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(*variacoes) > 0 {
+			return nil, ErrProdutoComVariacoes
+		}
+	}
+
+	deposito, err := p.stores.Deposito.FindDepositoByCodigo(ctx, estoque.DepositoCodigo)
+	if err != nil {
+		return nil, err
+	}
+
+	if deposito.Codigo == "" {
+		return nil, ErrDepositoNaoEncontrado
+	}
+
+	if _, err := p.stores.Deposito.SaveEstoque(ctx, estoque); err != nil {
+		return nil, err
+	}
+
+	return p.agregar(ctx, produto.Codigo)
+}
+
+// DeleteEstoque remove o estoque do produto no depósito e recalcula o estoque do produto
+func (p *appImpl) DeleteEstoque(ctx context.Context, produto, deposito string) (*model.EstoqueProduto, error) {
+	estoques, err := p.stores.Deposito.FindEstoquesByProduto(ctx, produto)
+	if err != nil {
+		return nil, err
+	}
+
+	encontrado := false
+	for _, e := range *estoques {
+		encontrado = encontrado || e.DepositoCodigo == deposito
+	}
+
+	if !encontrado {
+		return nil, ErrEstoqueNaoEncontrado
+	}
+
+	if err := p.stores.Deposito.DeleteEstoque(ctx, produto, deposito); err != nil {
+		return nil, err
+	}
+
+	return p.agregar(ctx, produto)
+}
+
+// agregar grava no produto a soma do estoque dos depósitos ativos. Ao remover o
+// último depósito o produto fica sem estoque, como quando todos estão inativos
+func (p *appImpl) agregar(ctx context.Context, codigo string) (*model.EstoqueProduto, error) {
+	produto, err := p.stores.Produto.FindProdutoByCodigo(ctx, codigo)
+	if err != nil {
+		return nil, err
+	}
+
+	if produto.Codigo == "" {
+		return nil, ErrProdutoNaoEncontrado
+	}
+
+	estoques, err := p.stores.Deposito.FindEstoquesByProduto(ctx, codigo)
+	if err != nil {
+		return nil, err
+	}
+
+	depositos, err := p.stores.Deposito.FindDepositos(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	anterior := *produto
+	if len(*estoques) == 0 {
+		produto.EstoqueTotal, produto.EstoqueCorte, produto.EstoqueDisponivel = 0, 0, 0
+	}
+	model.AgregarEstoqueDepositos(produto, *estoques, *depositos)
+
+	if _, err := p.stores.Produto.UpdateProduto(ctx, produto); err != nil {
+		return nil, err
+	}
+
+	alerta.Avaliar(ctx, p.stores, &anterior, produto)
+	if model.EstoqueAlterado(&anterior, produto) {
+		evento.Emitir(ctx, p.stores, model.EventoEstoqueAlterado, produto)
+	}
+
+	return estoqueProduto(produto, *estoques), nil
+}
+
+func estoqueProduto(produto *model.Produto, estoques []model.EstoqueDeposito) *model.EstoqueProduto {
+	return &model.EstoqueProduto{
+		Produto:           produto.Codigo,
+		EstoqueTotal:      produto.EstoqueTotal,
+		EstoqueCorte:      produto.EstoqueCorte,
+		EstoqueDisponivel: produto.EstoqueDisponivel,
+		Depositos:         estoques,
+	}
+}
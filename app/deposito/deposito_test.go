@@ -0,0 +1,233 @@
+package deposito_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/GianGoulart/CrudProdutos/app/deposito"
+	"github.com/GianGoulart/CrudProdutos/mocks"
+	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/GianGoulart/CrudProdutos/store"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/stretchr/testify/mock"
+)
+
+var depositos = []model.Deposito{
+	{Codigo: "cd-sp", Nome: "CD São Paulo"},
+	{Codigo: "cd-rj", Nome: "CD Rio de Janeiro"},
+	{Codigo: "cd-mg", Nome: "CD Minas Gerais", Inativo: true},
+}
+
+func Test_SaveEstoque(t *testing.T) {
+	ctx := context.Background()
+	produto := &model.Produto{Codigo: "p1", Nome: "TV"}
+
+	cases := map[string]struct {
+		Input       model.EstoqueDeposito
+		Expected    *model.EstoqueProduto
+		ExpectedErr error
+
+		PrepareMock func(produtos *mocks.IProdutoStore, variacoes *mocks.IVariacaoStore, depositos *mocks.IDepositoStore)
+	}{
+		"deve somar apenas os depósitos ativos": {Input: model.EstoqueDeposito{ProdutoCodigo: "p1", DepositoCodigo: "cd-sp", EstoqueTotal: 10, EstoqueCorte: 2},
+			Expected: &model.EstoqueProduto{Produto: "p1", EstoqueTotal: 15, EstoqueCorte: 2, EstoqueDisponivel: 13, Depositos: []model.EstoqueDeposito{
+				{ProdutoCodigo: "p1", DepositoCodigo: "cd-mg", EstoqueTotal: 100, EstoqueDisponivel: 100},
+				{ProdutoCodigo: "p1", DepositoCodigo: "cd-rj", EstoqueTotal: 5, EstoqueDisponivel: 5, Ativo: true},
+				{ProdutoCodigo: "p1", DepositoCodigo: "cd-sp", EstoqueTotal: 10, EstoqueCorte: 2, EstoqueDisponivel: 8, Ativo: true},
+			}},
+			PrepareMock: func(p *mocks.IProdutoStore, v *mocks.IVariacaoStore, d *mocks.IDepositoStore) {
+				p.On("FindProdutoByCodigo", ctx, "p1").Return(produto, nil)
+				v.On("FindVariacoesByProduto", ctx, "p1").Return(&[]model.Variacao{}, nil)
+				d.On("FindDepositoByCodigo", ctx, "cd-sp").Return(&depositos[0], nil)
+				d.On("SaveEstoque", ctx, &model.EstoqueDeposito{ProdutoCodigo: "p1", DepositoCodigo: "cd-sp", EstoqueTotal: 10, EstoqueCorte: 2, EstoqueDisponivel: 8}).Return(&model.EstoqueDeposito{}, nil)
+				d.On("FindEstoquesByProduto", ctx, "p1").Return(&[]model.EstoqueDeposito{
+					{ProdutoCodigo: "p1", DepositoCodigo: "cd-mg", EstoqueTotal: 100, EstoqueDisponivel: 100},
+					{ProdutoCodigo: "p1", DepositoCodigo: "cd-rj", EstoqueTotal: 5, EstoqueDisponivel: 5},
+					{ProdutoCodigo: "p1", DepositoCodigo: "cd-sp", EstoqueTotal: 10, EstoqueCorte: 2, EstoqueDisponivel: 8},
+				}, nil)
+				d.On("FindDepositos", ctx).Return(&depositos, nil)
+				p.On("UpdateProduto", ctx, mock.MatchedBy(func(p *model.Produto) bool {
+					return p.EstoqueTotal == 15 && p.EstoqueCorte == 2 && p.EstoqueDisponivel == 13
+				})).Return(&model.Produto{}, nil)
+			}},
+		"deve retornar erro com o produto com variações": {Input: model.EstoqueDeposito{ProdutoCodigo: "p1", DepositoCodigo: "cd-sp", EstoqueTotal: 10}, ExpectedErr: deposito.ErrProdutoComVariacoes, PrepareMock: func(p *mocks.IProdutoStore, v *mocks.IVariacaoStore, d *mocks.IDepositoStore) {
+			p.On("FindProdutoByCodigo", ctx, "p1").Return(produto, nil)
+			v.On("FindVariacoesByProduto", ctx, "p1").Return(&[]model.Variacao{{SKU: "p1-110", ProdutoCodigo: "p1"}}, nil)
+		}},
+		"deve retornar erro com o depósito inexistente": {Input: model.EstoqueDeposito{ProdutoCodigo: "p1", DepositoCodigo: "xpto", EstoqueTotal: 10}, ExpectedErr: deposito.ErrDepositoNaoEncontrado, PrepareMock: func(p *mocks.IProdutoStore, v *mocks.IVariacaoStore, d *mocks.IDepositoStore) {
+			p.On("FindProdutoByCodigo", ctx, "p1").Return(produto, nil)
+			v.On("FindVariacoesByProduto", ctx, "p1").Return(&[]model.Variacao{}, nil)
+			d.On("FindDepositoByCodigo", ctx, "xpto").Return(&model.Deposito{}, nil)
+		}},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			produtos := new(mocks.IProdutoStore)
+			variacoes := new(mocks.IVariacaoStore)
+			depositos := new(mocks.IDepositoStore)
+
+			cs.PrepareMock(produtos, variacoes, depositos)
+
+			app := deposito.NewApp(&store.Container{Produto: produtos, Variacao: variacoes, Deposito: depositos})
+
+			res, err := app.SaveEstoque(ctx, &cs.Input)
+
+			if diff := cmp.Diff(err, cs.ExpectedErr, cmpopts.EquateErrors()); diff != "" {
+				t.Error(diff)
+			}
+
+			if diff := cmp.Diff(res, cs.Expected, cmpopts.IgnoreFields(model.EstoqueDeposito{}, "UltimaAlteracao")); diff != "" {
+				t.Error(diff)
+			}
+
+			produtos.AssertExpectations(t)
+			variacoes.AssertExpectations(t)
+			depositos.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_UpdateDeposito(t *testing.T) {
+	ctx := context.Background()
+
+	cases := map[string]struct {
+		Input       model.Deposito
+		ExpectedErr error
+
+		PrepareMock func(produtos *mocks.IProdutoStore, depositos *mocks.IDepositoStore)
+	}{
+		"deve recalcular os produtos ao desativar": {Input: model.Deposito{Codigo: "cd-rj", Nome: "CD Rio de Janeiro", Inativo: true}, PrepareMock: func(p *mocks.IProdutoStore, d *mocks.IDepositoStore) {
+			d.On("FindDepositoByCodigo", ctx, "cd-rj").Return(&model.Deposito{Codigo: "cd-rj", Nome: "CD Rio de Janeiro"}, nil)
+			d.On("UpdateDeposito", ctx, mock.Anything).Return(func(_ context.Context, d *model.Deposito) *model.Deposito { return d }, nil)
+			d.On("FindProdutosByDeposito", ctx, "cd-rj").Return([]string{"p1"}, nil)
+			p.On("FindProdutoByCodigo", ctx, "p1").Return(&model.Produto{Codigo: "p1", Nome: "TV"}, nil)
+			d.On("FindEstoquesByProduto", ctx, "p1").Return(&[]model.EstoqueDeposito{
+				{ProdutoCodigo: "p1", DepositoCodigo: "cd-rj", EstoqueTotal: 5, EstoqueDisponivel: 5},
+				{ProdutoCodigo: "p1", DepositoCodigo: "cd-sp", EstoqueTotal: 10, EstoqueCorte: 2, EstoqueDisponivel: 8},
+			}, nil)
+			d.On("FindDepositos", ctx).Return(&[]model.Deposito{depositos[0], {Codigo: "cd-rj", Inativo: true}}, nil)
+			p.On("UpdateProduto", ctx, mock.MatchedBy(func(p *model.Produto) bool {
+				return p.EstoqueTotal == 10 && p.EstoqueDisponivel == 8
+			})).Return(&model.Produto{}, nil)
+		}},
+		"deve apenas alterar o nome": {Input: model.Deposito{Codigo: "cd-sp", Nome: "CD SP"}, PrepareMock: func(p *mocks.IProdutoStore, d *mocks.IDepositoStore) {
+			d.On("FindDepositoByCodigo", ctx, "cd-sp").Return(&depositos[0], nil)
+			d.On("UpdateDeposito", ctx, mock.Anything).Return(func(_ context.Context, d *model.Deposito) *model.Deposito { return d }, nil)
+		}},
+		"deve retornar erro com o depósito inexistente": {Input: model.Deposito{Codigo: "xpto", Nome: "Xpto"}, ExpectedErr: deposito.ErrDepositoNaoEncontrado, PrepareMock: func(p *mocks.IProdutoStore, d *mocks.IDepositoStore) {
+			d.On("FindDepositoByCodigo", ctx, "xpto").Return(&model.Deposito{}, nil)
+		}},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			produtos := new(mocks.IProdutoStore)
+			depositos := new(mocks.IDepositoStore)
+
+			cs.PrepareMock(produtos, depositos)
+
+			app := deposito.NewApp(&store.Container{Produto: produtos, Deposito: depositos})
+
+			_, err := app.UpdateDeposito(ctx, &cs.Input)
+
+			if diff := cmp.Diff(err, cs.ExpectedErr, cmpopts.EquateErrors()); diff != "" {
+				t.Error(diff)
+			}
+
+			produtos.AssertExpectations(t)
+			depositos.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_DeleteDeposito(t *testing.T) {
+	ctx := context.Background()
+
+	cases := map[string]struct {
+		Input       string
+		ExpectedErr error
+
+		PrepareMock func(mock *mocks.IDepositoStore)
+	}{
+		"deve remover um depósito sem estoque": {Input: "cd-mg", PrepareMock: func(m *mocks.IDepositoStore) {
+			m.On("FindDepositoByCodigo", ctx, "cd-mg").Return(&depositos[2], nil)
+			m.On("FindProdutosByDeposito", ctx, "cd-mg").Return([]string{}, nil)
+			m.On("DeleteDepositoByCodigo", ctx, &depositos[2]).Return(nil)
+		}},
+		"deve retornar erro com estoque": {Input: "cd-sp", ExpectedErr: deposito.ErrDepositoComEstoque, PrepareMock: func(m *mocks.IDepositoStore) {
+			m.On("FindDepositoByCodigo", ctx, "cd-sp").Return(&depositos[0], nil)
+			m.On("FindProdutosByDeposito", ctx, "cd-sp").Return([]string{"p1"}, nil)
+		}},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			m := new(mocks.IDepositoStore)
+
+			cs.PrepareMock(m)
+
+			app := deposito.NewApp(&store.Container{Deposito: m})
+
+			_, err := app.DeleteDeposito(ctx, cs.Input)
+
+			if diff := cmp.Diff(err, cs.ExpectedErr, cmpopts.EquateErrors()); diff != "" {
+				t.Error(diff)
+			}
+
+			m.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_DeleteEstoque(t *testing.T) {
+	ctx := context.Background()
+	estoques := []model.EstoqueDeposito{{ProdutoCodigo: "p1", DepositoCodigo: "cd-sp", EstoqueTotal: 10, EstoqueCorte: 2, EstoqueDisponivel: 8}}
+
+	cases := map[string]struct {
+		Deposito    string
+		Expected    *model.EstoqueProduto
+		ExpectedErr error
+
+		PrepareMock func(produtos *mocks.IProdutoStore, depositos *mocks.IDepositoStore)
+	}{
+		"deve zerar o estoque ao remover o último depósito": {Deposito: "cd-sp", Expected: &model.EstoqueProduto{Produto: "p1", Depositos: []model.EstoqueDeposito{}}, PrepareMock: func(p *mocks.IProdutoStore, d *mocks.IDepositoStore) {
+			d.On("FindEstoquesByProduto", ctx, "p1").Return(&estoques, nil).Once()
+			d.On("DeleteEstoque", ctx, "p1", "cd-sp").Return(nil)
+			p.On("FindProdutoByCodigo", ctx, "p1").Return(&model.Produto{Codigo: "p1", Nome: "TV", EstoqueTotal: 10, EstoqueCorte: 2, EstoqueDisponivel: 8}, nil)
+			d.On("FindEstoquesByProduto", ctx, "p1").Return(&[]model.EstoqueDeposito{}, nil).Once()
+			d.On("FindDepositos", ctx).Return(&depositos, nil)
+			p.On("UpdateProduto", ctx, mock.MatchedBy(func(p *model.Produto) bool {
+				return p.EstoqueTotal == 0 && p.EstoqueCorte == 0 && p.EstoqueDisponivel == 0
+			})).Return(&model.Produto{}, nil)
+		}},
+		"deve retornar erro com o estoque inexistente no depósito": {Deposito: "cd-rj", ExpectedErr: deposito.ErrEstoqueNaoEncontrado, PrepareMock: func(p *mocks.IProdutoStore, d *mocks.IDepositoStore) {
+			d.On("FindEstoquesByProduto", ctx, "p1").Return(&estoques, nil)
+		}},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			produtos := new(mocks.IProdutoStore)
+			depositos := new(mocks.IDepositoStore)
+
+			cs.PrepareMock(produtos, depositos)
+
+			app := deposito.NewApp(&store.Container{Produto: produtos, Deposito: depositos})
+
+			res, err := app.DeleteEstoque(ctx, "p1", cs.Deposito)
+
+			if diff := cmp.Diff(err, cs.ExpectedErr, cmpopts.EquateErrors()); diff != "" {
+				t.Error(diff)
+			}
+
+			if diff := cmp.Diff(res, cs.Expected); diff != "" {
+				t.Error(diff)
+			}
+
+			produtos.AssertExpectations(t)
+			depositos.AssertExpectations(t)
+		})
+	}
+}
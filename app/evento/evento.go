@@ -0,0 +1,25 @@
+package evento
+
+import (
+	"context"
+
+	"github.com/GianGoulart/CrudProdutos/app/webhook"
+	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/GianGoulart/CrudProdutos/store"
+)
+
+// Habilitado indica se há algum destino para os eventos de produto, para evitar
+// consultas feitas só para montar os eventos
+func Habilitado(stores *store.Container) bool {
+	return stores.Webhook != nil || stores.Stream != nil
+}
+
+// Emitir distribui o evento do produto para os webhooks assinados e para os
+// clientes conectados ao stream de eventos
+func Emitir(ctx context.Context, stores *store.Container, tipo string, produto *model.Produto) {
+	webhook.Publicar(ctx, stores, tipo, produto)
+
+	if stores.Stream != nil {
+		stores.Stream.Publicar(tipo, produto)
+	}
+}
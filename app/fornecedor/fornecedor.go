@@ -0,0 +1,134 @@
+package fornecedor
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GianGoulart/CrudProdutos/logger"
+	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/GianGoulart/CrudProdutos/store"
+)
+
+// IFornecedorApp interface de fornecedor para implementação
+type IFornecedorApp interface {
+	GetFornecedores(ctx context.Context) (*[]model.Fornecedor, error)
+	GetFornecedorByCodigo(ctx context.Context, codigo string) (*model.Fornecedor, error)
+	CreateFornecedor(ctx context.Context, fornecedor *model.Fornecedor) (*model.Fornecedor, error)
+	UpdateFornecedor(ctx context.Context, fornecedor *model.Fornecedor) (*model.Fornecedor, error)
+	DeleteFornecedor(ctx context.Context, codigo string) (*model.Fornecedor, error)
+	GetFornecimentos(ctx context.Context, produto string) (*[]model.ProdutoFornecedor, error)
+	SaveFornecimento(ctx context.Context, fornecimento *model.ProdutoFornecedor) (*model.ProdutoFornecedor, error)
+	DeleteFornecimento(ctx context.Context, produto, fornecedor string) error
+}
+
+var (
+	// ErrFornecedorNaoEncontrado erro retornado quando o fornecedor informado não existe
+	ErrFornecedorNaoEncontrado = errors.New("fornecedor não encontrado")
+	// ErrProdutoNaoEncontrado erro retornado ao vincular um fornecedor a um produto inexistente
+	ErrProdutoNaoEncontrado = errors.New("produto não encontrado")
+)
+
+// NewApp cria uma nova instancia do serviço de fornecedor
+func NewApp(store *store.Container) IFornecedorApp {
+	return &appImpl{
+		stores: store,
+	}
+}
+
+type appImpl struct {
+	stores *store.Container
+}
+
+func (p *appImpl) GetFornecedores(ctx context.Context) (*[]model.Fornecedor, error) {
+	return p.stores.Fornecedor.FindFornecedores(ctx)
+}
+
+func (p *appImpl) GetFornecedorByCodigo(ctx context.Context, codigo string) (*model.Fornecedor, error) {
+	return p.stores.Fornecedor.FindFornecedorByCodigo(ctx, codigo)
+}
+
+func (p *appImpl) CreateFornecedor(ctx context.Context, fornecedor *model.Fornecedor) (*model.Fornecedor, error) {
+	fornecedor.PreSave()
+
+	if err := fornecedor.Validate(); err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("codigo", fornecedor.Codigo).Warn("app.fornecedor.CreateFornecedor")
+		return nil, err
+	}
+
+	return p.stores.Fornecedor.CreateFornecedor(ctx, fornecedor)
+}
+
+func (p *appImpl) UpdateFornecedor(ctx context.Context, fornecedor *model.Fornecedor) (*model.Fornecedor, error) {
+	if err := fornecedor.Validate(); err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("codigo", fornecedor.Codigo).Warn("app.fornecedor.UpdateFornecedor")
+		return nil, err
+	}
+
+	atual, err := p.stores.Fornecedor.FindFornecedorByCodigo(ctx, fornecedor.Codigo)
+	if err != nil {
+		return nil, err
+	}
+
+	if atual.Codigo == "" {
+		return nil, ErrFornecedorNaoEncontrado
+	}
+
+	return p.stores.Fornecedor.UpdateFornecedor(ctx, fornecedor)
+}
+
+// DeleteFornecedor remove o fornecedor junto com os seus vínculos com produtos
+func (p *appImpl) DeleteFornecedor(ctx context.Context, codigo string) (*model.Fornecedor, error) {
+	fornecedor, err := p.stores.Fornecedor.FindFornecedorByCodigo(ctx, codigo)
+	if err != nil {
+		return nil, err
+	}
+
+	if fornecedor.Codigo == "" {
+		return nil, ErrFornecedorNaoEncontrado
+	}
+
+	if err := p.stores.Fornecedor.DeleteFornecedorByCodigo(ctx, fornecedor); err != nil {
+		return nil, err
+	}
+
+	logger.FromContext(ctx).WithField("codigo", codigo).Info("app.fornecedor.DeleteFornecedor")
+
+	return fornecedor, nil
+}
+
+func (p *appImpl) GetFornecimentos(ctx context.Context, produto string) (*[]model.ProdutoFornecedor, error) {
+	return p.stores.Fornecedor.FindFornecimentosByProduto(ctx, produto)
+}
+
+// SaveFornecimento vincula o fornecedor ao produto com o sku, custo e prazo de
+// entrega informados, substituindo as condições anteriores
+func (p *appImpl) SaveFornecimento(ctx context.Context, fornecimento *model.ProdutoFornecedor) (*model.ProdutoFornecedor, error) {
+	if err := fornecimento.Validate(); err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("produto", fornecimento.ProdutoCodigo).Warn("app.fornecedor.SaveFornecimento")
+		return nil, err
+	}
+
+	produto, err := p.stores.Produto.FindProdutoByCodigo(ctx, fornecimento.ProdutoCodigo)
+	if err != nil {
+		return nil, err
+	}
+
+	if produto.Codigo == "" {
+		return nil, ErrProdutoNaoEncontrado
+	}
+
+	fornecedor, err := p.stores.Fornecedor.FindFornecedorByCodigo(ctx, fornecimento.FornecedorCodigo)
+	if err != nil {
+		return nil, err
+	}
+
+	if fornecedor.Codigo == "" {
+		return nil, ErrFornecedorNaoEncontrado
+	}
+
+	return p.stores.Fornecedor.SaveFornecimento(ctx, fornecimento)
+}
+
+func (p *appImpl) DeleteFornecimento(ctx context.Context, produto, fornecedor string) error {
+	return p.stores.Fornecedor.DeleteFornecimento(ctx, produto, fornecedor)
+}
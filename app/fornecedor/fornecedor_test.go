@@ -0,0 +1,60 @@
+package fornecedor_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/GianGoulart/CrudProdutos/app/fornecedor"
+	"github.com/GianGoulart/CrudProdutos/mocks"
+	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/GianGoulart/CrudProdutos/store"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_SaveFornecimento(t *testing.T) {
+	ctx := context.Background()
+	produto := &model.Produto{Codigo: "p1", Nome: "TV"}
+	distribuidora := &model.Fornecedor{Codigo: "f1", Nome: "Distribuidora"}
+
+	cases := map[string]struct {
+		Input       model.ProdutoFornecedor
+		ExpectedErr error
+
+		PrepareMock func(produtos *mocks.IProdutoStore, fornecedores *mocks.IFornecedorStore)
+	}{
+		"deve vincular o fornecedor": {Input: model.ProdutoFornecedor{ProdutoCodigo: "p1", FornecedorCodigo: "f1", SKU: " A-1 ", Custo: 10, PrazoEntrega: 5}, PrepareMock: func(p *mocks.IProdutoStore, f *mocks.IFornecedorStore) {
+			p.On("FindProdutoByCodigo", ctx, "p1").Return(produto, nil)
+			f.On("FindFornecedorByCodigo", ctx, "f1").Return(distribuidora, nil)
+			f.On("SaveFornecimento", ctx, &model.ProdutoFornecedor{ProdutoCodigo: "p1", FornecedorCodigo: "f1", SKU: "A-1", Custo: 10, PrazoEntrega: 5}).
+				Return(&model.ProdutoFornecedor{ProdutoCodigo: "p1", FornecedorCodigo: "f1", SKU: "A-1", Custo: 10, PrazoEntrega: 5}, nil)
+		}},
+		"deve retornar erro sem sku": {Input: model.ProdutoFornecedor{ProdutoCodigo: "p1", FornecedorCodigo: "f1", Custo: 10}, ExpectedErr: errors.New("sku do fornecedor é obrigatório"), PrepareMock: func(p *mocks.IProdutoStore, f *mocks.IFornecedorStore) {}},
+		"deve retornar erro com custo negativo": {Input: model.ProdutoFornecedor{ProdutoCodigo: "p1", FornecedorCodigo: "f1", SKU: "A-1", Custo: -1}, ExpectedErr: errors.New("custo não pode ser negativo"), PrepareMock: func(p *mocks.IProdutoStore, f *mocks.IFornecedorStore) {}},
+		"deve retornar erro com o produto inexistente": {Input: model.ProdutoFornecedor{ProdutoCodigo: "p1", FornecedorCodigo: "f1", SKU: "A-1"}, ExpectedErr: fornecedor.ErrProdutoNaoEncontrado, PrepareMock: func(p *mocks.IProdutoStore, f *mocks.IFornecedorStore) {
+			p.On("FindProdutoByCodigo", ctx, "p1").Return(&model.Produto{}, nil)
+		}},
+		"deve retornar erro com o fornecedor inexistente": {Input: model.ProdutoFornecedor{ProdutoCodigo: "p1", FornecedorCodigo: "f1", SKU: "A-1"}, ExpectedErr: fornecedor.ErrFornecedorNaoEncontrado, PrepareMock: func(p *mocks.IProdutoStore, f *mocks.IFornecedorStore) {
+			p.On("FindProdutoByCodigo", ctx, "p1").Return(produto, nil)
+			f.On("FindFornecedorByCodigo", ctx, "f1").Return(&model.Fornecedor{}, nil)
+		}},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			produtos := new(mocks.IProdutoStore)
+			fornecedores := new(mocks.IFornecedorStore)
+
+			cs.PrepareMock(produtos, fornecedores)
+
+			app := fornecedor.NewApp(&store.Container{Produto: produtos, Fornecedor: fornecedores})
+
+			_, err := app.SaveFornecimento(ctx, &cs.Input)
+
+			assert.Equal(t, cs.ExpectedErr, err)
+
+			produtos.AssertExpectations(t)
+			fornecedores.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package marca
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GianGoulart/CrudProdutos/logger"
+	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/GianGoulart/CrudProdutos/store"
+)
+
+// IMarcaApp interface de marca para implementação
+type IMarcaApp interface {
+	GetMarcas(ctx context.Context) (*[]model.Marca, error)
+	GetMarcaByCodigo(ctx context.Context, codigo string) (*model.Marca, error)
+	CreateMarca(ctx context.Context, marca *model.Marca) (*model.Marca, error)
+	UpdateMarca(ctx context.Context, marca *model.Marca) (*model.Marca, error)
+	DeleteMarca(ctx context.Context, codigo string) (*model.Marca, error)
+}
+
+var (
+	// ErrMarcaNaoEncontrada erro retornado quando a marca informada não existe
+	ErrMarcaNaoEncontrada = errors.New("marca não encontrada")
+	// ErrMarcaEmUso erro retornado ao remover uma marca associada a produtos
+	ErrMarcaEmUso = errors.New("marca possui produtos associados")
+)
+
+// NewApp cria uma nova instancia do serviço de marca
+func NewApp(store *store.Container) IMarcaApp {
+	return &appImpl{
+		stores: store,
+	}
+}
+
+type appImpl struct {
+	stores *store.Container
+}
+
+func (p *appImpl) GetMarcas(ctx context.Context) (*[]model.Marca, error) {
+	return p.stores.Marca.FindMarcas(ctx)
+}
+
+func (p *appImpl) GetMarcaByCodigo(ctx context.Context, codigo string) (*model.Marca, error) {
+	return p.stores.Marca.FindMarcaByCodigo(ctx, codigo)
+}
+
+func (p *appImpl) CreateMarca(ctx context.Context, marca *model.Marca) (*model.Marca, error) {
+	marca.PreSave()
+
+	if err := marca.Validate(); err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("codigo", marca.Codigo).Warn("app.marca.CreateMarca")
+		return nil, err
+	}
+
+	return p.stores.Marca.CreateMarca(ctx, marca)
+}
+
+func (p *appImpl) UpdateMarca(ctx context.Context, marca *model.Marca) (*model.Marca, error) {
+	if err := marca.Validate(); err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("codigo", marca.Codigo).Warn("app.marca.UpdateMarca")
+		return nil, err
+	}
+
+	atual, err := p.stores.Marca.FindMarcaByCodigo(ctx, marca.Codigo)
+	if err != nil {
+		return nil, err
+	}
+
+	if atual.Codigo == "" {
+		return nil, ErrMarcaNaoEncontrada
+	}
+
+	return p.stores.Marca.UpdateMarca(ctx, marca)
+}
+
+func (p *appImpl) DeleteMarca(ctx context.Context, codigo string) (*model.Marca, error) {
+	marca, err := p.stores.Marca.FindMarcaByCodigo(ctx, codigo)
+	if err != nil {
+		return nil, err
+	}
+
+	if marca.Codigo == "" {
+		return nil, ErrMarcaNaoEncontrada
+	}
+
+	produtos, err := p.stores.Produto.FindProdutosByMarca(ctx, codigo)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(*produtos) > 0 {
+		return nil, ErrMarcaEmUso
+	}
+
+	if err := p.stores.Marca.DeleteMarcaByCodigo(ctx, marca); err != nil {
+		return nil, err
+	}
+
+	logger.FromContext(ctx).WithField("codigo", codigo).Info("app.marca.DeleteMarca")
+
+	return marca, nil
+}
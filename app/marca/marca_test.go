@@ -0,0 +1,57 @@
+package marca_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/GianGoulart/CrudProdutos/app/marca"
+	"github.com/GianGoulart/CrudProdutos/mocks"
+	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/GianGoulart/CrudProdutos/store"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func Test_DeleteMarca(t *testing.T) {
+	ctx := context.Background()
+	sony := &model.Marca{Codigo: "sony", Nome: "Sony"}
+
+	cases := map[string]struct {
+		ExpectedErr error
+
+		PrepareMock func(produtos *mocks.IProdutoStore, marcas *mocks.IMarcaStore)
+	}{
+		"deve remover a marca sem produtos": {PrepareMock: func(p *mocks.IProdutoStore, m *mocks.IMarcaStore) {
+			m.On("FindMarcaByCodigo", ctx, "sony").Return(sony, nil)
+			p.On("FindProdutosByMarca", ctx, "sony").Return(&[]model.Produto{}, nil)
+			m.On("DeleteMarcaByCodigo", ctx, sony).Return(nil)
+		}},
+		"deve retornar erro com produtos associados": {ExpectedErr: marca.ErrMarcaEmUso, PrepareMock: func(p *mocks.IProdutoStore, m *mocks.IMarcaStore) {
+			m.On("FindMarcaByCodigo", ctx, "sony").Return(sony, nil)
+			p.On("FindProdutosByMarca", ctx, "sony").Return(&[]model.Produto{{Codigo: "p1", Marca: "sony"}}, nil)
+		}},
+		"deve retornar erro com a marca inexistente": {ExpectedErr: marca.ErrMarcaNaoEncontrada, PrepareMock: func(p *mocks.IProdutoStore, m *mocks.IMarcaStore) {
+			m.On("FindMarcaByCodigo", ctx, "sony").Return(&model.Marca{}, nil)
+		}},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			produtos := new(mocks.IProdutoStore)
+			marcas := new(mocks.IMarcaStore)
+
+			cs.PrepareMock(produtos, marcas)
+
+			app := marca.NewApp(&store.Container{Produto: produtos, Marca: marcas})
+
+			_, err := app.DeleteMarca(ctx, "sony")
+
+			if diff := cmp.Diff(err, cs.ExpectedErr, cmpopts.EquateErrors()); diff != "" {
+				t.Error(diff)
+			}
+
+			produtos.AssertExpectations(t)
+			marcas.AssertExpectations(t)
+		})
+	}
+}
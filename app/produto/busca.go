@@ -0,0 +1,153 @@
+package produto
+
+import (
+	"html"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/GianGoulart/CrudProdutos/model"
+)
+
+const (
+	destaqueInicio = "<em>"
+	destaqueFim    = "</em>"
+)
+
+// nomeNormalizado nome normalizado para busca com o mapeamento de cada letra
+// para a sua posição no nome original
+type nomeNormalizado struct {
+	original []rune
+	runes    []rune
+	origem   []int
+}
+
+func normalizar(nome string) nomeNormalizado {
+	n := nomeNormalizado{original: []rune(nome)}
+
+	for i, r := range n.original {
+		for _, f := range model.FoldRune(r) {
+			n.runes = append(n.runes, f)
+			n.origem = append(n.origem, i)
+		}
+	}
+
+	return n
+}
+
+// ocorrencias retorna as posições em que o termo aparece no nome normalizado
+func (n nomeNormalizado) ocorrencias(termo []rune) []int {
+	pos := []int{}
+
+	for i := 0; i+len(termo) <= len(n.runes); i++ {
+		if string(n.runes[i:i+len(termo)]) == string(termo) {
+			pos = append(pos, i)
+		}
+	}
+
+	return pos
+}
+
+func (n nomeNormalizado) inicioDePalavra(i int) bool {
+	return i == 0 || !unicode.IsLetter(n.runes[i-1]) && !unicode.IsDigit(n.runes[i-1])
+}
+
+func (n nomeNormalizado) fimDePalavra(i int) bool {
+	return i == len(n.runes) || !unicode.IsLetter(n.runes[i]) && !unicode.IsDigit(n.runes[i])
+}
+
+// relevancia pontua o termo no nome: 0.5 por conter, 0.25 se começar uma palavra
+// e 0.25 se for a palavra inteira, somando 0.1 quando o nome começa com o termo
+func (n nomeNormalizado) relevancia(termo []rune, pos []int) float64 {
+	melhor := 0.0
+
+	for _, i := range pos {
+		score := 0.5
+		if n.inicioDePalavra(i) {
+			score += 0.25
+			if n.fimDePalavra(i + len(termo)) {
+				score += 0.25
+			}
+		}
+		if i == 0 {
+			score += 0.1
+		}
+		if score > melhor {
+			melhor = score
+		}
+	}
+
+	return melhor
+}
+
+// destacar envolve os trechos do nome original encontrados pelos termos com <em>,
+// escapando o restante do nome para uso seguro em html
+func (n nomeNormalizado) destacar(intervalos [][2]int) string {
+	marcado := make([]bool, len(n.original))
+	for _, in := range intervalos {
+		for i := in[0]; i < in[1]; i++ {
+			marcado[n.origem[i]] = true
+		}
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(n.original); {
+		j := i
+		for j < len(n.original) && marcado[j] == marcado[i] {
+			j++
+		}
+
+		trecho := html.EscapeString(string(n.original[i:j]))
+		if marcado[i] {
+			trecho = destaqueInicio + trecho + destaqueFim
+		}
+		b.WriteString(trecho)
+		i = j
+	}
+
+	return b.String()
+}
+
+// ranquear calcula a relevancia e o destaque de cada produto para os termos,
+// descartando os que não contém nenhum termo e ordenando do mais relevante
+func ranquear(produtos []model.Produto, termos []string) []model.ResultadoBusca {
+	resultados := make([]model.ResultadoBusca, 0, len(produtos))
+
+	for _, produto := range produtos {
+		nome := normalizar(produto.Nome)
+
+		total := 0.0
+		intervalos := [][2]int{}
+		for _, t := range termos {
+			termo := []rune(t)
+			pos := nome.ocorrencias(termo)
+
+			total += nome.relevancia(termo, pos)
+			for _, i := range pos {
+				intervalos = append(intervalos, [2]int{i, i + len(termo)})
+			}
+		}
+
+		if len(intervalos) == 0 {
+			continue
+		}
+
+		resultados = append(resultados, model.ResultadoBusca{
+			Produto:    produto,
+			Relevancia: total / float64(len(termos)),
+			Destaque:   nome.destacar(intervalos),
+		})
+	}
+
+	sort.SliceStable(resultados, func(i, j int) bool {
+		if resultados[i].Relevancia != resultados[j].Relevancia {
+			return resultados[i].Relevancia > resultados[j].Relevancia
+		}
+		if len(resultados[i].Nome) != len(resultados[j].Nome) {
+			return len(resultados[i].Nome) < len(resultados[j].Nome)
+		}
+		return resultados[i].Nome < resultados[j].Nome
+	})
+
+	return resultados
+}
@@ -0,0 +1,68 @@
+package produto_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/GianGoulart/CrudProdutos/app/produto"
+	"github.com/GianGoulart/CrudProdutos/mocks"
+	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/GianGoulart/CrudProdutos/store"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_UpdateProduto_Eventos(t *testing.T) {
+	ctx := context.Background()
+
+	cases := map[string]struct {
+		Anterior    model.Produto
+		Input       model.Produto
+		ExpectedErr error
+
+		PrepareMock func(stream *mocks.IStream)
+	}{
+		"deve avisar a alteração e a mudança de estoque": {
+			Anterior: model.Produto{Codigo: "p1", PrecoDe: 10, PrecoPor: 9, EstoqueTotal: 10},
+			Input:    model.Produto{Codigo: "p1", PrecoDe: 10, PrecoPor: 9, EstoqueTotal: 7},
+			PrepareMock: func(s *mocks.IStream) {
+				s.On("Publicar", model.EventoProdutoAlterado, mock.Anything).Return().Once()
+				s.On("Publicar", model.EventoEstoqueAlterado, mock.Anything).Return().Once()
+			}},
+		"deve avisar só a alteração com o mesmo estoque": {
+			Anterior: model.Produto{Codigo: "p1", PrecoDe: 10, PrecoPor: 9, EstoqueTotal: 10, EstoqueDisponivel: 10},
+			Input:    model.Produto{Codigo: "p1", PrecoDe: 10, PrecoPor: 8, EstoqueTotal: 10},
+			PrepareMock: func(s *mocks.IStream) {
+				s.On("Publicar", model.EventoProdutoAlterado, mock.Anything).Return().Once()
+			}},
+		"não deve avisar a alteração do produto inexistente": {
+			Input:       model.Produto{Codigo: "p1", PrecoDe: 10, PrecoPor: 9, EstoqueTotal: 7},
+			ExpectedErr: produto.ErrProdutoNaoEncontrado,
+			PrepareMock: func(s *mocks.IStream) {}},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			produtos := new(mocks.IProdutoStore)
+			stream := new(mocks.IStream)
+
+			produtos.On("FindProdutoByCodigo", ctx, "p1").Return(&cs.Anterior, nil)
+			produtos.On("UpdateProduto", ctx, mock.Anything).Return(func(_ context.Context, p *model.Produto) *model.Produto {
+				p.EstoqueDisponivel = p.EstoqueTotal - p.EstoqueCorte
+				return p
+			}, nil)
+			cs.PrepareMock(stream)
+
+			app := produto.NewApp(&store.Container{Produto: produtos, Stream: stream})
+
+			_, err := app.UpdateProduto(ctx, &cs.Input)
+
+			if diff := cmp.Diff(err, cs.ExpectedErr, cmpopts.EquateErrors()); diff != "" {
+				t.Error(diff)
+			}
+
+			stream.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package produto
+
+import (
+	"context"
+
+	"github.com/GianGoulart/CrudProdutos/logger"
+	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/GianGoulart/CrudProdutos/store"
+)
+
+type ignorarLimitesKey struct{}
+
+// IgnorarLimitesPreco marca o contexto da requisição que pediu explicitamente para
+// salvar os preços fora dos limites configurados
+func IgnorarLimitesPreco(ctx context.Context) context.Context {
+	return context.WithValue(ctx, ignorarLimitesKey{}, true)
+}
+
+// LimitesIgnorados indica se a requisição pediu para ignorar os limites de preço
+func LimitesIgnorados(ctx context.Context) bool {
+	ignorar, _ := ctx.Value(ignorarLimitesKey{}).(bool)
+	return ignorar
+}
+
+// VerificarLimites verifica os limites de preço do produto em relação ao anterior,
+// nil na criação. Quando a requisição ignora os limites a violação é apenas
+// registrada. As variações, que têm preços próprios, passam pela mesma verificação
+func VerificarLimites(ctx context.Context, stores *store.Container, anterior, produto *model.Produto) error {
+	err := stores.LimitesPreco.Verificar(anterior, produto)
+
+	if !LimitesIgnorados(ctx) {
+		return err
+	}
+
+	entry := logger.FromContext(ctx).WithField("codigo", produto.Codigo).WithField("preco_de", produto.PrecoDe).WithField("preco_por", produto.PrecoPor)
+	if err != nil {
+		entry = entry.WithError(err)
+	}
+	entry.Warn("app.produto.VerificarLimites: limites de preço ignorados pela requisição")
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package produto_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/GianGoulart/CrudProdutos/app/produto"
+	"github.com/GianGoulart/CrudProdutos/mocks"
+	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/GianGoulart/CrudProdutos/store"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_LimitesPreco(t *testing.T) {
+	limites := model.LimitesPreco{DescontoMaximo: 30, VariacaoMaxima: 50, PrecoMinimo: 10, PrecoMaximo: 50000}
+	gravado := &model.Produto{Codigo: "p1", Nome: "Televisao SAMSUNG", PrecoDe: 3700, PrecoPor: 3700, EstoqueTotal: 10}
+
+	cases := map[string]struct {
+		Input       model.Produto
+		Update      bool
+		Ignorar     bool
+		ExpectedErr error
+
+		PrepareMock func(mock *mocks.IProdutoStore)
+	}{
+		"deve criar dentro dos limites": {Input: model.Produto{Nome: "TV", PrecoDe: 3700, PrecoPor: 3000}, PrepareMock: func(m *mocks.IProdutoStore) {
+			m.On("CreateProduto", mock.Anything, mock.Anything).Return(&model.Produto{Codigo: "p2"}, nil)
+		}},
+		"deve rejeitar o preço abaixo do mínimo":   {Input: model.Produto{Nome: "TV", PrecoDe: 3700, PrecoPor: 3.7}, ExpectedErr: model.ErrPrecoAbaixoDoMinimo, PrepareMock: func(m *mocks.IProdutoStore) {}},
+		"deve rejeitar o preço acima do máximo":    {Input: model.Produto{Nome: "TV", PrecoDe: 370000, PrecoPor: 370000}, ExpectedErr: model.ErrPrecoAcimaDoMaximo, PrepareMock: func(m *mocks.IProdutoStore) {}},
+		"deve rejeitar o desconto acima do máximo": {Input: model.Produto{Nome: "TV", PrecoDe: 3700, PrecoPor: 370}, ExpectedErr: model.ErrDescontoAcimaDoMaximo, PrepareMock: func(m *mocks.IProdutoStore) {}},
+		"deve rejeitar a variação acima da máxima": {Input: model.Produto{Codigo: "p1", Nome: "TV", PrecoDe: 37, PrecoPor: 37}, Update: true, ExpectedErr: model.ErrVariacaoAcimaDaMaxima, PrepareMock: func(m *mocks.IProdutoStore) {
+			m.On("FindProdutoByCodigo", mock.Anything, "p1").Return(gravado, nil)
+		}},
+		"deve aceitar a variação dentro da máxima": {Input: model.Produto{Codigo: "p1", Nome: "TV", PrecoDe: 3900, PrecoPor: 3500}, Update: true, PrepareMock: func(m *mocks.IProdutoStore) {
+			m.On("FindProdutoByCodigo", mock.Anything, "p1").Return(gravado, nil)
+			m.On("UpdateProduto", mock.Anything, mock.Anything).Return(gravado, nil)
+		}},
+		"deve salvar fora dos limites quando a requisição ignora": {Input: model.Produto{Codigo: "p1", Nome: "TV", PrecoDe: 37, PrecoPor: 37}, Update: true, Ignorar: true, PrepareMock: func(m *mocks.IProdutoStore) {
+			m.On("FindProdutoByCodigo", mock.Anything, "p1").Return(gravado, nil)
+			m.On("UpdateProduto", mock.Anything, mock.Anything).Return(gravado, nil)
+		}},
+		"não deve ignorar a validação do produto": {Input: model.Produto{Codigo: "p1", Nome: "TV", PrecoDe: 37, PrecoPor: 3700}, Update: true, Ignorar: true, ExpectedErr: model.ErrPrecoDeInferior, PrepareMock: func(m *mocks.IProdutoStore) {}},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			if cs.Ignorar {
+				ctx = produto.IgnorarLimitesPreco(ctx)
+			}
+
+			m := new(mocks.IProdutoStore)
+			cs.PrepareMock(m)
+
+			app := produto.NewApp(&store.Container{Produto: m, LimitesPreco: limites})
+
+			var err error
+			if cs.Update {
+				_, err = app.UpdateProduto(ctx, &cs.Input)
+			} else {
+				_, err = app.CreateProduto(ctx, &cs.Input)
+			}
+
+			if diff := cmp.Diff(err, cs.ExpectedErr, cmpopts.EquateErrors()); diff != "" {
+				t.Error(diff)
+			}
+
+			m.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_PreverReajusteLimitesPreco(t *testing.T) {
+	ctx := context.Background()
+	reajuste := model.Reajuste{Codigos: []string{"p1", "p2"}, Campo: model.CampoPrecos, Tipo: model.ReajustePercentual, Valor: 20}
+
+	m := new(mocks.IProdutoStore)
+	m.On("FindProdutosByCodigos", mock.Anything, reajuste.Codigos).Return(&televisores, nil)
+
+	app := produto.NewApp(&store.Container{Produto: m, LimitesPreco: model.LimitesPreco{VariacaoMaxima: 10}})
+
+	previa, err := app.PreverReajuste(ctx, reajuste)
+	if assert.NoError(t, err) {
+		assert.Equal(t, 2, previa.Invalidos)
+		assert.Contains(t, previa.Itens[0].Erro, model.ErrVariacaoAcimaDaMaxima.Error())
+	}
+
+	previa, err = app.PreverReajuste(produto.IgnorarLimitesPreco(ctx), reajuste)
+	if assert.NoError(t, err) {
+		assert.Equal(t, 0, previa.Invalidos)
+	}
+}
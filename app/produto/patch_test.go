@@ -0,0 +1,114 @@
+package produto_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/GianGoulart/CrudProdutos/app/produto"
+	"github.com/GianGoulart/CrudProdutos/mocks"
+	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/GianGoulart/CrudProdutos/store"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_PatchProduto(t *testing.T) {
+	ctx := context.Background()
+	atual := model.Produto{Codigo: "p1", Nome: "Televisao SAMSUNG", PrecoDe: 2500, PrecoPor: 2200, EstoqueTotal: 100, EstoqueCorte: 10, EstoqueDisponivel: 90, Marca: "samsung", CriadoEm: "01-01-2022T10:00:00"}
+
+	cases := map[string]struct {
+		Input        string
+		ExpectedData *model.Produto
+		ExpectedErr  error
+
+		PrepareMock func(mock *mocks.IProdutoStore, marcas *mocks.IMarcaStore)
+	}{
+		"deve alterar apenas o nome": {Input: `{"nome": "Televisao LG"}`, ExpectedData: &model.Produto{Codigo: "p1", Nome: "Televisao LG", PrecoDe: 2500, PrecoPor: 2200, EstoqueTotal: 100, EstoqueCorte: 10, EstoqueDisponivel: 90, Marca: "samsung", CriadoEm: "01-01-2022T10:00:00"}, PrepareMock: func(m *mocks.IProdutoStore, marcas *mocks.IMarcaStore) {
+			m.On("FindProdutoByCodigo", ctx, "p1").Return(&atual, nil)
+			marcas.On("FindMarcaByCodigo", ctx, "samsung").Return(&model.Marca{Codigo: "samsung"}, nil)
+			m.On("UpdateProduto", ctx, mock.Anything).Return(func(_ context.Context, p *model.Produto) *model.Produto { return p }, nil)
+		}},
+		"deve zerar o estoque de corte e recalcular o disponível": {Input: `{"estoque_corte": 0}`, ExpectedData: &model.Produto{Codigo: "p1", Nome: "Televisao SAMSUNG", PrecoDe: 2500, PrecoPor: 2200, EstoqueTotal: 100, EstoqueCorte: 0, EstoqueDisponivel: 100, Marca: "samsung", CriadoEm: "01-01-2022T10:00:00"}, PrepareMock: func(m *mocks.IProdutoStore, marcas *mocks.IMarcaStore) {
+			m.On("FindProdutoByCodigo", ctx, "p1").Return(&atual, nil)
+			marcas.On("FindMarcaByCodigo", ctx, "samsung").Return(&model.Marca{Codigo: "samsung"}, nil)
+			m.On("UpdateProduto", ctx, mock.Anything).Return(func(_ context.Context, p *model.Produto) *model.Produto { return p }, nil)
+		}},
+		"deve remover a marca com null": {Input: `{"marca": null}`, ExpectedData: &model.Produto{Codigo: "p1", Nome: "Televisao SAMSUNG", PrecoDe: 2500, PrecoPor: 2200, EstoqueTotal: 100, EstoqueCorte: 10, EstoqueDisponivel: 90, CriadoEm: "01-01-2022T10:00:00"}, PrepareMock: func(m *mocks.IProdutoStore, marcas *mocks.IMarcaStore) {
+			m.On("FindProdutoByCodigo", ctx, "p1").Return(&atual, nil)
+			m.On("UpdateProduto", ctx, mock.Anything).Return(func(_ context.Context, p *model.Produto) *model.Produto { return p }, nil)
+		}},
+		"deve validar o resultado do patch": {Input: `{"preco_de": 2000}`, ExpectedErr: model.ErrPrecoDeInferior, PrepareMock: func(m *mocks.IProdutoStore, marcas *mocks.IMarcaStore) {
+			m.On("FindProdutoByCodigo", ctx, "p1").Return(&atual, nil)
+		}},
+		"deve rejeitar campos somente leitura": {Input: `{"codigo": "p2", "estoque_disponivel": 5}`, ExpectedErr: model.ErrPatchInvalido, PrepareMock: func(m *mocks.IProdutoStore, marcas *mocks.IMarcaStore) {
+			m.On("FindProdutoByCodigo", ctx, "p1").Return(&atual, nil)
+		}},
+		"deve rejeitar campos desconhecidos": {Input: `{"preco": 10}`, ExpectedErr: model.ErrPatchInvalido, PrepareMock: func(m *mocks.IProdutoStore, marcas *mocks.IMarcaStore) {
+			m.On("FindProdutoByCodigo", ctx, "p1").Return(&atual, nil)
+		}},
+		"deve rejeitar o tipo errado": {Input: `{"estoque_total": "muito"}`, ExpectedErr: model.ErrPatchInvalido, PrepareMock: func(m *mocks.IProdutoStore, marcas *mocks.IMarcaStore) {
+			m.On("FindProdutoByCodigo", ctx, "p1").Return(&atual, nil)
+		}},
+		"deve rejeitar o patch que não é objeto": {Input: `[1, 2]`, ExpectedErr: model.ErrPatchInvalido, PrepareMock: func(m *mocks.IProdutoStore, marcas *mocks.IMarcaStore) {
+			m.On("FindProdutoByCodigo", ctx, "p1").Return(&atual, nil)
+		}},
+		"deve retornar erro com o produto inexistente": {Input: `{"nome": "x"}`, ExpectedErr: produto.ErrProdutoNaoEncontrado, PrepareMock: func(m *mocks.IProdutoStore, marcas *mocks.IMarcaStore) {
+			m.On("FindProdutoByCodigo", ctx, "p1").Return(&model.Produto{}, nil)
+		}},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			m := new(mocks.IProdutoStore)
+			marcas := new(mocks.IMarcaStore)
+			cs.PrepareMock(m, marcas)
+
+			app := produto.NewApp(&store.Container{Produto: m, Marca: marcas})
+
+			data, err := app.PatchProduto(ctx, "p1", []byte(cs.Input))
+
+			if diff := cmp.Diff(err, cs.ExpectedErr, cmpopts.EquateErrors()); diff != "" {
+				t.Error(diff)
+			}
+
+			if diff := cmp.Diff(data, cs.ExpectedData); diff != "" {
+				t.Error(diff)
+			}
+
+			m.AssertExpectations(t)
+			marcas.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_MergePatch(t *testing.T) {
+	// exemplos do apêndice A da RFC 7396
+	cases := map[string]struct {
+		Doc      string
+		Patch    string
+		Expected string
+	}{
+		"substitui o valor":        {Doc: `{"a":"b"}`, Patch: `{"a":"c"}`, Expected: `{"a":"c"}`},
+		"adiciona o membro":        {Doc: `{"a":"b"}`, Patch: `{"b":"c"}`, Expected: `{"a":"b","b":"c"}`},
+		"remove com null":          {Doc: `{"a":"b","b":"c"}`, Patch: `{"a":null}`, Expected: `{"b":"c"}`},
+		"combina os objetos":       {Doc: `{"a":{"b":"c"}}`, Patch: `{"a":{"b":"d","c":null}}`, Expected: `{"a":{"b":"d"}}`},
+		"substitui as listas":      {Doc: `{"a":[{"b":"c"}]}`, Patch: `{"a":[1]}`, Expected: `{"a":[1]}`},
+		"substitui o documento":    {Doc: `{"a":"foo"}`, Patch: `"bar"`, Expected: `"bar"`},
+		"mantém o documento vazio": {Doc: `{}`, Patch: `{"a":{"bb":{"ccc":null}}}`, Expected: `{"a":{"bb":{}}}`},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			var doc, patch interface{}
+			assert.NoError(t, json.Unmarshal([]byte(cs.Doc), &doc))
+			assert.NoError(t, json.Unmarshal([]byte(cs.Patch), &patch))
+
+			res, err := json.Marshal(model.MergePatch(doc, patch))
+			assert.NoError(t, err)
+			assert.JSONEq(t, cs.Expected, string(res))
+		})
+	}
+}
@@ -2,21 +2,50 @@ package produto
 
 import (
 	"context"
+	"errors"
 
+	"github.com/GianGoulart/CrudProdutos/app/alerta"
+	"github.com/GianGoulart/CrudProdutos/app/evento"
+	"github.com/GianGoulart/CrudProdutos/app/promocao"
+	"github.com/GianGoulart/CrudProdutos/logger"
 	"github.com/GianGoulart/CrudProdutos/model"
 	"github.com/GianGoulart/CrudProdutos/store"
+	"github.com/GianGoulart/CrudProdutos/store/cache"
+	"github.com/GianGoulart/CrudProdutos/stream"
 )
 
 // App interface de health para implementação
 type IProdutoApp interface {
 	GetProdutos(ctx context.Context) (*[]model.Produto, error)
+	FiltrarProdutos(ctx context.Context, filtro model.FiltroProduto) (*[]model.Produto, error)
 	GetProdutoByCodigo(ctx context.Context, codigo string) (*model.Produto, error)
 	GetProdutoByNome(ctx context.Context, nome string) (*[]model.Produto, error)
+	BuscarProdutos(ctx context.Context, q string, limite int) (*[]model.ResultadoBusca, error)
 	CreateProduto(ctx context.Context, produto *model.Produto) (*model.Produto, error)
 	UpdateProduto(ctx context.Context, produto *model.Produto) (*model.Produto, error)
+	PatchProduto(ctx context.Context, codigo string, patch []byte) (*model.Produto, error)
 	DeleteProduto(ctx context.Context, codigo string) (*model.Produto, error)
+	GetCacheStats(ctx context.Context) (*cache.Stats, error)
+	GetSugestoes(ctx context.Context, q string, limite int) (*[]model.Sugestao, error)
+	RebuildIndice(ctx context.Context) (int, error)
+	AssinarEventos(ctx context.Context, filtro stream.Filtro, ultimoID string) (*stream.Assinatura, bool, error)
+	PreverReajuste(ctx context.Context, reajuste model.Reajuste) (*model.PreviaReajuste, error)
+	AplicarReajuste(ctx context.Context, reajuste model.Reajuste) (*model.PreviaReajuste, error)
 }
 
+var (
+	// ErrCacheDesabilitado erro retornado ao consultar as estatísticas com o cache desabilitado
+	ErrCacheDesabilitado = errors.New("cache desabilitado")
+	// ErrIndiceDesabilitado erro retornado ao consultar as sugestões com o índice desabilitado
+	ErrIndiceDesabilitado = errors.New("índice de sugestões desabilitado")
+	// ErrMarcaNaoEncontrada erro retornado ao salvar um produto com uma marca inexistente
+	ErrMarcaNaoEncontrada = errors.New("marca não encontrada")
+	// ErrProdutoNaoEncontrado erro retornado ao alterar parcialmente um produto inexistente
+	ErrProdutoNaoEncontrado = errors.New("produto não encontrado")
+	// ErrStreamDesabilitado erro retornado ao assinar os eventos com o stream desabilitado
+	ErrStreamDesabilitado = errors.New("stream de eventos desabilitado")
+)
+
 // NewApp cria uma nova instancia do serviço de health
 func NewApp(store *store.Container) IProdutoApp {
 	return &appImpl{
@@ -29,21 +58,202 @@ type appImpl struct {
 }
 
 func (p *appImpl) GetProdutos(ctx context.Context) (*[]model.Produto, error) {
-	return p.stores.Produto.FindProdutos(ctx)
+	produtos, err := p.stores.Produto.FindProdutos(ctx)
+	if err != nil {
+		return produtos, err
+	}
+
+	return p.promover(ctx, produtos)
+}
+
+// FiltrarProdutos lista os produtos que atendem a todos os filtros informados.
+// O filtro de categoria inclui os produtos das categorias descendentes
+func (p *appImpl) FiltrarProdutos(ctx context.Context, filtro model.FiltroProduto) (*[]model.Produto, error) {
+	produtos, err := p.filtrar(ctx, filtro)
+	if err != nil {
+		return produtos, err
+	}
+
+	return p.promover(ctx, produtos)
+}
+
+// filtrar lista os produtos do filtro sem os preços promocionais
+func (p *appImpl) filtrar(ctx context.Context, filtro model.FiltroProduto) (*[]model.Produto, error) {
+	if filtro.Vazio() {
+		return p.stores.Produto.FindProdutos(ctx)
+	}
+
+	// codigos dos produtos que atendem aos filtros por associação, nil enquanto
+	// nenhum deles for aplicado
+	var codigos []string
+
+	if filtro.Categoria != "" {
+		categorias, err := p.stores.Categoria.FindCategorias(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		encontrados, err := p.stores.Categoria.FindProdutosByCategorias(ctx, model.Descendentes(*categorias, filtro.Categoria))
+		if err != nil {
+			return nil, err
+		}
+
+		codigos = intersecao(codigos, encontrados)
+	}
+
+	if filtro.Fornecedor != "" {
+		encontrados, err := p.stores.Fornecedor.FindProdutosByFornecedor(ctx, filtro.Fornecedor)
+		if err != nil {
+			return nil, err
+		}
+
+		codigos = intersecao(codigos, encontrados)
+	}
+
+	if filtro.Marca == "" {
+		return p.stores.Produto.FindProdutosByCodigos(ctx, codigos)
+	}
+
+	produtos, err := p.stores.Produto.FindProdutosByMarca(ctx, filtro.Marca)
+	if err != nil || codigos == nil {
+		return produtos, err
+	}
+
+	permitidos := make(map[string]bool, len(codigos))
+	for _, codigo := range codigos {
+		permitidos[codigo] = true
+	}
+
+	filtrados := []model.Produto{}
+	for _, produto := range *produtos {
+		if permitidos[produto.Codigo] {
+			filtrados = append(filtrados, produto)
+		}
+	}
+
+	return &filtrados, nil
 }
 
+// intersecao retorna os codigos presentes nas duas listas, considerando uma
+// lista nil como ainda não filtrada
+func intersecao(atuais, novos []string) []string {
+	if atuais == nil {
+		return novos
+	}
+
+	presentes := make(map[string]bool, len(novos))
+	for _, codigo := range novos {
+		presentes[codigo] = true
+	}
+
+	codigos := []string{}
+	for _, codigo := range atuais {
+		if presentes[codigo] {
+			codigos = append(codigos, codigo)
+		}
+	}
+
+	return codigos
+}
+
+// GetProdutoByCodigo retorna o produto junto com as suas variações e o preço promocional
 func (p *appImpl) GetProdutoByCodigo(ctx context.Context, codigo string) (*model.Produto, error) {
-	return p.stores.Produto.FindProdutoByCodigo(ctx, codigo)
+	produto, err := p.stores.Produto.FindProdutoByCodigo(ctx, codigo)
+	if err != nil || produto == nil || produto.Codigo == "" {
+		return produto, err
+	}
+
+	if p.stores.Variacao != nil {
+		variacoes, err := p.stores.Variacao.FindVariacoesByProduto(ctx, codigo)
+		if err != nil {
+			return nil, err
+		}
+
+		produto.Variacoes = *variacoes
+	}
+
+	produtos := []model.Produto{*produto}
+	if err := promocao.Aplicar(ctx, p.stores, produtos); err != nil {
+		return nil, err
+	}
+
+	return &produtos[0], nil
 }
 
 func (p *appImpl) GetProdutoByNome(ctx context.Context, nome string) (*[]model.Produto, error) {
-	return p.stores.Produto.FindProdutoByNome(ctx, nome)
+	produtos, err := p.stores.Produto.FindProdutoByNome(ctx, nome)
+	if err != nil {
+		return produtos, err
+	}
+
+	return p.promover(ctx, produtos)
+}
+
+// promover preenche o preço promocional dos produtos lidos do repositorio
+func (p *appImpl) promover(ctx context.Context, produtos *[]model.Produto) (*[]model.Produto, error) {
+	if produtos == nil {
+		return produtos, nil
+	}
+
+	if err := promocao.Aplicar(ctx, p.stores, *produtos); err != nil {
+		return nil, err
+	}
+
+	return produtos, nil
+}
+
+// BuscarProdutos busca os produtos que contém algum dos termos no nome, ignorando
+// acentos e caixa, ordenados por relevancia e limitados ao número informado.
+// As promoções são aplicadas apenas aos resultados retornados
+func (p *appImpl) BuscarProdutos(ctx context.Context, q string, limite int) (*[]model.ResultadoBusca, error) {
+	termos := model.Termos(q)
+	if len(termos) == 0 {
+		return &[]model.ResultadoBusca{}, nil
+	}
+
+	produtos, err := p.stores.Produto.SearchProdutos(ctx, termos)
+	if err != nil {
+		return nil, err
+	}
+
+	resultados := ranquear(*produtos, termos)
+	if limite > 0 && len(resultados) > limite {
+		resultados = resultados[:limite]
+	}
+
+	encontrados := make([]model.Produto, len(resultados))
+	for i := range resultados {
+		encontrados[i] = resultados[i].Produto
+	}
+
+	if _, err := p.promover(ctx, &encontrados); err != nil {
+		return nil, err
+	}
+
+	for i := range resultados {
+		resultados[i].Produto = encontrados[i]
+	}
+
+	return &resultados, nil
 }
 
 func (p *appImpl) CreateProduto(ctx context.Context, produto *model.Produto) (*model.Produto, error) {
 	produto.PreSave()
+	// as variações são mantidas pelas rotas próprias e as promoções são calculadas na leitura
+	produto.Variacoes = nil
+	produto.PrecoPromocional, produto.Promocoes = 0, nil
 
 	if err := produto.Validate(); err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("codigo", produto.Codigo).Warn("app.produto.CreateProduto")
+		return nil, err
+	}
+
+	if err := VerificarLimites(ctx, p.stores, nil, produto); err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("codigo", produto.Codigo).Warn("app.produto.CreateProduto")
+		return nil, err
+	}
+
+	if err := p.validarMarca(ctx, produto); err != nil {
 		return nil, err
 	}
 
@@ -52,22 +262,114 @@ func (p *appImpl) CreateProduto(ctx context.Context, produto *model.Produto) (*m
 		return nil, err
 	}
 
+	p.indexar(ctx, produto)
+	alerta.Avaliar(ctx, p.stores, nil, produto)
+	evento.Emitir(ctx, p.stores, model.EventoProdutoCriado, produto)
+
 	return produto, err
 
 }
 
 func (p *appImpl) UpdateProduto(ctx context.Context, produto *model.Produto) (*model.Produto, error) {
+	produto.Variacoes = nil
+	produto.PrecoPromocional, produto.Promocoes = 0, nil
+
+	// o estoque de um produto com variações é sempre a soma delas
+	if p.stores.Variacao != nil {
+		variacoes, err := p.stores.Variacao.FindVariacoesByProduto(ctx, produto.Codigo)
+		if err != nil {
+			return nil, err
+		}
+
+		model.AgregarEstoque(produto, *variacoes)
+	}
+
+	// assim como o estoque por depósito, que soma apenas os depósitos ativos
+	if p.stores.Deposito != nil {
+		estoques, err := p.stores.Deposito.FindEstoquesByProduto(ctx, produto.Codigo)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(*estoques) > 0 {
+			depositos, err := p.stores.Deposito.FindDepositos(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			model.AgregarEstoqueDepositos(produto, *estoques, *depositos)
+		}
+	}
+
 	if err := produto.Validate(); err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("codigo", produto.Codigo).Warn("app.produto.UpdateProduto")
 		return nil, err
 	}
+
+	if err := p.validarMarca(ctx, produto); err != nil {
+		return nil, err
+	}
+
+	// o estado anterior só é necessário para avisar a mudança de estoque, para
+	// alertar apenas quando o estoque atinge o limite e para verificar a variação
+	// dos preços
+	var anterior *model.Produto
+	if evento.Habilitado(p.stores) || p.stores.Alerta != nil || p.stores.LimitesPreco.VariacaoMaxima > 0 {
+		var err error
+		if anterior, err = p.stores.Produto.FindProdutoByCodigo(ctx, produto.Codigo); err != nil {
+			return nil, err
+		}
+
+		// sem o produto não há o que avisar nem alertar
+		if anterior != nil && anterior.Codigo == "" {
+			return nil, ErrProdutoNaoEncontrado
+		}
+	}
+
+	if err := VerificarLimites(ctx, p.stores, anterior, produto); err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("codigo", produto.Codigo).Warn("app.produto.UpdateProduto")
+		return nil, err
+	}
+
 	produto, err := p.stores.Produto.UpdateProduto(ctx, produto)
 	if err != nil {
 		return nil, err
 	}
 
+	p.indexar(ctx, produto)
+	alerta.Avaliar(ctx, p.stores, anterior, produto)
+
+	if anterior != nil {
+		evento.Emitir(ctx, p.stores, model.EventoProdutoAlterado, produto)
+		if model.EstoqueAlterado(anterior, produto) {
+			evento.Emitir(ctx, p.stores, model.EventoEstoqueAlterado, produto)
+		}
+	}
+
 	return produto, err
 }
 
+// PatchProduto aplica o merge patch (RFC 7396) sobre o produto cadastrado e salva
+// o resultado pelas mesmas regras do UpdateProduto
+func (p *appImpl) PatchProduto(ctx context.Context, codigo string, patch []byte) (*model.Produto, error) {
+	atual, err := p.stores.Produto.FindProdutoByCodigo(ctx, codigo)
+	if err != nil {
+		return nil, err
+	}
+
+	if atual == nil || atual.Codigo == "" {
+		return nil, ErrProdutoNaoEncontrado
+	}
+
+	produto, err := model.AplicarPatch(atual, patch)
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("codigo", codigo).Warn("app.produto.PatchProduto")
+		return nil, err
+	}
+
+	return p.UpdateProduto(ctx, produto)
+}
+
 func (p *appImpl) DeleteProduto(ctx context.Context, codigo string) (*model.Produto, error) {
 
 	produto, err := p.stores.Produto.FindProdutoByCodigo(ctx, codigo)
@@ -79,5 +381,125 @@ func (p *appImpl) DeleteProduto(ctx context.Context, codigo string) (*model.Prod
 		return nil, err
 	}
 
+	if p.stores.Categoria != nil {
+		if err := p.stores.Categoria.SetProdutoCategorias(ctx, codigo, nil); err != nil {
+			logger.FromContext(ctx).WithError(err).WithField("codigo", codigo).Error("app.produto.categoria.SetProdutoCategorias")
+		}
+	}
+
+	if p.stores.Fornecedor != nil {
+		if err := p.stores.Fornecedor.DeleteFornecimentosByProduto(ctx, codigo); err != nil {
+			logger.FromContext(ctx).WithError(err).WithField("codigo", codigo).Error("app.produto.fornecedor.DeleteFornecimentosByProduto")
+		}
+	}
+
+	if p.stores.Variacao != nil {
+		if err := p.stores.Variacao.DeleteVariacoesByProduto(ctx, codigo); err != nil {
+			logger.FromContext(ctx).WithError(err).WithField("codigo", codigo).Error("app.produto.variacao.DeleteVariacoesByProduto")
+		}
+	}
+
+	if p.stores.Deposito != nil {
+		if err := p.stores.Deposito.DeleteEstoquesByProduto(ctx, codigo); err != nil {
+			logger.FromContext(ctx).WithError(err).WithField("codigo", codigo).Error("app.produto.deposito.DeleteEstoquesByProduto")
+		}
+	}
+
+	if p.stores.Promocao != nil {
+		if err := p.stores.Promocao.DeleteAlvosByProduto(ctx, codigo); err != nil {
+			logger.FromContext(ctx).WithError(err).WithField("codigo", codigo).Error("app.produto.promocao.DeleteAlvosByProduto")
+		}
+	}
+
+	if p.stores.Indice != nil {
+		if err := p.stores.Indice.Delete(ctx, codigo); err != nil {
+			logger.FromContext(ctx).WithError(err).WithField("codigo", codigo).Error("app.produto.indice.Delete")
+		}
+	}
+
+	logger.FromContext(ctx).WithField("codigo", codigo).Info("app.produto.DeleteProduto")
+
+	evento.Emitir(ctx, p.stores, model.EventoProdutoRemovido, produto)
+
 	return produto, nil
 }
+
+func (p *appImpl) GetCacheStats(ctx context.Context) (*cache.Stats, error) {
+	if p.stores.Cache == nil {
+		return nil, ErrCacheDesabilitado
+	}
+
+	stats := p.stores.Cache.Stats()
+
+	return &stats, nil
+}
+
+func (p *appImpl) GetSugestoes(ctx context.Context, q string, limite int) (*[]model.Sugestao, error) {
+	if p.stores.Indice == nil {
+		return nil, ErrIndiceDesabilitado
+	}
+
+	return p.stores.Indice.Suggest(ctx, q, limite)
+}
+
+// RebuildIndice recria o índice de sugestões a partir dos produtos do repositorio
+func (p *appImpl) RebuildIndice(ctx context.Context) (int, error) {
+	if p.stores.Indice == nil {
+		return 0, ErrIndiceDesabilitado
+	}
+
+	produtos, err := p.stores.Produto.FindProdutos(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := p.stores.Indice.Rebuild(ctx, *produtos); err != nil {
+		return 0, err
+	}
+
+	return len(*produtos), nil
+}
+
+// AssinarEventos assina os eventos de produto a partir do último id recebido pelo
+// cliente. O retorno false indica que parte dos eventos seguintes a esse id não
+// está mais disponível
+func (p *appImpl) AssinarEventos(ctx context.Context, filtro stream.Filtro, ultimoID string) (*stream.Assinatura, bool, error) {
+	if p.stores.Stream == nil {
+		return nil, false, ErrStreamDesabilitado
+	}
+
+	assinatura, completo := p.stores.Stream.Assinar(filtro, ultimoID)
+
+	return assinatura, completo, nil
+}
+
+// indexar mantém o índice de sugestões em dia, uma falha aqui não desfaz a escrita
+// no repositorio e pode ser corrigida com a recriação do índice
+func (p *appImpl) indexar(ctx context.Context, produto *model.Produto) {
+	if p.stores.Indice == nil || produto == nil {
+		return
+	}
+
+	if err := p.stores.Indice.Index(ctx, produto); err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("codigo", produto.Codigo).Error("app.produto.indice.Index")
+	}
+}
+
+// validarMarca garante que a marca informada no produto está cadastrada
+func (p *appImpl) validarMarca(ctx context.Context, produto *model.Produto) error {
+	if produto.Marca == "" {
+		return nil
+	}
+
+	marca, err := p.stores.Marca.FindMarcaByCodigo(ctx, produto.Marca)
+	if err != nil {
+		return err
+	}
+
+	if marca.Codigo == "" {
+		logger.FromContext(ctx).WithError(ErrMarcaNaoEncontrada).WithField("marca", produto.Marca).Warn("app.produto.validarMarca")
+		return ErrMarcaNaoEncontrada
+	}
+
+	return nil
+}
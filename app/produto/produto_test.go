@@ -10,6 +10,8 @@ import (
 	"github.com/GianGoulart/CrudProdutos/model"
 	"github.com/GianGoulart/CrudProdutos/store"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/stretchr/testify/mock"
 )
 
 var (
@@ -278,3 +280,182 @@ func Test_DeleteProduto(t *testing.T) {
 		})
 	}
 }
+
+func Test_BuscarProdutos(t *testing.T) {
+	ctx := context.Background()
+
+	produtos := []model.Produto{
+		{Codigo: "1", Nome: "Suporte para Televisão"},
+		{Codigo: "2", Nome: "Televisão SONY 50"},
+		{Codigo: "3", Nome: "Televisão <LG> 50"},
+		{Codigo: "4", Nome: "Geladeira"},
+	}
+
+	cases := map[string]struct {
+		InputQ      string
+		InputLimite int
+
+		ExpectedCodigos     []string
+		ExpectedDestaque    []string
+		ExpectedPromocional []float64
+
+		PrepareMock func(mock *mocks.IProdutoStore)
+	}{
+		"deve ignorar acentos e ordenar por relevancia": {InputQ: "televisao sony", ExpectedCodigos: []string{"2", "3", "1"}, ExpectedDestaque: []string{
+			"<em>Televisão</em> <em>SONY</em> 50",
+			"<em>Televisão</em> &lt;LG&gt; 50",
+			"Suporte para <em>Televisão</em>",
+		}, PrepareMock: func(mock *mocks.IProdutoStore) {
+			mock.On("SearchProdutos", ctx, []string{"televisao", "sony"}).Return(&produtos, nil)
+		}},
+		"deve limitar os resultados": {InputQ: "TELEVISÃO", InputLimite: 1, ExpectedCodigos: []string{"3"}, ExpectedDestaque: []string{
+			"<em>Televisão</em> &lt;LG&gt; 50",
+		}, PrepareMock: func(mock *mocks.IProdutoStore) {
+			mock.On("SearchProdutos", ctx, []string{"televisao"}).Return(&produtos, nil)
+		}},
+		"deve retornar vazio sem termos": {InputQ: "   ", ExpectedCodigos: []string{}, ExpectedDestaque: []string{}, PrepareMock: func(mock *mocks.IProdutoStore) {}},
+		"deve aplicar as promoções aos resultados": {InputQ: "sony", ExpectedCodigos: []string{"2"}, ExpectedDestaque: []string{"Televisão <em>SONY</em> 50"}, ExpectedPromocional: []float64{2000}, PrepareMock: func(mock *mocks.IProdutoStore) {
+			mock.On("SearchProdutos", ctx, []string{"sony"}).Return(&[]model.Produto{{Codigo: "2", Nome: "Televisão SONY 50", PrecoDe: 2500, PrecoPor: 2500}}, nil)
+		}},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			promocoes := new(mocks.IPromocaoStore)
+			promocoes.On("FindPromocoesVigentes", ctx, mock.Anything).Return(&[]model.Promocao{
+				{Codigo: "sony", Nome: "Sony", Tipo: model.DescontoFixo, Valor: 500, Produtos: []string{"2"}},
+			}, nil)
+
+			mock := new(mocks.IProdutoStore)
+
+			cs.PrepareMock(mock)
+
+			app := produto.NewApp(&store.Container{Produto: mock, Promocao: promocoes})
+
+			data, err := app.BuscarProdutos(ctx, cs.InputQ, cs.InputLimite)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			codigos, destaques, promocionais := []string{}, []string{}, []float64{}
+			for _, r := range *data {
+				codigos = append(codigos, r.Codigo)
+				destaques = append(destaques, r.Destaque)
+				promocionais = append(promocionais, r.PrecoPromocional)
+			}
+
+			if diff := cmp.Diff(codigos, cs.ExpectedCodigos); diff != "" {
+				t.Error(diff)
+			}
+
+			if diff := cmp.Diff(destaques, cs.ExpectedDestaque); diff != "" {
+				t.Error(diff)
+			}
+
+			if cs.ExpectedPromocional != nil {
+				if diff := cmp.Diff(promocionais, cs.ExpectedPromocional); diff != "" {
+					t.Error(diff)
+				}
+			}
+		})
+	}
+}
+
+func Test_FiltrarProdutos(t *testing.T) {
+	ctx := context.Background()
+
+	categorias := []model.Categoria{
+		{Codigo: "eletro", Nome: "Eletrodomésticos"},
+		{Codigo: "tv", Nome: "Televisores", Pai: "eletro"},
+		{Codigo: "oled", Nome: "OLED", Pai: "tv"},
+		{Codigo: "info", Nome: "Informática"},
+	}
+
+	cases := map[string]struct {
+		Input        model.FiltroProduto
+		ExpectedData *[]model.Produto
+
+		PrepareMock func(produtos *mocks.IProdutoStore, categorias *mocks.ICategoriaStore, fornecedores *mocks.IFornecedorStore)
+	}{
+		"deve incluir as categorias descendentes": {Input: model.FiltroProduto{Categoria: "tv"}, ExpectedData: &res, PrepareMock: func(p *mocks.IProdutoStore, c *mocks.ICategoriaStore, f *mocks.IFornecedorStore) {
+			c.On("FindCategorias", ctx).Return(&categorias, nil)
+			c.On("FindProdutosByCategorias", ctx, []string{"tv", "oled"}).Return([]string{res[0].Codigo}, nil)
+			p.On("FindProdutosByCodigos", ctx, []string{res[0].Codigo}).Return(&res, nil)
+		}},
+		"deve listar todos sem filtro": {ExpectedData: &res, PrepareMock: func(p *mocks.IProdutoStore, c *mocks.ICategoriaStore, f *mocks.IFornecedorStore) {
+			p.On("FindProdutos", ctx).Return(&res, nil)
+		}},
+		"deve filtrar por marca": {Input: model.FiltroProduto{Marca: "samsung"}, ExpectedData: &res, PrepareMock: func(p *mocks.IProdutoStore, c *mocks.ICategoriaStore, f *mocks.IFornecedorStore) {
+			p.On("FindProdutosByMarca", ctx, "samsung").Return(&res, nil)
+		}},
+		"deve combinar os filtros": {Input: model.FiltroProduto{Categoria: "tv", Fornecedor: "f1", Marca: "samsung"}, ExpectedData: &[]model.Produto{{Codigo: "b", Marca: "samsung"}}, PrepareMock: func(p *mocks.IProdutoStore, c *mocks.ICategoriaStore, f *mocks.IFornecedorStore) {
+			c.On("FindCategorias", ctx).Return(&categorias, nil)
+			c.On("FindProdutosByCategorias", ctx, []string{"tv", "oled"}).Return([]string{"a", "b", "c"}, nil)
+			f.On("FindProdutosByFornecedor", ctx, "f1").Return([]string{"b", "c", "d"}, nil)
+			p.On("FindProdutosByMarca", ctx, "samsung").Return(&[]model.Produto{{Codigo: "b", Marca: "samsung"}, {Codigo: "d", Marca: "samsung"}}, nil)
+		}},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			produtos := new(mocks.IProdutoStore)
+			categorias := new(mocks.ICategoriaStore)
+			fornecedores := new(mocks.IFornecedorStore)
+
+			cs.PrepareMock(produtos, categorias, fornecedores)
+
+			app := produto.NewApp(&store.Container{Produto: produtos, Categoria: categorias, Fornecedor: fornecedores})
+
+			data, err := app.FiltrarProdutos(ctx, cs.Input)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(data, cs.ExpectedData); diff != "" {
+				t.Error(diff)
+			}
+
+			produtos.AssertExpectations(t)
+			categorias.AssertExpectations(t)
+			fornecedores.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_CreateProduto_Marca(t *testing.T) {
+	ctx := context.Background()
+
+	cases := map[string]struct {
+		ExpectedErr error
+
+		PrepareMock func(produtos *mocks.IProdutoStore, marcas *mocks.IMarcaStore)
+	}{
+		"deve aceitar a marca cadastrada": {PrepareMock: func(p *mocks.IProdutoStore, m *mocks.IMarcaStore) {
+			m.On("FindMarcaByCodigo", ctx, "sony").Return(&model.Marca{Codigo: "sony", Nome: "Sony"}, nil)
+			p.On("CreateProduto", ctx, mock.Anything).Return(&model.Produto{Nome: "TV", Marca: "sony"}, nil)
+		}},
+		"deve retornar erro com a marca inexistente": {ExpectedErr: produto.ErrMarcaNaoEncontrada, PrepareMock: func(p *mocks.IProdutoStore, m *mocks.IMarcaStore) {
+			m.On("FindMarcaByCodigo", ctx, "sony").Return(&model.Marca{}, nil)
+		}},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			produtos := new(mocks.IProdutoStore)
+			marcas := new(mocks.IMarcaStore)
+
+			cs.PrepareMock(produtos, marcas)
+
+			app := produto.NewApp(&store.Container{Produto: produtos, Marca: marcas})
+
+			_, err := app.CreateProduto(ctx, &model.Produto{Nome: "TV", PrecoDe: 10, PrecoPor: 9, Marca: "sony"})
+
+			if diff := cmp.Diff(err, cs.ExpectedErr, cmpopts.EquateErrors()); diff != "" {
+				t.Error(diff)
+			}
+
+			produtos.AssertExpectations(t)
+			marcas.AssertExpectations(t)
+		})
+	}
+}
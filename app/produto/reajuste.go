@@ -0,0 +1,130 @@
+package produto
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/GianGoulart/CrudProdutos/app/evento"
+	"github.com/GianGoulart/CrudProdutos/logger"
+	"github.com/GianGoulart/CrudProdutos/model"
+	produtoStore "github.com/GianGoulart/CrudProdutos/store/produto"
+)
+
+var (
+	// ErrConfirmacaoObrigatoria erro retornado ao aplicar um reajuste sem a confirmação da prévia
+	ErrConfirmacaoObrigatoria = errors.New("informe a confirmação da prévia do reajuste")
+	// ErrPreviaDesatualizada erro retornado quando os produtos mudaram depois da prévia
+	ErrPreviaDesatualizada = errors.New("os produtos foram alterados depois da prévia, gere uma nova prévia")
+	// ErrReajusteInvalido erro retornado quando algum produto ficaria inválido com o reajuste
+	ErrReajusteInvalido = errors.New("o reajuste deixaria produtos inválidos")
+)
+
+// PreverReajuste calcula os novos preços dos produtos selecionados sem alterá-los.
+// A confirmação retornada identifica a prévia e é exigida para aplicar o reajuste
+func (p *appImpl) PreverReajuste(ctx context.Context, reajuste model.Reajuste) (*model.PreviaReajuste, error) {
+	if err := reajuste.Validate(); err != nil {
+		logger.FromContext(ctx).WithError(err).Warn("app.produto.PreverReajuste")
+		return nil, err
+	}
+
+	var produtos *[]model.Produto
+	var err error
+	if len(reajuste.Codigos) > 0 {
+		produtos, err = p.stores.Produto.FindProdutosByCodigos(ctx, reajuste.Codigos)
+	} else {
+		produtos, err = p.filtrar(ctx, reajuste.Filtro())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	previa := &model.PreviaReajuste{
+		Selecionados: len(*produtos),
+		Itens:        []model.ItemReajuste{},
+	}
+
+	hash := sha256.New()
+	for _, produto := range *produtos {
+		item := reajuste.Aplicar(produto)
+		if !item.Alterado() {
+			continue
+		}
+
+		if err := p.validarReajuste(ctx, produto, item); err != nil {
+			item.Erro = err.Error()
+			previa.Invalidos++
+		}
+
+		previa.Itens = append(previa.Itens, item)
+		fmt.Fprintf(hash, "%s|%v|%v|%v|%v\n", item.Codigo, item.PrecoDeAnterior, item.PrecoPorAnterior, item.PrecoDe, item.PrecoPor)
+	}
+
+	previa.Alterados = len(previa.Itens)
+	previa.Confirmacao = hex.EncodeToString(hash.Sum(nil))
+
+	return previa, nil
+}
+
+// AplicarReajuste aplica o reajuste da prévia confirmada numa única transação.
+// Nenhum produto é alterado quando algum deles ficaria inválido
+func (p *appImpl) AplicarReajuste(ctx context.Context, reajuste model.Reajuste) (*model.PreviaReajuste, error) {
+	if reajuste.Confirmacao == "" {
+		return nil, ErrConfirmacaoObrigatoria
+	}
+
+	previa, err := p.PreverReajuste(ctx, reajuste)
+	if err != nil {
+		return nil, err
+	}
+
+	if previa.Confirmacao != reajuste.Confirmacao {
+		logger.FromContext(ctx).WithError(ErrPreviaDesatualizada).Warn("app.produto.AplicarReajuste")
+		return nil, ErrPreviaDesatualizada
+	}
+
+	if previa.Invalidos > 0 {
+		logger.FromContext(ctx).WithError(ErrReajusteInvalido).WithField("invalidos", previa.Invalidos).Warn("app.produto.AplicarReajuste")
+		return previa, ErrReajusteInvalido
+	}
+
+	if len(previa.Itens) == 0 {
+		return previa, nil
+	}
+
+	produtos, err := p.stores.Produto.ReajustarPrecos(ctx, previa.Itens)
+	if errors.Is(err, produtoStore.ErrPrecoAlterado) {
+		return nil, ErrPreviaDesatualizada
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	logger.FromContext(ctx).WithField("alterados", len(*produtos)).Info("app.produto.AplicarReajuste")
+
+	for i := range *produtos {
+		evento.Emitir(ctx, p.stores, model.EventoProdutoAlterado, &(*produtos)[i])
+	}
+
+	return previa, nil
+}
+
+// validarReajuste valida o produto com os novos preços do item, inclusive os
+// limites de preço em relação aos preços atuais
+func (p *appImpl) validarReajuste(ctx context.Context, produto model.Produto, item model.ItemReajuste) error {
+	if item.PrecoDe < 0 || item.PrecoPor < 0 {
+		return errors.New("preço não pode ser negativo")
+	}
+
+	anterior := produto
+	produto.PrecoDe = item.PrecoDe
+	produto.PrecoPor = item.PrecoPor
+
+	if err := produto.Validate(); err != nil {
+		return err
+	}
+
+	return VerificarLimites(ctx, p.stores, &anterior, &produto)
+}
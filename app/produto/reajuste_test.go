@@ -0,0 +1,144 @@
+package produto_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/GianGoulart/CrudProdutos/app/produto"
+	"github.com/GianGoulart/CrudProdutos/mocks"
+	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/GianGoulart/CrudProdutos/store"
+	produtoStore "github.com/GianGoulart/CrudProdutos/store/produto"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var televisores = []model.Produto{
+	{Codigo: "p1", Nome: "Televisao SAMSUNG", PrecoDe: 2500, PrecoPor: 2200},
+	{Codigo: "p2", Nome: "Televisao LG", PrecoDe: 1999.9, PrecoPor: 1999.9},
+}
+
+func Test_PreverReajuste(t *testing.T) {
+	ctx := context.Background()
+
+	cases := map[string]struct {
+		Input         model.Reajuste
+		ExpectedItens []model.ItemReajuste
+		ExpectedErr   bool
+
+		PrepareMock func(mock *mocks.IProdutoStore)
+	}{
+		"deve aumentar os dois preços em percentual": {Input: model.Reajuste{Marca: "tv", Campo: model.CampoPrecos, Tipo: model.ReajustePercentual, Valor: 5}, ExpectedItens: []model.ItemReajuste{
+			{Codigo: "p1", Nome: "Televisao SAMSUNG", PrecoDeAnterior: 2500, PrecoPorAnterior: 2200, PrecoDe: 2625, PrecoPor: 2310},
+			{Codigo: "p2", Nome: "Televisao LG", PrecoDeAnterior: 1999.9, PrecoPorAnterior: 1999.9, PrecoDe: 2099.9, PrecoPor: 2099.9},
+		}, PrepareMock: func(m *mocks.IProdutoStore) {
+			m.On("FindProdutosByMarca", ctx, "tv").Return(&televisores, nil)
+		}},
+		"deve calcular o preço por sobre o preço de": {Input: model.Reajuste{Codigos: []string{"p1", "p2"}, Campo: model.CampoPrecoPor, Base: model.CampoPrecoDe, Tipo: model.ReajustePercentual, Valor: -10, Arredondamento: model.ArredondamentoFinal99}, ExpectedItens: []model.ItemReajuste{
+			{Codigo: "p1", Nome: "Televisao SAMSUNG", PrecoDeAnterior: 2500, PrecoPorAnterior: 2200, PrecoDe: 2500, PrecoPor: 2250.99},
+			{Codigo: "p2", Nome: "Televisao LG", PrecoDeAnterior: 1999.9, PrecoPorAnterior: 1999.9, PrecoDe: 1999.9, PrecoPor: 1799.99},
+		}, PrepareMock: func(m *mocks.IProdutoStore) {
+			m.On("FindProdutosByCodigos", ctx, []string{"p1", "p2"}).Return(&televisores, nil)
+		}},
+		"deve marcar os produtos que ficariam inválidos": {Input: model.Reajuste{Codigos: []string{"p1", "p2"}, Campo: model.CampoPrecoPor, Tipo: model.ReajusteFixo, Valor: 200, Arredondamento: model.ArredondamentoInteiro}, ExpectedItens: []model.ItemReajuste{
+			{Codigo: "p1", Nome: "Televisao SAMSUNG", PrecoDeAnterior: 2500, PrecoPorAnterior: 2200, PrecoDe: 2500, PrecoPor: 2400},
+			{Codigo: "p2", Nome: "Televisao LG", PrecoDeAnterior: 1999.9, PrecoPorAnterior: 1999.9, PrecoDe: 1999.9, PrecoPor: 2200, Erro: "preço de não pode ser inferior a Preço por"},
+		}, PrepareMock: func(m *mocks.IProdutoStore) {
+			m.On("FindProdutosByCodigos", ctx, []string{"p1", "p2"}).Return(&televisores, nil)
+		}},
+		"deve ignorar os produtos sem alteração": {Input: model.Reajuste{Codigos: []string{"p1"}, Campo: model.CampoPrecoPor, Base: model.CampoPrecoPor, Tipo: model.ReajusteFixo, Valor: 0}, ExpectedItens: []model.ItemReajuste{}, PrepareMock: func(m *mocks.IProdutoStore) {
+			m.On("FindProdutosByCodigos", ctx, []string{"p1"}).Return(&[]model.Produto{televisores[0]}, nil)
+		}},
+		"deve exigir a seleção dos produtos": {Input: model.Reajuste{Campo: model.CampoPrecos, Tipo: model.ReajustePercentual, Valor: 5}, ExpectedErr: true, PrepareMock: func(m *mocks.IProdutoStore) {}},
+		"deve rejeitar o campo desconhecido": {Input: model.Reajuste{Codigos: []string{"p1"}, Campo: "preco", Tipo: model.ReajustePercentual, Valor: 5}, ExpectedErr: true, PrepareMock: func(m *mocks.IProdutoStore) {}},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			m := new(mocks.IProdutoStore)
+			cs.PrepareMock(m)
+
+			app := produto.NewApp(&store.Container{Produto: m})
+
+			previa, err := app.PreverReajuste(ctx, cs.Input)
+
+			if cs.ExpectedErr {
+				assert.Error(t, err)
+			} else if assert.NoError(t, err) {
+				if diff := cmp.Diff(previa.Itens, cs.ExpectedItens); diff != "" {
+					t.Error(diff)
+				}
+				assert.NotEmpty(t, previa.Confirmacao)
+			}
+
+			m.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_AplicarReajuste(t *testing.T) {
+	ctx := context.Background()
+	aumento := model.Reajuste{Codigos: []string{"p1", "p2"}, Campo: model.CampoPrecos, Tipo: model.ReajustePercentual, Valor: 5}
+	invalido := model.Reajuste{Codigos: []string{"p1", "p2"}, Campo: model.CampoPrecoPor, Tipo: model.ReajusteFixo, Valor: 200}
+
+	// confirmacao gera a confirmação da prévia com os mesmos produtos
+	confirmacao := func(t *testing.T, reajuste model.Reajuste) string {
+		m := new(mocks.IProdutoStore)
+		m.On("FindProdutosByCodigos", ctx, reajuste.Codigos).Return(&televisores, nil)
+
+		previa, err := produto.NewApp(&store.Container{Produto: m}).PreverReajuste(ctx, reajuste)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return previa.Confirmacao
+	}
+
+	cases := map[string]struct {
+		Input       model.Reajuste
+		Confirmar   bool
+		ExpectedErr error
+
+		PrepareMock func(mock *mocks.IProdutoStore)
+	}{
+		"deve aplicar o reajuste confirmado": {Input: aumento, Confirmar: true, PrepareMock: func(m *mocks.IProdutoStore) {
+			m.On("FindProdutosByCodigos", ctx, []string{"p1", "p2"}).Return(&televisores, nil)
+			m.On("ReajustarPrecos", ctx, mock.MatchedBy(func(itens []model.ItemReajuste) bool {
+				return len(itens) == 2 && itens[0].PrecoPor == 2310 && itens[1].PrecoDe == 2099.9
+			})).Return(&televisores, nil)
+		}},
+		"deve exigir a confirmação": {Input: aumento, ExpectedErr: produto.ErrConfirmacaoObrigatoria, PrepareMock: func(m *mocks.IProdutoStore) {}},
+		"deve rejeitar a prévia desatualizada": {Input: aumento, Confirmar: true, ExpectedErr: produto.ErrPreviaDesatualizada, PrepareMock: func(m *mocks.IProdutoStore) {
+			m.On("FindProdutosByCodigos", ctx, []string{"p1", "p2"}).Return(&[]model.Produto{televisores[0], {Codigo: "p2", PrecoDe: 1500, PrecoPor: 1500}}, nil)
+		}},
+		"deve rejeitar a alteração concorrente": {Input: aumento, Confirmar: true, ExpectedErr: produto.ErrPreviaDesatualizada, PrepareMock: func(m *mocks.IProdutoStore) {
+			m.On("FindProdutosByCodigos", ctx, []string{"p1", "p2"}).Return(&televisores, nil)
+			m.On("ReajustarPrecos", ctx, mock.Anything).Return(nil, produtoStore.ErrPrecoAlterado)
+		}},
+		"não deve aplicar com produtos inválidos": {Input: invalido, Confirmar: true, ExpectedErr: produto.ErrReajusteInvalido, PrepareMock: func(m *mocks.IProdutoStore) {
+			m.On("FindProdutosByCodigos", ctx, []string{"p1", "p2"}).Return(&televisores, nil)
+		}},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			m := new(mocks.IProdutoStore)
+			cs.PrepareMock(m)
+
+			if cs.Confirmar {
+				cs.Input.Confirmacao = confirmacao(t, cs.Input)
+			}
+
+			app := produto.NewApp(&store.Container{Produto: m})
+
+			_, err := app.AplicarReajuste(ctx, cs.Input)
+
+			if diff := cmp.Diff(err, cs.ExpectedErr, cmpopts.EquateErrors()); diff != "" {
+				t.Error(diff)
+			}
+
+			m.AssertExpectations(t)
+		})
+	}
+}
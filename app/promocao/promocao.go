@@ -0,0 +1,267 @@
+package promocao
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/GianGoulart/CrudProdutos/logger"
+	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/GianGoulart/CrudProdutos/store"
+)
+
+// IPromocaoApp interface de promoção para implementação
+type IPromocaoApp interface {
+	GetPromocoes(ctx context.Context) (*[]model.Promocao, error)
+	GetPromocaoByCodigo(ctx context.Context, codigo string) (*model.Promocao, error)
+	CreatePromocao(ctx context.Context, promocao *model.Promocao) (*model.Promocao, error)
+	UpdatePromocao(ctx context.Context, promocao *model.Promocao) (*model.Promocao, error)
+	DeletePromocao(ctx context.Context, codigo string) (*model.Promocao, error)
+}
+
+var (
+	// ErrPromocaoNaoEncontrada erro retornado quando a promoção informada não existe
+	ErrPromocaoNaoEncontrada = errors.New("promoção não encontrada")
+	// ErrProdutoNaoEncontrado erro retornado ao salvar uma promoção com um produto inexistente
+	ErrProdutoNaoEncontrado = errors.New("produto não encontrado")
+	// ErrCategoriaNaoEncontrada erro retornado ao salvar uma promoção com uma categoria inexistente
+	ErrCategoriaNaoEncontrada = errors.New("categoria não encontrada")
+)
+
+// NewApp cria uma nova instancia do serviço de promoção
+func NewApp(store *store.Container) IPromocaoApp {
+	return &appImpl{
+		stores: store,
+	}
+}
+
+type appImpl struct {
+	stores *store.Container
+}
+
+func (p *appImpl) GetPromocoes(ctx context.Context) (*[]model.Promocao, error) {
+	return p.stores.Promocao.FindPromocoes(ctx)
+}
+
+func (p *appImpl) GetPromocaoByCodigo(ctx context.Context, codigo string) (*model.Promocao, error) {
+	return p.stores.Promocao.FindPromocaoByCodigo(ctx, codigo)
+}
+
+func (p *appImpl) CreatePromocao(ctx context.Context, promocao *model.Promocao) (*model.Promocao, error) {
+	promocao.PreSave()
+
+	if err := p.validar(ctx, promocao); err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("codigo", promocao.Codigo).Warn("app.promocao.CreatePromocao")
+		return nil, err
+	}
+
+	return p.stores.Promocao.CreatePromocao(ctx, promocao)
+}
+
+func (p *appImpl) UpdatePromocao(ctx context.Context, promocao *model.Promocao) (*model.Promocao, error) {
+	atual, err := p.stores.Promocao.FindPromocaoByCodigo(ctx, promocao.Codigo)
+	if err != nil {
+		return nil, err
+	}
+
+	if atual.Codigo == "" {
+		return nil, ErrPromocaoNaoEncontrada
+	}
+
+	if err := p.validar(ctx, promocao); err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("codigo", promocao.Codigo).Warn("app.promocao.UpdatePromocao")
+		return nil, err
+	}
+
+	return p.stores.Promocao.UpdatePromocao(ctx, promocao)
+}
+
+func (p *appImpl) DeletePromocao(ctx context.Context, codigo string) (*model.Promocao, error) {
+	promocao, err := p.stores.Promocao.FindPromocaoByCodigo(ctx, codigo)
+	if err != nil {
+		return nil, err
+	}
+
+	if promocao.Codigo == "" {
+		return nil, ErrPromocaoNaoEncontrada
+	}
+
+	if err := p.stores.Promocao.DeletePromocaoByCodigo(ctx, promocao); err != nil {
+		return nil, err
+	}
+
+	logger.FromContext(ctx).WithField("codigo", codigo).Info("app.promocao.DeletePromocao")
+
+	return promocao, nil
+}
+
+// validar valida a promoção e garante que os produtos e as categorias informados
+// existem, removendo os repetidos
+func (p *appImpl) validar(ctx context.Context, promocao *model.Promocao) error {
+	promocao.Produtos = unicos(promocao.Produtos)
+	promocao.Categorias = unicos(promocao.Categorias)
+
+	if err := promocao.Validate(); err != nil {
+		return err
+	}
+
+	if len(promocao.Produtos) > 0 {
+		produtos, err := p.stores.Produto.FindProdutosByCodigos(ctx, promocao.Produtos)
+		if err != nil {
+			return err
+		}
+
+		if len(*produtos) != len(promocao.Produtos) {
+			return ErrProdutoNaoEncontrado
+		}
+	}
+
+	if len(promocao.Categorias) > 0 {
+		categorias, err := p.stores.Categoria.FindCategorias(ctx)
+		if err != nil {
+			return err
+		}
+
+		existe := map[string]bool{}
+		for _, c := range *categorias {
+			existe[c.Codigo] = true
+		}
+
+		for _, codigo := range promocao.Categorias {
+			if !existe[codigo] {
+				return ErrCategoriaNaoEncontrada
+			}
+		}
+	}
+
+	return nil
+}
+
+// unicos retorna os codigos sem os repetidos, na ordem em que aparecem
+func unicos(codigos []string) []string {
+	if codigos == nil {
+		return nil
+	}
+
+	res := []string{}
+	vistos := map[string]bool{}
+	for _, codigo := range codigos {
+		if !vistos[codigo] {
+			vistos[codigo] = true
+			res = append(res, codigo)
+		}
+	}
+
+	return res
+}
+
+// Aplicar preenche o preço promocional dos produtos com as promoções vigentes.
+// A promoção de uma categoria vale também para os produtos das suas descendentes.
+// As categorias são consultadas uma única vez, e apenas para os produtos informados,
+// independente da quantidade de promoções por categoria
+func Aplicar(ctx context.Context, stores *store.Container, produtos []model.Produto) error {
+	if stores.Promocao == nil || len(produtos) == 0 {
+		return nil
+	}
+
+	vigentes, err := stores.Promocao.FindPromocoesVigentes(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+
+	if len(*vigentes) == 0 {
+		return nil
+	}
+
+	doProduto, err := categoriasDosProdutos(ctx, stores, *vigentes, produtos)
+	if err != nil {
+		return err
+	}
+
+	// produtos e categorias, com as descendentes, alcançados por cada promoção vigente
+	type alvos struct {
+		produtos, categorias map[string]bool
+	}
+
+	var categorias *[]model.Categoria
+	alcance := make([]alvos, len(*vigentes))
+	for i, promocao := range *vigentes {
+		alcance[i] = alvos{produtos: map[string]bool{}, categorias: map[string]bool{}}
+		for _, codigo := range promocao.Produtos {
+			alcance[i].produtos[codigo] = true
+		}
+
+		if len(promocao.Categorias) == 0 || doProduto == nil {
+			continue
+		}
+
+		if categorias == nil {
+			if categorias, err = stores.Categoria.FindCategorias(ctx); err != nil {
+				return err
+			}
+		}
+
+		for _, categoria := range promocao.Categorias {
+			for _, descendente := range model.Descendentes(*categorias, categoria) {
+				alcance[i].categorias[descendente] = true
+			}
+		}
+	}
+
+	for i := range produtos {
+		aplicaveis := []model.Promocao{}
+		for j, promocao := range *vigentes {
+			if alcance[j].produtos[produtos[i].Codigo] || algumaCategoria(alcance[j].categorias, doProduto[produtos[i].Codigo]) {
+				aplicaveis = append(aplicaveis, promocao)
+			}
+		}
+
+		model.AplicarPromocoes(&produtos[i], aplicaveis)
+	}
+
+	return nil
+}
+
+// categoriasDosProdutos retorna as categorias de cada produto, nil quando nenhuma
+// das promoções é por categoria
+func categoriasDosProdutos(ctx context.Context, stores *store.Container, promocoes []model.Promocao, produtos []model.Produto) (map[string][]string, error) {
+	if stores.Categoria == nil {
+		return nil, nil
+	}
+
+	porCategoria := false
+	for _, promocao := range promocoes {
+		porCategoria = porCategoria || len(promocao.Categorias) > 0
+	}
+
+	if !porCategoria {
+		return nil, nil
+	}
+
+	codigos := make([]string, 0, len(produtos))
+	for _, produto := range produtos {
+		codigos = append(codigos, produto.Codigo)
+	}
+
+	associacoes, err := stores.Categoria.FindProdutoCategorias(ctx, codigos)
+	if err != nil {
+		return nil, err
+	}
+
+	doProduto := make(map[string][]string, len(produtos))
+	for _, a := range *associacoes {
+		doProduto[a.ProdutoCodigo] = append(doProduto[a.ProdutoCodigo], a.CategoriaCodigo)
+	}
+
+	return doProduto, nil
+}
+
+func algumaCategoria(alvos map[string]bool, categorias []string) bool {
+	for _, categoria := range categorias {
+		if alvos[categoria] {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,142 @@
+package promocao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/GianGoulart/CrudProdutos/app/promocao"
+	"github.com/GianGoulart/CrudProdutos/mocks"
+	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/GianGoulart/CrudProdutos/store"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	ontem  = time.Now().Add(-24 * time.Hour).UTC().Format(time.RFC3339)
+	amanha = time.Now().Add(24 * time.Hour).UTC().Format(time.RFC3339)
+)
+
+func Test_CreatePromocao(t *testing.T) {
+	ctx := context.Background()
+
+	cases := map[string]struct {
+		Input       model.Promocao
+		ExpectedErr bool
+		Err         error
+
+		PrepareMock func(promocoes *mocks.IPromocaoStore, produtos *mocks.IProdutoStore, categorias *mocks.ICategoriaStore)
+	}{
+		"deve criar a promoção sem os alvos repetidos": {Input: model.Promocao{Nome: "Natal", Tipo: model.DescontoPercentual, Valor: 10, Inicio: "2021-12-01T00:00:00-03:00", Fim: "2021-12-26T00:00:00-03:00", Produtos: []string{"p1", "p1"}, Categorias: []string{"tv"}},
+			PrepareMock: func(pr *mocks.IPromocaoStore, p *mocks.IProdutoStore, c *mocks.ICategoriaStore) {
+				p.On("FindProdutosByCodigos", ctx, []string{"p1"}).Return(&[]model.Produto{{Codigo: "p1"}}, nil)
+				c.On("FindCategorias", ctx).Return(&[]model.Categoria{{Codigo: "tv"}}, nil)
+				pr.On("CreatePromocao", ctx, mock.MatchedBy(func(p *model.Promocao) bool {
+					return len(p.Produtos) == 1 && p.Inicio == "2021-12-01T03:00:00Z" && p.Fim == "2021-12-26T03:00:00Z"
+				})).Return(&model.Promocao{}, nil)
+			}},
+		"deve retornar erro com o produto inexistente": {Input: model.Promocao{Nome: "Natal", Tipo: model.DescontoFixo, Valor: 100, Inicio: ontem, Fim: amanha, Produtos: []string{"p1", "p9"}}, Err: promocao.ErrProdutoNaoEncontrado,
+			PrepareMock: func(pr *mocks.IPromocaoStore, p *mocks.IProdutoStore, c *mocks.ICategoriaStore) {
+				p.On("FindProdutosByCodigos", ctx, []string{"p1", "p9"}).Return(&[]model.Produto{{Codigo: "p1"}}, nil)
+			}},
+		"deve retornar erro com a categoria inexistente": {Input: model.Promocao{Nome: "Natal", Tipo: model.DescontoFixo, Valor: 100, Inicio: ontem, Fim: amanha, Categorias: []string{"xpto"}}, Err: promocao.ErrCategoriaNaoEncontrada,
+			PrepareMock: func(pr *mocks.IPromocaoStore, p *mocks.IProdutoStore, c *mocks.ICategoriaStore) {
+				c.On("FindCategorias", ctx).Return(&[]model.Categoria{{Codigo: "tv"}}, nil)
+			}},
+		"deve exigir o fim depois do inicio": {Input: model.Promocao{Nome: "Natal", Tipo: model.DescontoFixo, Valor: 100, Inicio: amanha, Fim: ontem, Produtos: []string{"p1"}}, ExpectedErr: true, PrepareMock: func(pr *mocks.IPromocaoStore, p *mocks.IProdutoStore, c *mocks.ICategoriaStore) {}},
+		"deve rejeitar o percentual acima de 100": {Input: model.Promocao{Nome: "Natal", Tipo: model.DescontoPercentual, Valor: 150, Inicio: ontem, Fim: amanha, Produtos: []string{"p1"}}, ExpectedErr: true, PrepareMock: func(pr *mocks.IPromocaoStore, p *mocks.IProdutoStore, c *mocks.ICategoriaStore) {}},
+		"deve exigir os alvos": {Input: model.Promocao{Nome: "Natal", Tipo: model.DescontoPercentual, Valor: 10, Inicio: ontem, Fim: amanha}, ExpectedErr: true, PrepareMock: func(pr *mocks.IPromocaoStore, p *mocks.IProdutoStore, c *mocks.ICategoriaStore) {}},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			promocoes := new(mocks.IPromocaoStore)
+			produtos := new(mocks.IProdutoStore)
+			categorias := new(mocks.ICategoriaStore)
+
+			cs.PrepareMock(promocoes, produtos, categorias)
+
+			app := promocao.NewApp(&store.Container{Promocao: promocoes, Produto: produtos, Categoria: categorias})
+
+			_, err := app.CreatePromocao(ctx, &cs.Input)
+
+			if cs.ExpectedErr {
+				assert.Error(t, err)
+			} else if diff := cmp.Diff(err, cs.Err, cmpopts.EquateErrors()); diff != "" {
+				t.Error(diff)
+			}
+
+			promocoes.AssertExpectations(t)
+			produtos.AssertExpectations(t)
+			categorias.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_Aplicar(t *testing.T) {
+	ctx := context.Background()
+	produto := model.Produto{Codigo: "p1", Nome: "Televisao SAMSUNG", PrecoDe: 2500, PrecoPor: 2200}
+
+	// vigente retorna a promoção válida agora para o produto p1
+	vigente := func(codigo string, tipo string, valor float64, prioridade int, acumulativa bool) model.Promocao {
+		return model.Promocao{Codigo: codigo, Nome: codigo, Tipo: tipo, Valor: valor, Inicio: ontem, Fim: amanha, Prioridade: prioridade, Acumulativa: acumulativa, Produtos: []string{"p1"}}
+	}
+
+	cases := map[string]struct {
+		Promocoes         []model.Promocao
+		ExpectedPreco     float64
+		ExpectedPromocoes []model.PromocaoAplicada
+
+		PrepareMock func(categorias *mocks.ICategoriaStore)
+	}{
+		"deve aplicar o desconto percentual": {Promocoes: []model.Promocao{vigente("natal", model.DescontoPercentual, 10, 0, false)}, ExpectedPreco: 1980, ExpectedPromocoes: []model.PromocaoAplicada{{Codigo: "natal", Nome: "natal", Desconto: 220}}},
+		"deve aplicar apenas a de maior prioridade": {Promocoes: []model.Promocao{vigente("grande", model.DescontoFixo, 500, 0, false), vigente("prioritaria", model.DescontoFixo, 100, 5, false)}, ExpectedPreco: 2100, ExpectedPromocoes: []model.PromocaoAplicada{{Codigo: "prioritaria", Nome: "prioritaria", Desconto: 100}}},
+		"deve escolher o maior desconto na mesma prioridade": {Promocoes: []model.Promocao{vigente("pequena", model.DescontoFixo, 100, 0, false), vigente("grande", model.DescontoPercentual, 10, 0, false)}, ExpectedPreco: 1980, ExpectedPromocoes: []model.PromocaoAplicada{{Codigo: "grande", Nome: "grande", Desconto: 220}}},
+		"deve acumular as promoções acumulativas": {Promocoes: []model.Promocao{vigente("fixa", model.DescontoFixo, 100, 0, true), vigente("percentual", model.DescontoPercentual, 10, 5, true), vigente("exclusiva", model.DescontoFixo, 1000, 1, false)}, ExpectedPreco: 1880, ExpectedPromocoes: []model.PromocaoAplicada{{Codigo: "percentual", Nome: "percentual", Desconto: 220}, {Codigo: "fixa", Nome: "fixa", Desconto: 100}}},
+		"não deve passar de preço zero": {Promocoes: []model.Promocao{vigente("brinde", model.DescontoFixo, 5000, 0, false)}, ExpectedPreco: 0, ExpectedPromocoes: []model.PromocaoAplicada{{Codigo: "brinde", Nome: "brinde", Desconto: 2200}}},
+		"deve ignorar as promoções de outros produtos": {Promocoes: []model.Promocao{{Codigo: "outra", Tipo: model.DescontoFixo, Valor: 100, Inicio: ontem, Fim: amanha, Produtos: []string{"p2"}}}},
+		"deve aplicar a promoção da categoria pai": {Promocoes: []model.Promocao{{Codigo: "eletro", Nome: "Eletro", Tipo: model.DescontoPercentual, Valor: 5, Inicio: ontem, Fim: amanha, Categorias: []string{"eletro"}}}, ExpectedPreco: 2090, ExpectedPromocoes: []model.PromocaoAplicada{{Codigo: "eletro", Nome: "Eletro", Desconto: 110}},
+			PrepareMock: func(c *mocks.ICategoriaStore) {
+				c.On("FindCategorias", ctx).Return(&[]model.Categoria{{Codigo: "eletro"}, {Codigo: "tv", Pai: "eletro"}}, nil)
+				c.On("FindProdutoCategorias", ctx, []string{"p1"}).Return(&[]model.ProdutoCategoria{{ProdutoCodigo: "p1", CategoriaCodigo: "tv"}}, nil)
+			}},
+		"deve consultar as categorias uma única vez": {Promocoes: []model.Promocao{
+			{Codigo: "eletro", Nome: "Eletro", Tipo: model.DescontoFixo, Valor: 100, Inicio: ontem, Fim: amanha, Prioridade: 1, Categorias: []string{"eletro"}},
+			{Codigo: "audio", Nome: "Audio", Tipo: model.DescontoFixo, Valor: 300, Inicio: ontem, Fim: amanha, Categorias: []string{"audio"}},
+		}, ExpectedPreco: 2100, ExpectedPromocoes: []model.PromocaoAplicada{{Codigo: "eletro", Nome: "Eletro", Desconto: 100}},
+			PrepareMock: func(c *mocks.ICategoriaStore) {
+				c.On("FindCategorias", ctx).Return(&[]model.Categoria{{Codigo: "eletro"}, {Codigo: "tv", Pai: "eletro"}, {Codigo: "audio"}}, nil).Once()
+				c.On("FindProdutoCategorias", ctx, []string{"p1"}).Return(&[]model.ProdutoCategoria{{ProdutoCodigo: "p1", CategoriaCodigo: "tv"}}, nil).Once()
+			}},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			promocoes := new(mocks.IPromocaoStore)
+			categorias := new(mocks.ICategoriaStore)
+
+			promocoes.On("FindPromocoesVigentes", ctx, mock.Anything).Return(&cs.Promocoes, nil)
+			if cs.PrepareMock != nil {
+				cs.PrepareMock(categorias)
+			}
+
+			produtos := []model.Produto{produto}
+			err := promocao.Aplicar(ctx, &store.Container{Promocao: promocoes, Categoria: categorias}, produtos)
+
+			if assert.NoError(t, err) {
+				assert.Equal(t, cs.ExpectedPreco, produtos[0].PrecoPromocional)
+				if diff := cmp.Diff(produtos[0].Promocoes, cs.ExpectedPromocoes); diff != "" {
+					t.Error(diff)
+				}
+				// o preço de referência não muda
+				assert.Equal(t, float64(2500), produtos[0].PrecoDe)
+				assert.Equal(t, float64(2200), produtos[0].PrecoPor)
+			}
+
+			categorias.AssertExpectations(t)
+		})
+	}
+}
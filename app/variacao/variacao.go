@@ -0,0 +1,260 @@
+package variacao
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GianGoulart/CrudProdutos/app/alerta"
+	"github.com/GianGoulart/CrudProdutos/app/evento"
+	produtoApp "github.com/GianGoulart/CrudProdutos/app/produto"
+	"github.com/GianGoulart/CrudProdutos/logger"
+	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/GianGoulart/CrudProdutos/store"
+)
+
+// IVariacaoApp interface de variação para implementação
+type IVariacaoApp interface {
+	GetVariacoes(ctx context.Context, produto string) (*[]model.Variacao, error)
+	GetVariacoesByProdutos(ctx context.Context, produtos []string) (map[string][]model.Variacao, error)
+	CreateVariacao(ctx context.Context, variacao *model.Variacao) (*model.Variacao, error)
+	UpdateVariacao(ctx context.Context, variacao *model.Variacao) (*model.Variacao, error)
+	DeleteVariacao(ctx context.Context, produto, sku string) (*model.Variacao, error)
+}
+
+var (
+	// ErrVariacaoNaoEncontrada erro retornado quando o sku não existe no produto informado
+	ErrVariacaoNaoEncontrada = errors.New("variação não encontrada")
+	// ErrSKUDuplicado erro retornado ao criar uma variação com um sku já utilizado
+	ErrSKUDuplicado = errors.New("sku já cadastrado")
+	// ErrAtributosDuplicados erro retornado quando o produto já possui uma variação com os mesmos atributos
+	ErrAtributosDuplicados = errors.New("produto já possui uma variação com os mesmos atributos")
+	// ErrProdutoNaoEncontrado erro retornado ao criar uma variação para um produto inexistente
+	ErrProdutoNaoEncontrado = errors.New("produto não encontrado")
+	// ErrProdutoComDepositos erro retornado ao criar uma variação para um produto com estoque por depósito
+	ErrProdutoComDepositos = errors.New("produto com estoque por depósito não pode ter variações")
+)
+
+// NewApp cria uma nova instancia do serviço de variação
+func NewApp(store *store.Container) IVariacaoApp {
+	return &appImpl{
+		stores: store,
+	}
+}
+
+type appImpl struct {
+	stores *store.Container
+}
+
+func (p *appImpl) GetVariacoes(ctx context.Context, produto string) (*[]model.Variacao, error) {
+	return p.stores.Variacao.FindVariacoesByProduto(ctx, produto)
+}
+
+// GetVariacoesByProdutos retorna as variações de cada produto, consultadas de uma
+// vez. Todos os produtos informados estão no mapa, mesmo os sem variação
+func (p *appImpl) GetVariacoesByProdutos(ctx context.Context, produtos []string) (map[string][]model.Variacao, error) {
+	variacoes, err := p.stores.Variacao.FindVariacoesByProdutos(ctx, produtos)
+	if err != nil {
+		return nil, err
+	}
+
+	porProduto := make(map[string][]model.Variacao, len(produtos))
+	for _, produto := range produtos {
+		porProduto[produto] = []model.Variacao{}
+	}
+
+	for _, variacao := range *variacoes {
+		porProduto[variacao.ProdutoCodigo] = append(porProduto[variacao.ProdutoCodigo], variacao)
+	}
+
+	return porProduto, nil
+}
+
+func (p *appImpl) CreateVariacao(ctx context.Context, variacao *model.Variacao) (*model.Variacao, error) {
+	variacao.PreSave()
+
+	if err := variacao.Validate(); err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("sku", variacao.SKU).Warn("app.variacao.CreateVariacao")
+		return nil, err
+	}
+
+	if err := produtoApp.VerificarLimites(ctx, p.stores, nil, precos(variacao)); err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("sku", variacao.SKU).Warn("app.variacao.CreateVariacao")
+		return nil, err
+	}
+
+	produto, err := p.stores.Produto.FindProdutoByCodigo(ctx, variacao.ProdutoCodigo)
+	if err != nil {
+		return nil, err
+	}
+
+	if produto.Codigo == "" {
+		return nil, ErrProdutoNaoEncontrado
+	}
+
+	// o estoque do produto vem das variações ou dos depósitos, nunca dos dois
+	if p.stores.Deposito != nil {
+		estoques, err := p.stores.Deposito.FindEstoquesByProduto(ctx, produto.Codigo)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(*estoques) > 0 {
+			return nil, ErrProdutoComDepositos
+		}
+	}
+
+	atual, err := p.stores.Variacao.FindVariacaoBySKU(ctx, variacao.SKU)
+	if err != nil {
+		return nil, err
+	}
+
+	if atual.SKU != "" {
+		return nil, ErrSKUDuplicado
+	}
+
+	variacoes, err := p.stores.Variacao.FindVariacoesByProduto(ctx, variacao.ProdutoCodigo)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := unicos(variacao, *variacoes); err != nil {
+		return nil, err
+	}
+
+	variacao, err = p.stores.Variacao.CreateVariacao(ctx, variacao)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.agregar(ctx, produto, append(*variacoes, *variacao)); err != nil {
+		return nil, err
+	}
+
+	return variacao, nil
+}
+
+func (p *appImpl) UpdateVariacao(ctx context.Context, variacao *model.Variacao) (*model.Variacao, error) {
+	if err := variacao.Validate(); err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("sku", variacao.SKU).Warn("app.variacao.UpdateVariacao")
+		return nil, err
+	}
+
+	variacoes, err := p.stores.Variacao.FindVariacoesByProduto(ctx, variacao.ProdutoCodigo)
+	if err != nil {
+		return nil, err
+	}
+
+	outras := []model.Variacao{}
+	var anterior *model.Variacao
+	for i, v := range *variacoes {
+		if v.SKU == variacao.SKU {
+			anterior = &(*variacoes)[i]
+			continue
+		}
+		outras = append(outras, v)
+	}
+
+	if anterior == nil {
+		return nil, ErrVariacaoNaoEncontrada
+	}
+
+	if err := unicos(variacao, outras); err != nil {
+		return nil, err
+	}
+
+	if err := produtoApp.VerificarLimites(ctx, p.stores, precos(anterior), precos(variacao)); err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("sku", variacao.SKU).Warn("app.variacao.UpdateVariacao")
+		return nil, err
+	}
+
+	variacao, err = p.stores.Variacao.UpdateVariacao(ctx, variacao)
+	if err != nil {
+		return nil, err
+	}
+
+	produto, err := p.stores.Produto.FindProdutoByCodigo(ctx, variacao.ProdutoCodigo)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.agregar(ctx, produto, append(outras, *variacao)); err != nil {
+		return nil, err
+	}
+
+	return variacao, nil
+}
+
+func (p *appImpl) DeleteVariacao(ctx context.Context, produto, sku string) (*model.Variacao, error) {
+	variacao, err := p.stores.Variacao.FindVariacaoBySKU(ctx, sku)
+	if err != nil {
+		return nil, err
+	}
+
+	if variacao.SKU == "" || variacao.ProdutoCodigo != produto {
+		return nil, ErrVariacaoNaoEncontrada
+	}
+
+	if err := p.stores.Variacao.DeleteVariacaoBySKU(ctx, variacao); err != nil {
+		return nil, err
+	}
+
+	variacoes, err := p.stores.Variacao.FindVariacoesByProduto(ctx, produto)
+	if err != nil {
+		return nil, err
+	}
+
+	pai, err := p.stores.Produto.FindProdutoByCodigo(ctx, produto)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.agregar(ctx, pai, *variacoes); err != nil {
+		return nil, err
+	}
+
+	logger.FromContext(ctx).WithField("sku", sku).Info("app.variacao.DeleteVariacao")
+
+	return variacao, nil
+}
+
+// agregar grava no produto pai a soma do estoque das suas variações. Ao remover
+// a última variação o produto fica sem estoque, já que o estoque gravado era a
+// soma das variações removidas
+func (p *appImpl) agregar(ctx context.Context, produto *model.Produto, variacoes []model.Variacao) error {
+	if produto.Codigo == "" {
+		return nil
+	}
+
+	anterior := *produto
+	if len(variacoes) == 0 {
+		produto.EstoqueTotal, produto.EstoqueCorte, produto.EstoqueDisponivel = 0, 0, 0
+	}
+	model.AgregarEstoque(produto, variacoes)
+
+	if _, err := p.stores.Produto.UpdateProduto(ctx, produto); err != nil {
+		return err
+	}
+
+	alerta.Avaliar(ctx, p.stores, &anterior, produto)
+	if model.EstoqueAlterado(&anterior, produto) {
+		evento.Emitir(ctx, p.stores, model.EventoEstoqueAlterado, produto)
+	}
+
+	return nil
+}
+
+// precos retorna os preços da variação no formato verificado pelos limites de preço
+func precos(variacao *model.Variacao) *model.Produto {
+	return &model.Produto{Codigo: variacao.SKU, PrecoDe: variacao.PrecoDe, PrecoPor: variacao.PrecoPor}
+}
+
+// unicos garante que a variação não repete os atributos de outra do mesmo produto
+func unicos(variacao *model.Variacao, outras []model.Variacao) error {
+	for _, outra := range outras {
+		if outra.SKU != variacao.SKU && variacao.MesmosAtributos(outra) {
+			return ErrAtributosDuplicados
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,186 @@
+package variacao_test
+
+import (
+	"context"
+	"testing"
+
+	produtoApp "github.com/GianGoulart/CrudProdutos/app/produto"
+	"github.com/GianGoulart/CrudProdutos/app/variacao"
+	"github.com/GianGoulart/CrudProdutos/mocks"
+	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/GianGoulart/CrudProdutos/store"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/stretchr/testify/mock"
+)
+
+var variacoes = []model.Variacao{
+	{SKU: "cam-p", ProdutoCodigo: "cam", Tamanho: "P", PrecoDe: 50, PrecoPor: 40, EstoqueTotal: 10, EstoqueCorte: 2},
+	{SKU: "cam-m", ProdutoCodigo: "cam", Tamanho: "M", PrecoDe: 50, PrecoPor: 40, EstoqueTotal: 5},
+}
+
+func Test_CreateVariacao(t *testing.T) {
+	ctx := context.Background()
+
+	cases := map[string]struct {
+		Input       model.Variacao
+		ExpectedErr error
+
+		PrepareMock func(produtos *mocks.IProdutoStore, variacoes *mocks.IVariacaoStore)
+	}{
+		"deve criar e agregar o estoque no produto": {Input: model.Variacao{SKU: "cam-g", ProdutoCodigo: "cam", Tamanho: "G", PrecoDe: 50, PrecoPor: 40, EstoqueTotal: 4, EstoqueCorte: 1}, PrepareMock: func(p *mocks.IProdutoStore, v *mocks.IVariacaoStore) {
+			p.On("FindProdutoByCodigo", ctx, "cam").Return(&model.Produto{Codigo: "cam", Nome: "Camiseta"}, nil)
+			v.On("FindVariacaoBySKU", ctx, "cam-g").Return(&model.Variacao{}, nil)
+			v.On("FindVariacoesByProduto", ctx, "cam").Return(&[]model.Variacao{variacoes[0], variacoes[1]}, nil)
+			v.On("CreateVariacao", ctx, mock.Anything).Return(func(_ context.Context, v *model.Variacao) *model.Variacao { return v }, nil)
+			p.On("UpdateProduto", ctx, mock.MatchedBy(func(p *model.Produto) bool {
+				return p.EstoqueTotal == 19 && p.EstoqueCorte == 3 && p.EstoqueDisponivel == 16
+			})).Return(&model.Produto{}, nil)
+		}},
+		"deve retornar erro com os atributos repetidos": {Input: model.Variacao{SKU: "cam-p2", ProdutoCodigo: "cam", Tamanho: "p", PrecoDe: 50, PrecoPor: 40}, ExpectedErr: variacao.ErrAtributosDuplicados, PrepareMock: func(p *mocks.IProdutoStore, v *mocks.IVariacaoStore) {
+			p.On("FindProdutoByCodigo", ctx, "cam").Return(&model.Produto{Codigo: "cam", Nome: "Camiseta"}, nil)
+			v.On("FindVariacaoBySKU", ctx, "cam-p2").Return(&model.Variacao{}, nil)
+			v.On("FindVariacoesByProduto", ctx, "cam").Return(&[]model.Variacao{variacoes[0], variacoes[1]}, nil)
+		}},
+		"deve retornar erro com o sku repetido": {Input: model.Variacao{SKU: "cam-p", ProdutoCodigo: "cam", Tamanho: "GG", PrecoDe: 50, PrecoPor: 40}, ExpectedErr: variacao.ErrSKUDuplicado, PrepareMock: func(p *mocks.IProdutoStore, v *mocks.IVariacaoStore) {
+			p.On("FindProdutoByCodigo", ctx, "cam").Return(&model.Produto{Codigo: "cam", Nome: "Camiseta"}, nil)
+			v.On("FindVariacaoBySKU", ctx, "cam-p").Return(&variacoes[0], nil)
+		}},
+		"deve retornar erro com o produto inexistente": {Input: model.Variacao{SKU: "x-p", ProdutoCodigo: "x", Tamanho: "P", PrecoDe: 50, PrecoPor: 40}, ExpectedErr: variacao.ErrProdutoNaoEncontrado, PrepareMock: func(p *mocks.IProdutoStore, v *mocks.IVariacaoStore) {
+			p.On("FindProdutoByCodigo", ctx, "x").Return(&model.Produto{}, nil)
+		}},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			produtos := new(mocks.IProdutoStore)
+			variacoes := new(mocks.IVariacaoStore)
+
+			cs.PrepareMock(produtos, variacoes)
+
+			app := variacao.NewApp(&store.Container{Produto: produtos, Variacao: variacoes})
+
+			_, err := app.CreateVariacao(ctx, &cs.Input)
+
+			if diff := cmp.Diff(err, cs.ExpectedErr, cmpopts.EquateErrors()); diff != "" {
+				t.Error(diff)
+			}
+
+			produtos.AssertExpectations(t)
+			variacoes.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_DeleteVariacao(t *testing.T) {
+	ctx := context.Background()
+
+	cases := map[string]struct {
+		Produto     string
+		SKU         string
+		ExpectedErr error
+
+		PrepareMock func(produtos *mocks.IProdutoStore, variacoes *mocks.IVariacaoStore)
+	}{
+		"deve remover e reagregar o estoque": {Produto: "cam", SKU: "cam-p", PrepareMock: func(p *mocks.IProdutoStore, v *mocks.IVariacaoStore) {
+			v.On("FindVariacaoBySKU", ctx, "cam-p").Return(&variacoes[0], nil)
+			v.On("DeleteVariacaoBySKU", ctx, &variacoes[0]).Return(nil)
+			v.On("FindVariacoesByProduto", ctx, "cam").Return(&[]model.Variacao{variacoes[1]}, nil)
+			p.On("FindProdutoByCodigo", ctx, "cam").Return(&model.Produto{Codigo: "cam", Nome: "Camiseta"}, nil)
+			p.On("UpdateProduto", ctx, mock.MatchedBy(func(p *model.Produto) bool {
+				return p.EstoqueTotal == 5 && p.EstoqueDisponivel == 5
+			})).Return(&model.Produto{}, nil)
+		}},
+		"deve zerar o estoque ao remover a última variação": {Produto: "cam", SKU: "cam-p", PrepareMock: func(p *mocks.IProdutoStore, v *mocks.IVariacaoStore) {
+			v.On("FindVariacaoBySKU", ctx, "cam-p").Return(&variacoes[0], nil)
+			v.On("DeleteVariacaoBySKU", ctx, &variacoes[0]).Return(nil)
+			v.On("FindVariacoesByProduto", ctx, "cam").Return(&[]model.Variacao{}, nil)
+			p.On("FindProdutoByCodigo", ctx, "cam").Return(&model.Produto{Codigo: "cam", Nome: "Camiseta", EstoqueTotal: 10, EstoqueCorte: 2, EstoqueDisponivel: 8}, nil)
+			p.On("UpdateProduto", ctx, mock.MatchedBy(func(p *model.Produto) bool {
+				return p.EstoqueTotal == 0 && p.EstoqueCorte == 0 && p.EstoqueDisponivel == 0
+			})).Return(&model.Produto{}, nil)
+		}},
+		"deve retornar erro com o sku de outro produto": {Produto: "tv", SKU: "cam-p", ExpectedErr: variacao.ErrVariacaoNaoEncontrada, PrepareMock: func(p *mocks.IProdutoStore, v *mocks.IVariacaoStore) {
+			v.On("FindVariacaoBySKU", ctx, "cam-p").Return(&variacoes[0], nil)
+		}},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			produtos := new(mocks.IProdutoStore)
+			variacoes := new(mocks.IVariacaoStore)
+
+			cs.PrepareMock(produtos, variacoes)
+
+			app := variacao.NewApp(&store.Container{Produto: produtos, Variacao: variacoes})
+
+			_, err := app.DeleteVariacao(ctx, cs.Produto, cs.SKU)
+
+			if diff := cmp.Diff(err, cs.ExpectedErr, cmpopts.EquateErrors()); diff != "" {
+				t.Error(diff)
+			}
+
+			produtos.AssertExpectations(t)
+			variacoes.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_LimitesPrecoVariacao(t *testing.T) {
+	ctx := context.Background()
+	limites := model.LimitesPreco{VariacaoMaxima: 50, PrecoMinimo: 10}
+
+	cases := map[string]struct {
+		Input       model.Variacao
+		Update      bool
+		Ignorar     bool
+		ExpectedErr error
+
+		PrepareMock func(produtos *mocks.IProdutoStore, variacoes *mocks.IVariacaoStore)
+	}{
+		"deve rejeitar a variação abaixo do preço mínimo": {Input: model.Variacao{SKU: "cam-g", ProdutoCodigo: "cam", Tamanho: "G", PrecoDe: 5, PrecoPor: 5}, ExpectedErr: model.ErrPrecoAbaixoDoMinimo, PrepareMock: func(p *mocks.IProdutoStore, v *mocks.IVariacaoStore) {}},
+		"deve rejeitar a alteração acima da variação máxima": {Input: model.Variacao{SKU: "cam-p", ProdutoCodigo: "cam", Tamanho: "P", PrecoDe: 50, PrecoPor: 15}, Update: true, ExpectedErr: model.ErrVariacaoAcimaDaMaxima, PrepareMock: func(p *mocks.IProdutoStore, v *mocks.IVariacaoStore) {
+			v.On("FindVariacoesByProduto", mock.Anything, "cam").Return(&[]model.Variacao{variacoes[0], variacoes[1]}, nil)
+		}},
+		"deve aceitar a alteração dentro da variação máxima": {Input: model.Variacao{SKU: "cam-p", ProdutoCodigo: "cam", Tamanho: "P", PrecoDe: 50, PrecoPor: 30}, Update: true, PrepareMock: func(p *mocks.IProdutoStore, v *mocks.IVariacaoStore) {
+			v.On("FindVariacoesByProduto", mock.Anything, "cam").Return(&[]model.Variacao{variacoes[0], variacoes[1]}, nil)
+			v.On("UpdateVariacao", mock.Anything, mock.Anything).Return(&variacoes[0], nil)
+			p.On("FindProdutoByCodigo", mock.Anything, "cam").Return(&model.Produto{}, nil)
+		}},
+		"deve salvar fora dos limites quando a requisição ignora": {Input: model.Variacao{SKU: "cam-p", ProdutoCodigo: "cam", Tamanho: "P", PrecoDe: 50, PrecoPor: 5}, Update: true, Ignorar: true, PrepareMock: func(p *mocks.IProdutoStore, v *mocks.IVariacaoStore) {
+			v.On("FindVariacoesByProduto", mock.Anything, "cam").Return(&[]model.Variacao{variacoes[0], variacoes[1]}, nil)
+			v.On("UpdateVariacao", mock.Anything, mock.Anything).Return(&variacoes[0], nil)
+			p.On("FindProdutoByCodigo", mock.Anything, "cam").Return(&model.Produto{}, nil)
+		}},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx := ctx
+			if cs.Ignorar {
+				ctx = produtoApp.IgnorarLimitesPreco(ctx)
+			}
+
+			produtos := new(mocks.IProdutoStore)
+			variacoes := new(mocks.IVariacaoStore)
+
+			cs.PrepareMock(produtos, variacoes)
+
+			app := variacao.NewApp(&store.Container{Produto: produtos, Variacao: variacoes, LimitesPreco: limites})
+
+			var err error
+			if cs.Update {
+				_, err = app.UpdateVariacao(ctx, &cs.Input)
+			} else {
+				_, err = app.CreateVariacao(ctx, &cs.Input)
+			}
+
+			if diff := cmp.Diff(err, cs.ExpectedErr, cmpopts.EquateErrors()); diff != "" {
+				t.Error(diff)
+			}
+
+			produtos.AssertExpectations(t)
+			variacoes.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,241 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/GianGoulart/CrudProdutos/logger"
+	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/GianGoulart/CrudProdutos/store"
+)
+
+// IWebhookApp interface de webhook para implementação
+type IWebhookApp interface {
+	GetWebhooks(ctx context.Context) (*[]model.Webhook, error)
+	GetWebhookByCodigo(ctx context.Context, codigo string) (*model.Webhook, error)
+	CreateWebhook(ctx context.Context, webhook *model.Webhook) (*model.Webhook, error)
+	UpdateWebhook(ctx context.Context, webhook *model.Webhook) (*model.Webhook, error)
+	DeleteWebhook(ctx context.Context, codigo string) (*model.Webhook, error)
+	GetEntregas(ctx context.Context, filtro model.FiltroEntrega) (*[]model.Entrega, error)
+	ReenviarEntrega(ctx context.Context, codigo string) (*model.Entrega, error)
+}
+
+var (
+	// ErrWebhookNaoEncontrado erro retornado quando o webhook informado não existe
+	ErrWebhookNaoEncontrado = errors.New("webhook não encontrado")
+	// ErrEntregaNaoEncontrada erro retornado quando a entrega informada não existe
+	ErrEntregaNaoEncontrada = errors.New("entrega não encontrada")
+)
+
+// NewApp cria uma nova instancia do serviço de webhook
+func NewApp(store *store.Container) IWebhookApp {
+	return &appImpl{
+		stores: store,
+	}
+}
+
+type appImpl struct {
+	stores *store.Container
+}
+
+// GetWebhooks lista os webhooks sem o segredo, que só é exibido na criação
+func (p *appImpl) GetWebhooks(ctx context.Context) (*[]model.Webhook, error) {
+	webhooks, err := p.stores.Webhook.FindWebhooks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range *webhooks {
+		(*webhooks)[i].Segredo = ""
+	}
+
+	return webhooks, nil
+}
+
+func (p *appImpl) GetWebhookByCodigo(ctx context.Context, codigo string) (*model.Webhook, error) {
+	webhook, err := p.stores.Webhook.FindWebhookByCodigo(ctx, codigo)
+	if err != nil {
+		return nil, err
+	}
+
+	webhook.Segredo = ""
+
+	return webhook, nil
+}
+
+// CreateWebhook cadastra o webhook e retorna o segredo usado nas assinaturas,
+// gerado quando não informado
+func (p *appImpl) CreateWebhook(ctx context.Context, webhook *model.Webhook) (*model.Webhook, error) {
+	webhook.PreSave()
+
+	if err := p.validar(webhook); err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("codigo", webhook.Codigo).Warn("app.webhook.CreateWebhook")
+		return nil, err
+	}
+
+	return p.stores.Webhook.CreateWebhook(ctx, webhook)
+}
+
+// validar valida o webhook e, a menos que configurado para permitir, rejeita os
+// destinos internos
+func (p *appImpl) validar(webhook *model.Webhook) error {
+	if err := webhook.Validate(); err != nil {
+		return err
+	}
+
+	if p.stores.WebhooksInternos {
+		return nil
+	}
+
+	return webhook.ValidarDestino()
+}
+
+// UpdateWebhook altera o webhook, mantendo o segredo atual quando não informado
+func (p *appImpl) UpdateWebhook(ctx context.Context, webhook *model.Webhook) (*model.Webhook, error) {
+	if err := p.validar(webhook); err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("codigo", webhook.Codigo).Warn("app.webhook.UpdateWebhook")
+		return nil, err
+	}
+
+	atual, err := p.stores.Webhook.FindWebhookByCodigo(ctx, webhook.Codigo)
+	if err != nil {
+		return nil, err
+	}
+
+	if atual.Codigo == "" {
+		return nil, ErrWebhookNaoEncontrado
+	}
+
+	segredo := webhook.Segredo
+	if segredo == "" {
+		webhook.Segredo = atual.Segredo
+	}
+
+	webhook, err = p.stores.Webhook.UpdateWebhook(ctx, webhook)
+	if err != nil {
+		return nil, err
+	}
+
+	webhook.Segredo = segredo
+
+	return webhook, nil
+}
+
+// DeleteWebhook remove o webhook junto com o histórico das suas entregas
+func (p *appImpl) DeleteWebhook(ctx context.Context, codigo string) (*model.Webhook, error) {
+	webhook, err := p.stores.Webhook.FindWebhookByCodigo(ctx, codigo)
+	if err != nil {
+		return nil, err
+	}
+
+	if webhook.Codigo == "" {
+		return nil, ErrWebhookNaoEncontrado
+	}
+
+	if err := p.stores.Webhook.DeleteWebhookByCodigo(ctx, webhook); err != nil {
+		return nil, err
+	}
+
+	logger.FromContext(ctx).WithField("codigo", codigo).Info("app.webhook.DeleteWebhook")
+
+	webhook.Segredo = ""
+
+	return webhook, nil
+}
+
+func (p *appImpl) GetEntregas(ctx context.Context, filtro model.FiltroEntrega) (*[]model.Entrega, error) {
+	return p.stores.Webhook.FindEntregas(ctx, filtro)
+}
+
+// ReenviarEntrega devolve a entrega para a fila com as tentativas zeradas, usado
+// principalmente nas entregas que esgotaram as tentativas
+func (p *appImpl) ReenviarEntrega(ctx context.Context, codigo string) (*model.Entrega, error) {
+	entrega, err := p.stores.Webhook.FindEntregaByCodigo(ctx, codigo)
+	if err != nil {
+		return nil, err
+	}
+
+	if entrega.Codigo == "" {
+		return nil, ErrEntregaNaoEncontrada
+	}
+
+	entrega.Status = model.EntregaPendente
+	entrega.Tentativas = 0
+	entrega.ProximaTentativa = time.Now().Unix()
+
+	entrega, err = p.stores.Webhook.UpdateEntrega(ctx, entrega)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.FromContext(ctx).WithField("codigo", codigo).Info("app.webhook.ReenviarEntrega")
+
+	if p.stores.Entregador != nil {
+		p.stores.Entregador.Avisar()
+	}
+
+	return entrega, nil
+}
+
+// Publicar registra uma entrega do evento do produto para cada webhook ativo que
+// o assina, enviadas depois em segundo plano pelo entregador. Falhas são apenas
+// registradas e não interrompem a operação do produto
+func Publicar(ctx context.Context, stores *store.Container, tipo string, produto *model.Produto) {
+	if stores.Webhook == nil || produto == nil || produto.Codigo == "" {
+		return
+	}
+
+	log := logger.FromContext(ctx).WithField("produto", produto.Codigo).WithField("evento", tipo)
+
+	webhooks, err := stores.Webhook.FindWebhooks(ctx)
+	if err != nil {
+		log.WithError(err).Error("app.webhook.Publicar")
+		return
+	}
+
+	evento := model.Evento{
+		Codigo:     model.NewId(),
+		Tipo:       tipo,
+		Produto:    produto.Codigo,
+		OcorridoEm: time.Now().UTC().Format(time.RFC3339),
+		Dados:      produto,
+	}
+
+	payload, err := json.Marshal(evento)
+	if err != nil {
+		log.WithError(err).Error("app.webhook.Publicar")
+		return
+	}
+
+	entregas := []model.Entrega{}
+	for _, webhook := range *webhooks {
+		if webhook.Inativo || !webhook.Assina(tipo) {
+			continue
+		}
+
+		entregas = append(entregas, model.Entrega{
+			Codigo:           model.NewId(),
+			WebhookCodigo:    webhook.Codigo,
+			Evento:           tipo,
+			Produto:          produto.Codigo,
+			Payload:          string(payload),
+			Status:           model.EntregaPendente,
+			ProximaTentativa: time.Now().Unix(),
+		})
+	}
+
+	if len(entregas) == 0 {
+		return
+	}
+
+	if err := stores.Webhook.CreateEntregas(ctx, entregas); err != nil {
+		log.WithError(err).Error("app.webhook.Publicar")
+		return
+	}
+
+	if stores.Entregador != nil {
+		stores.Entregador.Avisar()
+	}
+}
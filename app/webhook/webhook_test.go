@@ -0,0 +1,171 @@
+package webhook_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/GianGoulart/CrudProdutos/app/webhook"
+	"github.com/GianGoulart/CrudProdutos/mocks"
+	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/GianGoulart/CrudProdutos/store"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_Publicar(t *testing.T) {
+	ctx := context.Background()
+	webhooks := &[]model.Webhook{
+		{Codigo: "w1", Eventos: model.ListaEventos{model.EventoProdutoCriado, model.EventoEstoqueAlterado}},
+		{Codigo: "w2", Eventos: model.ListaEventos{model.EventoProdutoRemovido}},
+		{Codigo: "w3", Eventos: model.ListaEventos{model.EventoProdutoCriado}, Inativo: true},
+	}
+
+	cases := map[string]struct {
+		Evento           string
+		ExpectedWebhooks []string
+
+		PrepareMock func(store *mocks.IWebhookStore, entregador *mocks.IEntregador, entregas *[]model.Entrega)
+	}{
+		"deve criar entregas para os webhooks ativos que assinam o evento": {Evento: model.EventoProdutoCriado, ExpectedWebhooks: []string{"w1"}, PrepareMock: func(s *mocks.IWebhookStore, e *mocks.IEntregador, entregas *[]model.Entrega) {
+			s.On("FindWebhooks", ctx).Return(webhooks, nil)
+			s.On("CreateEntregas", ctx, mock.Anything).Run(func(args mock.Arguments) {
+				*entregas = args.Get(1).([]model.Entrega)
+			}).Return(nil)
+			e.On("Avisar").Return()
+		}},
+		"não deve criar entregas sem assinantes": {Evento: model.EventoProdutoAlterado, PrepareMock: func(s *mocks.IWebhookStore, e *mocks.IEntregador, entregas *[]model.Entrega) {
+			s.On("FindWebhooks", ctx).Return(webhooks, nil)
+		}},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := new(mocks.IWebhookStore)
+			e := new(mocks.IEntregador)
+			entregas := []model.Entrega{}
+
+			cs.PrepareMock(s, e, &entregas)
+
+			webhook.Publicar(ctx, &store.Container{Webhook: s, Entregador: e}, cs.Evento, &model.Produto{Codigo: "p1", Nome: "Caneca"})
+
+			codigos := []string{}
+			for _, entrega := range entregas {
+				codigos = append(codigos, entrega.WebhookCodigo)
+				assert.Equal(t, model.EntregaPendente, entrega.Status)
+
+				var evento model.Evento
+				assert.NoError(t, json.Unmarshal([]byte(entrega.Payload), &evento))
+				assert.Equal(t, cs.Evento, evento.Tipo)
+				assert.Equal(t, "p1", evento.Produto)
+			}
+
+			if diff := cmp.Diff(codigos, cs.ExpectedWebhooks, cmpopts.EquateEmpty()); diff != "" {
+				t.Error(diff)
+			}
+
+			s.AssertExpectations(t)
+			e.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_ReenviarEntrega(t *testing.T) {
+	ctx := context.Background()
+
+	cases := map[string]struct {
+		Input       string
+		ExpectedErr error
+
+		PrepareMock func(store *mocks.IWebhookStore, entregador *mocks.IEntregador)
+	}{
+		"deve devolver a entrega para a fila": {Input: "e1", PrepareMock: func(s *mocks.IWebhookStore, e *mocks.IEntregador) {
+			s.On("FindEntregaByCodigo", ctx, "e1").Return(&model.Entrega{Codigo: "e1", Status: model.EntregaFalha, Tentativas: 8}, nil)
+			s.On("UpdateEntrega", ctx, mock.MatchedBy(func(e *model.Entrega) bool {
+				return e.Status == model.EntregaPendente && e.Tentativas == 0 && e.ProximaTentativa > 0
+			})).Return(func(_ context.Context, e *model.Entrega) *model.Entrega { return e }, nil)
+			e.On("Avisar").Return()
+		}},
+		"deve retornar erro com a entrega inexistente": {Input: "xpto", ExpectedErr: webhook.ErrEntregaNaoEncontrada, PrepareMock: func(s *mocks.IWebhookStore, e *mocks.IEntregador) {
+			s.On("FindEntregaByCodigo", ctx, "xpto").Return(&model.Entrega{}, nil)
+		}},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := new(mocks.IWebhookStore)
+			e := new(mocks.IEntregador)
+
+			cs.PrepareMock(s, e)
+
+			app := webhook.NewApp(&store.Container{Webhook: s, Entregador: e})
+
+			_, err := app.ReenviarEntrega(ctx, cs.Input)
+
+			if diff := cmp.Diff(err, cs.ExpectedErr, cmpopts.EquateErrors()); diff != "" {
+				t.Error(diff)
+			}
+
+			s.AssertExpectations(t)
+			e.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_UpdateWebhook(t *testing.T) {
+	ctx := context.Background()
+
+	s := new(mocks.IWebhookStore)
+	s.On("FindWebhookByCodigo", ctx, "w1").Return(&model.Webhook{Codigo: "w1", Segredo: "atual"}, nil)
+	s.On("UpdateWebhook", ctx, mock.MatchedBy(func(w *model.Webhook) bool { return w.Segredo == "atual" })).
+		Return(func(_ context.Context, w *model.Webhook) *model.Webhook { return w }, nil)
+
+	app := webhook.NewApp(&store.Container{Webhook: s})
+
+	// sem segredo no payload o atual é mantido, e não é devolvido na resposta
+	res, err := app.UpdateWebhook(ctx, &model.Webhook{Codigo: "w1", URL: "https://erp.local/hook", Eventos: model.ListaEventos{model.EventoProdutoCriado}})
+	assert.NoError(t, err)
+	assert.Empty(t, res.Segredo)
+
+	s.AssertExpectations(t)
+}
+
+func Test_CreateWebhook(t *testing.T) {
+	ctx := context.Background()
+
+	cases := map[string]struct {
+		URL         string
+		Internos    bool
+		ExpectedErr error
+
+		PrepareMock func(store *mocks.IWebhookStore)
+	}{
+		"deve cadastrar o destino público": {URL: "https://erp.exemplo.com.br/hook", PrepareMock: func(s *mocks.IWebhookStore) {
+			s.On("CreateWebhook", ctx, mock.Anything).Return(func(_ context.Context, w *model.Webhook) *model.Webhook { return w }, nil)
+		}},
+		"deve rejeitar o destino interno": {URL: "http://169.254.169.254/latest/meta-data", ExpectedErr: model.ErrDestinoInterno, PrepareMock: func(s *mocks.IWebhookStore) {}},
+		"deve cadastrar o destino interno quando permitido": {URL: "http://127.0.0.1:8080/hook", Internos: true, PrepareMock: func(s *mocks.IWebhookStore) {
+			s.On("CreateWebhook", ctx, mock.Anything).Return(func(_ context.Context, w *model.Webhook) *model.Webhook { return w }, nil)
+		}},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := new(mocks.IWebhookStore)
+
+			cs.PrepareMock(s)
+
+			app := webhook.NewApp(&store.Container{Webhook: s, WebhooksInternos: cs.Internos})
+
+			_, err := app.CreateWebhook(ctx, &model.Webhook{URL: cs.URL, Eventos: model.ListaEventos{model.EventoProdutoCriado}})
+
+			if diff := cmp.Diff(err, cs.ExpectedErr, cmpopts.EquateErrors()); diff != "" {
+				t.Error(diff)
+			}
+
+			s.AssertExpectations(t)
+		})
+	}
+}
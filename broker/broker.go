@@ -0,0 +1,58 @@
+package broker
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Brokers suportados
+const (
+	BrokerMemory = "memory"
+	BrokerNATS   = "nats"
+	BrokerKafka  = "kafka"
+)
+
+// Mensagem evento publicado no broker
+type Mensagem struct {
+	// Codigo identificador do evento, para o consumidor descartar repetições
+	Codigo string
+	// Chave codigo do produto, as mensagens de uma mesma chave mantêm a ordem
+	Chave   string
+	Tipo    string
+	Payload []byte
+}
+
+// IBroker interface para implementação dos brokers de eventos
+type IBroker interface {
+	// Publicar retorna depois que o broker confirmou o recebimento da mensagem
+	Publicar(ctx context.Context, msg Mensagem) error
+	Close() error
+}
+
+// Options struct de opções para a criação do broker
+type Options struct {
+	Broker string
+
+	NATSURL     string
+	NATSPrefixo string
+
+	KafkaBrokers []string
+	KafkaTopico  string
+	// KafkaEsperaLote tempo máximo que cada publicação aguarda para formar o lote
+	KafkaEsperaLote time.Duration
+}
+
+// New cria o broker configurado
+func New(opts Options) (IBroker, error) {
+	switch opts.Broker {
+	case BrokerMemory:
+		return NewMemory(), nil
+	case BrokerNATS:
+		return NewNATS(opts.NATSURL, opts.NATSPrefixo)
+	case BrokerKafka:
+		return NewKafka(opts.KafkaBrokers, opts.KafkaTopico, opts.KafkaEsperaLote), nil
+	default:
+		return nil, fmt.Errorf("broker não suportado: %s", opts.Broker)
+	}
+}
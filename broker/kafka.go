@@ -0,0 +1,49 @@
+package broker
+
+import (
+	"context"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+)
+
+// Headers enviados em cada mensagem do Kafka
+const (
+	HeaderTipo   = "tipo"
+	HeaderCodigo = "codigo"
+)
+
+type kafkaImpl struct {
+	writer *kafka.Writer
+}
+
+// NewKafka cria o broker que publica os eventos no tópico informado, com a chave
+// da mensagem definindo a partição e com isso a ordem por produto. Cada publicação
+// aguarda até esperaLote por outras mensagens antes de enviar o lote; como o relay
+// publica um evento por vez, a espera limita a vazão a um evento por esperaLote
+func NewKafka(brokers []string, topico string, esperaLote time.Duration) IBroker {
+	return &kafkaImpl{
+		writer: &kafka.Writer{
+			Addr:         kafka.TCP(brokers...),
+			Topic:        topico,
+			Balancer:     &kafka.Hash{},
+			RequiredAcks: kafka.RequireAll,
+			BatchTimeout: esperaLote,
+		},
+	}
+}
+
+func (b *kafkaImpl) Publicar(ctx context.Context, msg Mensagem) error {
+	return b.writer.WriteMessages(ctx, kafka.Message{
+		Key:   []byte(msg.Chave),
+		Value: msg.Payload,
+		Headers: []kafka.Header{
+			{Key: HeaderTipo, Value: []byte(msg.Tipo)},
+			{Key: HeaderCodigo, Value: []byte(msg.Codigo)},
+		},
+	})
+}
+
+func (b *kafkaImpl) Close() error {
+	return b.writer.Close()
+}
@@ -0,0 +1,52 @@
+package broker
+
+import (
+	"context"
+	"sync"
+)
+
+// Memory broker em memória que guarda as mensagens publicadas, para
+// desenvolvimento e testes
+type Memory struct {
+	mu        sync.Mutex
+	mensagens []Mensagem
+	err       error
+}
+
+// NewMemory cria uma nova instancia do broker em memória
+func NewMemory() *Memory {
+	return &Memory{}
+}
+
+func (b *Memory) Publicar(ctx context.Context, msg Mensagem) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.err != nil {
+		return b.err
+	}
+
+	b.mensagens = append(b.mensagens, msg)
+
+	return nil
+}
+
+func (b *Memory) Close() error {
+	return nil
+}
+
+// Mensagens retorna as mensagens publicadas, na ordem de publicação
+func (b *Memory) Mensagens() []Mensagem {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return append([]Mensagem(nil), b.mensagens...)
+}
+
+// Falhar faz as próximas publicações retornarem o erro informado, nil volta a publicar
+func (b *Memory) Falhar(err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.err = err
+}
@@ -0,0 +1,58 @@
+package broker
+
+import (
+	"context"
+
+	"github.com/nats-io/nats.go"
+)
+
+// HeaderChave header com a chave da mensagem no NATS. O codigo do evento vai no
+// Nats-Msg-Id, usado pelo JetStream para descartar repetições
+const HeaderChave = "Chave"
+
+type natsImpl struct {
+	conn    *nats.Conn
+	js      nats.JetStreamContext
+	prefixo string
+}
+
+// NewNATS cria o broker que publica cada evento no subject <prefixo>.<tipo> pelo
+// JetStream. Os subjects devem estar cobertos por um stream já criado
+func NewNATS(url, prefixo string) (IBroker, error) {
+	conn, err := nats.Connect(url, nats.Name("crudprodutos"))
+	if err != nil {
+		return nil, err
+	}
+
+	js, err := conn.JetStream()
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	return &natsImpl{conn: conn, js: js, prefixo: prefixo}, nil
+}
+
+// Publicar só retorna sucesso depois do ack do JetStream, que confirma que a
+// mensagem foi gravada no stream. O publish do NATS core não tem essa garantia
+// e descarta a mensagem quando não há assinantes
+func (b *natsImpl) Publicar(ctx context.Context, msg Mensagem) error {
+	m := nats.NewMsg(b.prefixo + "." + msg.Tipo)
+	m.Header.Set(nats.MsgIdHdr, msg.Codigo)
+	m.Header.Set(HeaderChave, msg.Chave)
+	m.Data = msg.Payload
+
+	// sem prazo no contexto vale a espera padrão do JetStream pelo ack
+	opts := []nats.PubOpt{}
+	if _, ok := ctx.Deadline(); ok {
+		opts = append(opts, nats.Context(ctx))
+	}
+
+	_, err := b.js.PublishMsg(m, opts...)
+
+	return err
+}
+
+func (b *natsImpl) Close() error {
+	return b.conn.Drain()
+}
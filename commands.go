@@ -0,0 +1,220 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/GianGoulart/CrudProdutos/app"
+	"github.com/GianGoulart/CrudProdutos/broker"
+	"github.com/GianGoulart/CrudProdutos/entregador"
+	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/GianGoulart/CrudProdutos/notificacao"
+	"github.com/GianGoulart/CrudProdutos/relay"
+	"github.com/GianGoulart/CrudProdutos/store"
+	"github.com/GianGoulart/CrudProdutos/store/cache"
+	"github.com/GianGoulart/CrudProdutos/store/indice"
+	"github.com/GianGoulart/CrudProdutos/stream"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// runCommand executa os subcomandos da aplicação, retornando false quando os
+// argumentos não correspondem a nenhum subcomando e o server deve ser iniciado
+func runCommand(args []string) bool {
+	if len(args) < 2 {
+		return false
+	}
+
+	var err error
+	switch args[0] + " " + args[1] {
+	case "config check":
+		// valida e imprime a configuração efetiva
+		err = model.Check(os.Stdout)
+	case "indice rebuild":
+		// recria o índice de sugestões a partir do banco, com o server parado
+		err = rebuildIndice()
+	default:
+		return false
+	}
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		os.Exit(1)
+	}
+
+	return true
+}
+
+func rebuildIndice() error {
+	settings, err := model.Load()
+	if err != nil {
+		return err
+	}
+
+	if !settings.Indice.Enabled {
+		return fmt.Errorf("índice de sugestões desabilitado")
+	}
+
+	stores, closeStores, err := newStores(settings)
+	if err != nil {
+		return err
+	}
+	defer closeStores()
+
+	apps := app.New(app.Options{Stores: stores, Version: settings.Version})
+
+	total, err := apps.Produto.RebuildIndice(context.Background())
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("índice recriado com %d produtos\n", total)
+
+	return nil
+}
+
+// newStores cria os repositórios com as conexões configuradas, retornando a
+// função que encerra essas conexões
+func newStores(settings model.Settings) (*store.Container, func(), error) {
+	var dbWriter *gorm.DB
+	if settings.Database.Driver != store.DriverMemory {
+		var err error
+		dbWriter, err = store.Open(settings.Database.Driver, settings.Database.Writer.URL)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var produtoCache cache.Cache
+	if settings.Cache.Enabled {
+		var err error
+		produtoCache, err = store.NewCache(settings.Cache.Backend, settings.Cache.Size, settings.Cache.Redis.URL)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var produtoIndice indice.IIndice
+	if settings.Indice.Enabled {
+		var err error
+		produtoIndice, err = indice.New(settings.Indice.Path)
+		if err != nil {
+			return nil, nil, fmt.Errorf("não consegui abrir o índice de sugestões em %q: %w", settings.Indice.Path, err)
+		}
+	}
+
+	var notificador notificacao.INotificador
+	if len(settings.Alertas.Canais) > 0 {
+		n, err := notificacao.New(notificacao.Options{
+			Canais:     settings.Alertas.Canais,
+			WebhookURL: settings.Alertas.Webhook.URL,
+			Timeout:    settings.Alertas.Webhook.Timeout,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		notificador = n
+	}
+
+	var produtoStream stream.IStream
+	if settings.Stream.Enabled {
+		produtoStream = stream.New(settings.Stream.Buffer, settings.Stream.Pendentes)
+	}
+
+	// criação dos stores com a injeção do banco de escrita e leitura
+	stores := store.New(store.Options{
+		DB:       dbWriter,
+		Driver:   settings.Database.Driver,
+		Cache:    produtoCache,
+		CacheTTL: settings.Cache.TTL,
+		Indice:   produtoIndice,
+
+		Notificador:  notificador,
+		LimiteAlerta: settings.Alertas.LimitePadrao,
+		LimitesPreco: settings.Precos.LimitesPreco(),
+
+		WebhooksInternos: settings.Webhooks.PermitirInternos,
+
+		Outbox: settings.Outbox.Enabled,
+		Stream: produtoStream,
+	})
+
+	// o entregador depende do store de webhooks, por isso é criado depois. Ele
+	// só é iniciado pelo server, os subcomandos apenas gravam as entregas
+	if settings.Webhooks.Enabled {
+		stores.Entregador = entregador.New(entregador.Options{
+			Store:         stores.Webhook,
+			Intervalo:     settings.Webhooks.Intervalo,
+			Timeout:       settings.Webhooks.Timeout,
+			MaxTentativas: settings.Webhooks.MaxTentativas,
+			Espera:        settings.Webhooks.Espera,
+			EsperaMaxima:  settings.Webhooks.EsperaMaxima,
+			Lote:          settings.Webhooks.Lote,
+
+			PermitirInternos: settings.Webhooks.PermitirInternos,
+		})
+	}
+
+	// assim como o relay, que publica a outbox no broker configurado
+	var eventos broker.IBroker
+	if settings.Outbox.Enabled {
+		var err error
+		eventos, err = broker.New(broker.Options{
+			Broker:          settings.Outbox.Broker,
+			NATSURL:         settings.Outbox.NATS.URL,
+			NATSPrefixo:     settings.Outbox.NATS.Prefixo,
+			KafkaBrokers:    settings.Outbox.Kafka.Brokers,
+			KafkaTopico:     settings.Outbox.Kafka.Topico,
+			KafkaEsperaLote: settings.Outbox.Kafka.EsperaLote,
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("não consegui conectar ao broker %s: %w", settings.Outbox.Broker, err)
+		}
+
+		stores.Relay = relay.New(relay.Options{
+			Store:     stores.Outbox,
+			Broker:    eventos,
+			Intervalo: settings.Outbox.Intervalo,
+			Lote:      settings.Outbox.Lote,
+			Retencao:  settings.Outbox.Retencao,
+
+			MaxTentativas: settings.Outbox.MaxTentativas,
+		})
+	}
+
+	closeStores := func() {
+		if stores.Entregador != nil {
+			stores.Entregador.Stop()
+		}
+
+		if stores.Relay != nil {
+			stores.Relay.Stop()
+		}
+
+		// os alertas já enviados em segundo plano não se perdem na recarga
+		if notificador != nil {
+			notificador.Wait()
+		}
+
+		if eventos != nil {
+			if err := eventos.Close(); err != nil {
+				logrus.WithError(err).Error("erro ao fechar o broker")
+			}
+		}
+
+		if produtoIndice != nil {
+			if err := produtoIndice.Close(); err != nil {
+				logrus.WithError(err).Error("erro ao fechar o índice de sugestões")
+			}
+		}
+
+		if dbWriter != nil {
+			if sqlDB, err := dbWriter.DB(); err == nil {
+				sqlDB.Close()
+			}
+		}
+	}
+
+	return stores, closeStores, nil
+}
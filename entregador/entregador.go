@@ -0,0 +1,275 @@
+package entregador
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/GianGoulart/CrudProdutos/logger"
+	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/GianGoulart/CrudProdutos/store/webhook"
+	"github.com/sirupsen/logrus"
+)
+
+// Headers enviados em cada entrega
+const (
+	HeaderEvento     = "X-Webhook-Evento"
+	HeaderEntrega    = "X-Webhook-Entrega"
+	HeaderTimestamp  = "X-Webhook-Timestamp"
+	HeaderAssinatura = "X-Webhook-Assinatura"
+)
+
+// IEntregador interface do envio das entregas em segundo plano
+type IEntregador interface {
+	Start()
+	Stop()
+	// Avisar indica que há entregas novas para enviar
+	Avisar()
+}
+
+// Options struct de opções para a criação do entregador
+type Options struct {
+	Store webhook.IWebhookStore
+
+	// Intervalo entre as verificações das entregas pendentes
+	Intervalo time.Duration
+	// Timeout de cada requisição ao webhook
+	Timeout time.Duration
+	// MaxTentativas tentativas antes de mover a entrega para a lista de falhas
+	MaxTentativas int
+	// Espera inicial entre as tentativas, dobrada a cada falha até a EsperaMaxima
+	Espera       time.Duration
+	EsperaMaxima time.Duration
+	// Lote quantidade máxima de entregas processadas por verificação
+	Lote int
+	// PermitirInternos permite a entrega em endereços internos. Sem ele o endereço
+	// resolvido é verificado a cada conexão, valendo também para os redirecionamentos
+	PermitirInternos bool
+}
+
+// Entregador envia as entregas pendentes aos webhooks em segundo plano, com
+// novas tentativas em backoff exponencial. A entrega é pelo menos uma vez: o
+// destino deve usar o header X-Webhook-Entrega para descartar repetições
+type Entregador struct {
+	opts   Options
+	client *http.Client
+
+	aviso chan struct{}
+	quit  chan struct{}
+	wg    sync.WaitGroup
+}
+
+// New cria uma nova instancia do entregador
+func New(opts Options) *Entregador {
+	return &Entregador{
+		opts:   opts,
+		client: newClient(opts),
+		aviso:  make(chan struct{}, 1),
+		quit:   make(chan struct{}),
+	}
+}
+
+func newClient(opts Options) *http.Client {
+	if opts.PermitirInternos {
+		return &http.Client{Timeout: opts.Timeout}
+	}
+
+	dialer := &net.Dialer{
+		Timeout: 30 * time.Second,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+
+			if ip := net.ParseIP(host); ip == nil || model.EnderecoInterno(ip) {
+				return fmt.Errorf("%w: %s", model.ErrDestinoInterno, host)
+			}
+
+			return nil
+		},
+	}
+
+	// sem proxy, para que a verificação seja feita no endereço do destino
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{Timeout: opts.Timeout, Transport: transport}
+}
+
+// Start inicia o processamento das entregas
+func (e *Entregador) Start() {
+	e.wg.Add(1)
+
+	go func() {
+		defer e.wg.Done()
+
+		ticker := time.NewTicker(e.opts.Intervalo)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-e.quit:
+				return
+			case <-ticker.C:
+			case <-e.aviso:
+			}
+
+			e.Processar(context.Background())
+		}
+	}()
+
+	logrus.Info("Registered -> Entregador")
+}
+
+// Stop encerra o processamento, aguardando a verificação em andamento
+func (e *Entregador) Stop() {
+	close(e.quit)
+	e.wg.Wait()
+}
+
+// Avisar antecipa a próxima verificação, sem bloquear quem avisa
+func (e *Entregador) Avisar() {
+	select {
+	case e.aviso <- struct{}{}:
+	default:
+	}
+}
+
+// Processar envia as entregas pendentes até o momento e retorna quantas foram entregues
+func (e *Entregador) Processar(ctx context.Context) int {
+	entregas, err := e.opts.Store.FindEntregasPendentes(ctx, time.Now().Unix(), e.opts.Lote)
+	if err != nil {
+		return 0
+	}
+
+	webhooks := make(map[string]*model.Webhook)
+	entregues := 0
+
+	for i := range *entregas {
+		entrega := &(*entregas)[i]
+
+		hook, ok := webhooks[entrega.WebhookCodigo]
+		if !ok {
+			if hook, err = e.opts.Store.FindWebhookByCodigo(ctx, entrega.WebhookCodigo); err != nil {
+				continue
+			}
+			webhooks[entrega.WebhookCodigo] = hook
+		}
+
+		if e.entregar(ctx, hook, entrega) {
+			entregues++
+		}
+	}
+
+	return entregues
+}
+
+// entregar faz uma tentativa de envio e grava o resultado na entrega
+func (e *Entregador) entregar(ctx context.Context, hook *model.Webhook, entrega *model.Entrega) bool {
+	log := logger.FromContext(ctx).WithField("entrega", entrega.Codigo).WithField("webhook", entrega.WebhookCodigo)
+
+	entrega.Tentativas++
+
+	var err error
+	switch {
+	case hook.Codigo == "":
+		err = fmt.Errorf("webhook removido")
+	case hook.Inativo:
+		err = fmt.Errorf("webhook inativo")
+	default:
+		entrega.UltimoStatus, err = e.enviar(ctx, hook, entrega)
+	}
+
+	if err == nil {
+		entrega.Status = model.EntregaEntregue
+		entrega.UltimoErro = ""
+	} else {
+		entrega.UltimoErro = err.Error()
+		entrega.ProximaTentativa = time.Now().Add(Backoff(e.opts.Espera, e.opts.EsperaMaxima, entrega.Tentativas)).Unix()
+
+		// sem destino ativo não adianta tentar de novo
+		if entrega.Tentativas >= e.opts.MaxTentativas || hook.Codigo == "" || hook.Inativo {
+			entrega.Status = model.EntregaFalha
+			log.WithError(err).Warn("entregador.entregar")
+		}
+	}
+
+	if _, err := e.opts.Store.UpdateEntrega(ctx, entrega); err != nil {
+		log.WithError(err).Error("entregador.entregar")
+	}
+
+	return entrega.Status == model.EntregaEntregue
+}
+
+func (e *Entregador) enviar(ctx context.Context, hook *model.Webhook, entrega *model.Entrega) (int, error) {
+	body := []byte(entrega.Payload)
+	timestamp := time.Now().Unix()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, hook.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEvento, entrega.Evento)
+	req.Header.Set(HeaderEntrega, entrega.Codigo)
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderAssinatura, Assinar(hook.Segredo, timestamp, body))
+
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	// descarta o corpo para reaproveitar a conexão
+	io.Copy(ioutil.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("webhook respondeu com status %d", resp.StatusCode)
+	}
+
+	return resp.StatusCode, nil
+}
+
+// Assinar calcula a assinatura HMAC-SHA256 de uma entrega. O conteúdo assinado
+// é o timestamp, um ponto e o corpo, para que uma entrega antiga não possa ser
+// reaproveitada com outro timestamp
+func Assinar(segredo string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(segredo))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Backoff espera antes da próxima tentativa: a espera inicial dobrada a cada
+// tentativa que falhou, limitada à espera máxima
+func Backoff(espera, maxima time.Duration, tentativas int) time.Duration {
+	if espera >= maxima {
+		return maxima
+	}
+
+	for i := 1; i < tentativas; i++ {
+		espera *= 2
+		if espera >= maxima {
+			return maxima
+		}
+	}
+
+	return espera
+}
@@ -0,0 +1,144 @@
+package entregador_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/GianGoulart/CrudProdutos/entregador"
+	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/GianGoulart/CrudProdutos/store/webhook"
+	"github.com/stretchr/testify/assert"
+)
+
+func newEntregador(s webhook.IWebhookStore, permitirInternos bool) *entregador.Entregador {
+	return entregador.New(entregador.Options{
+		Store:         s,
+		Intervalo:     time.Hour,
+		Timeout:       time.Second,
+		MaxTentativas: 2,
+		Espera:        time.Minute,
+		EsperaMaxima:  time.Hour,
+		Lote:          10,
+
+		PermitirInternos: permitirInternos,
+	})
+}
+
+func Test_Processar(t *testing.T) {
+	cases := map[string]struct {
+		Status            int
+		Inativo           bool
+		Bloquear          bool
+		Tentativas        int
+		ExpectedStatus    string
+		ExpectedRecebidas int
+	}{
+		"deve entregar com status 2xx": {
+			Status:            http.StatusNoContent,
+			ExpectedStatus:    model.EntregaEntregue,
+			ExpectedRecebidas: 1,
+		},
+		"deve manter pendente para nova tentativa": {
+			Status:            http.StatusServiceUnavailable,
+			ExpectedStatus:    model.EntregaPendente,
+			ExpectedRecebidas: 1,
+		},
+		"deve mover para falha ao esgotar as tentativas": {
+			Status:            http.StatusServiceUnavailable,
+			Tentativas:        1,
+			ExpectedStatus:    model.EntregaFalha,
+			ExpectedRecebidas: 1,
+		},
+		"deve bloquear o destino interno": {
+			Status:         http.StatusNoContent,
+			Bloquear:       true,
+			ExpectedStatus: model.EntregaPendente,
+		},
+		"deve mover para falha com o webhook inativo": {
+			Status:         http.StatusNoContent,
+			Inativo:        true,
+			ExpectedStatus: model.EntregaFalha,
+		},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			recebidas := 0
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				recebidas++
+
+				body, _ := ioutil.ReadAll(r.Body)
+				ts, _ := strconv.ParseInt(r.Header.Get(entregador.HeaderTimestamp), 10, 64)
+
+				assert.Equal(t, `{"tipo":"produto.criado"}`, string(body))
+				assert.Equal(t, model.EventoProdutoCriado, r.Header.Get(entregador.HeaderEvento))
+				assert.Equal(t, "e1", r.Header.Get(entregador.HeaderEntrega))
+				assert.Equal(t, entregador.Assinar("segredo", ts, body), r.Header.Get(entregador.HeaderAssinatura))
+
+				w.WriteHeader(cs.Status)
+			}))
+			defer srv.Close()
+
+			s := webhook.NewWebhookMemory()
+			s.CreateWebhook(ctx, &model.Webhook{Codigo: "w1", URL: srv.URL, Segredo: "segredo", Eventos: model.ListaEventos{model.EventoProdutoCriado}, Inativo: cs.Inativo})
+			s.CreateEntregas(ctx, []model.Entrega{{
+				Codigo:        "e1",
+				WebhookCodigo: "w1",
+				Evento:        model.EventoProdutoCriado,
+				Payload:       `{"tipo":"produto.criado"}`,
+				Status:        model.EntregaPendente,
+				Tentativas:    cs.Tentativas,
+			}})
+
+			newEntregador(s, !cs.Bloquear).Processar(ctx)
+
+			entrega, _ := s.FindEntregaByCodigo(ctx, "e1")
+			assert.Equal(t, cs.ExpectedStatus, entrega.Status)
+			assert.Equal(t, cs.Tentativas+1, entrega.Tentativas)
+			assert.Equal(t, cs.ExpectedRecebidas, recebidas)
+
+			if cs.ExpectedStatus == model.EntregaPendente {
+				// a nova tentativa fica para depois da espera e não é feita agora
+				assert.Greater(t, entrega.ProximaTentativa, time.Now().Unix())
+				assert.NotEmpty(t, entrega.UltimoErro)
+				assert.Equal(t, 0, newEntregador(s, !cs.Bloquear).Processar(ctx))
+				assert.Equal(t, cs.ExpectedRecebidas, recebidas)
+			}
+
+			if cs.Bloquear {
+				assert.Contains(t, entrega.UltimoErro, model.ErrDestinoInterno.Error())
+			}
+		})
+	}
+}
+
+func Test_Backoff(t *testing.T) {
+	cases := map[string]struct {
+		Tentativas int
+		Expected   time.Duration
+	}{
+		"primeira tentativa": {Tentativas: 1, Expected: 10 * time.Second},
+		"dobra a cada falha": {Tentativas: 3, Expected: 40 * time.Second},
+		"limitada à máxima":  {Tentativas: 20, Expected: time.Minute},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, cs.Expected, entregador.Backoff(10*time.Second, time.Minute, cs.Tentativas))
+		})
+	}
+}
+
+func Test_Assinar(t *testing.T) {
+	// valor calculado de forma independente com openssl
+	assert.Equal(t,
+		"sha256=28d73844c84580182772a1e98a60aeb8f04184b1bef0d4e147cfa59ebf0bfedc",
+		entregador.Assinar("segredo", 1700000000, []byte(`{}`)))
+}
@@ -4,13 +4,24 @@ go 1.16
 
 require (
 	github.com/DATA-DOG/go-sqlmock v1.5.0
+	github.com/blevesearch/bleve/v2 v2.3.0
+	github.com/fsnotify/fsnotify v1.5.1
 	github.com/go-playground/validator/v10 v10.10.0
+	github.com/go-redis/redis/v8 v8.11.4
 	github.com/google/go-cmp v0.5.6
+	github.com/graphql-go/graphql v0.8.0
 	github.com/labstack/echo/v4 v4.1.17
+	github.com/nats-io/nats.go v1.13.0
+	github.com/segmentio/kafka-go v0.4.28
 	github.com/sirupsen/logrus v1.8.1
 	github.com/spf13/viper v1.10.1
 	github.com/stretchr/testify v1.7.0
 	github.com/xtgo/uuid v0.0.0-20140804021211-a0b114877d4c
+	golang.org/x/text v0.3.7
+	google.golang.org/grpc v1.44.0
+	google.golang.org/protobuf v1.27.1
 	gorm.io/driver/mysql v1.2.3
+	gorm.io/driver/postgres v1.2.3
+	gorm.io/driver/sqlite v1.2.6
 	gorm.io/gorm v1.22.5
 )
@@ -0,0 +1,273 @@
+package idempotency
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/GianGoulart/CrudProdutos/logger"
+	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/GianGoulart/CrudProdutos/store/cache"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	// HeaderIdempotencyKey header enviado pelo cliente para identificar a operação
+	HeaderIdempotencyKey = "Idempotency-Key"
+	// HeaderReplayed header presente nas respostas reproduzidas
+	HeaderReplayed = "Idempotent-Replayed"
+)
+
+// Options struct de opções para a criação do middleware de idempotência
+type Options struct {
+	Cache cache.Cache
+	TTL   time.Duration
+	// Processamento tempo que a chave fica reservada enquanto a requisição é
+	// executada. Se a instancia cair no meio dela, a chave é liberada após esse tempo
+	Processamento time.Duration
+}
+
+// record resposta guardada para uma chave
+type record struct {
+	Fingerprint string `json:"fingerprint"`
+	Done        bool   `json:"done"`
+	Status      int    `json:"status,omitempty"`
+	ContentType string `json:"content_type,omitempty"`
+	Body        []byte `json:"body,omitempty"`
+}
+
+type idempotencyImpl struct {
+	opts Options
+
+	mu    sync.Mutex
+	locks map[string]*keyLock
+}
+
+type keyLock struct {
+	sync.Mutex
+	refs int
+}
+
+// Middleware guarda a resposta das requisições com o header Idempotency-Key e a
+// reproduz nas repetições dentro da janela configurada. Uma chave reutilizada com
+// outro corpo é rejeitada com 422
+func Middleware(opts Options) echo.MiddlewareFunc {
+	if opts.Processamento <= 0 {
+		opts.Processamento = time.Minute
+	}
+
+	i := &idempotencyImpl{
+		opts:  opts,
+		locks: make(map[string]*keyLock),
+	}
+
+	return i.handle
+}
+
+func (i *idempotencyImpl) handle(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		key := c.Request().Header.Get(HeaderIdempotencyKey)
+		if key == "" {
+			return next(c)
+		}
+
+		ctx := c.Request().Context()
+		log := logger.FromContext(ctx).WithField("idempotency_key", key)
+
+		body, err := ioutil.ReadAll(c.Request().Body)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, model.Response{Err: err.Error()})
+		}
+		c.Request().Body = ioutil.NopCloser(bytes.NewReader(body))
+
+		// a chave é única por rota. Sem autenticação o cliente não é identificado com
+		// segurança, e o ip muda entre as tentativas que saem por outro proxy
+		cacheKey := "idempotency:" + c.Request().Method + " " + c.Path() + "|" + key
+		fingerprint := fingerprint(c.Request().Method, c.Request().URL.Path, body)
+
+		unlock := i.lock(cacheKey)
+		defer unlock()
+
+		rec, found, err := i.get(c, cacheKey)
+		if err != nil {
+			log.WithError(err).Error("idempotency.get")
+			return c.JSON(http.StatusInternalServerError, model.Response{Err: err.Error()})
+		}
+
+		if !found {
+			// reserva a chave de forma atômica, já que outra instancia pode ter recebido
+			// a mesma chave depois da leitura
+			reservada, err := i.reservar(c, cacheKey, fingerprint)
+			if err != nil {
+				log.WithError(err).Error("idempotency.reservar")
+				return c.JSON(http.StatusInternalServerError, model.Response{Err: err.Error()})
+			}
+
+			if !reservada {
+				if rec, found, err = i.get(c, cacheKey); err != nil {
+					log.WithError(err).Error("idempotency.get")
+					return c.JSON(http.StatusInternalServerError, model.Response{Err: err.Error()})
+				}
+				// a reserva expirou entre as duas leituras, o cliente pode tentar novamente
+				if !found {
+					rec = record{Fingerprint: fingerprint}
+				}
+				found = true
+			}
+		}
+
+		if found {
+			if rec.Fingerprint != fingerprint {
+				log.Warn("idempotency.chave reutilizada com outro corpo")
+				return c.JSON(http.StatusUnprocessableEntity, model.Response{
+					Err: "Idempotency-Key já utilizada com outro corpo de requisição",
+				})
+			}
+
+			if !rec.Done {
+				return c.JSON(http.StatusConflict, model.Response{
+					Err: "requisição com a mesma Idempotency-Key em processamento",
+				})
+			}
+
+			log.Info("idempotency.replay")
+			c.Response().Header().Set(HeaderReplayed, "true")
+			return c.Blob(rec.Status, rec.ContentType, rec.Body)
+		}
+
+		// libera a reserva quando o handler não termina, como em um panic
+		concluida := false
+		defer func() {
+			if !concluida {
+				i.delete(c, cacheKey)
+			}
+		}()
+
+		capture := &captureWriter{ResponseWriter: c.Response().Writer}
+		c.Response().Writer = capture
+
+		if err := next(c); err != nil {
+			c.Error(err)
+		}
+
+		concluida = true
+		status := c.Response().Status
+
+		// erros do servidor não são guardados para o cliente poder tentar novamente
+		if status >= http.StatusInternalServerError {
+			i.delete(c, cacheKey)
+			return nil
+		}
+
+		rec = record{
+			Fingerprint: fingerprint,
+			Done:        true,
+			Status:      status,
+			ContentType: c.Response().Header().Get(echo.HeaderContentType),
+			Body:        capture.body.Bytes(),
+		}
+		if err := i.set(c, cacheKey, rec); err != nil {
+			log.WithError(err).Error("idempotency.set")
+		}
+
+		return nil
+	}
+}
+
+// lock serializa as requisições com a mesma chave nesta instancia
+func (i *idempotencyImpl) lock(key string) func() {
+	i.mu.Lock()
+	l, ok := i.locks[key]
+	if !ok {
+		l = new(keyLock)
+		i.locks[key] = l
+	}
+	l.refs++
+	i.mu.Unlock()
+
+	l.Lock()
+
+	return func() {
+		l.Unlock()
+
+		i.mu.Lock()
+		if l.refs--; l.refs == 0 {
+			delete(i.locks, key)
+		}
+		i.mu.Unlock()
+	}
+}
+
+func (i *idempotencyImpl) get(c echo.Context, key string) (record, bool, error) {
+	rec := record{}
+
+	data, found, err := i.opts.Cache.Get(c.Request().Context(), key)
+	if err != nil || !found {
+		return rec, false, err
+	}
+
+	if err := json.Unmarshal(data, &rec); err != nil {
+		return rec, false, err
+	}
+
+	return rec, true, nil
+}
+
+func (i *idempotencyImpl) set(c echo.Context, key string, rec record) error {
+	data, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+
+	return i.opts.Cache.Set(c.Request().Context(), key, data, i.opts.TTL)
+}
+
+// reservar marca a chave como em processamento, apenas se ela ainda não existir
+func (i *idempotencyImpl) reservar(c echo.Context, key, fingerprint string) (bool, error) {
+	data, err := json.Marshal(record{Fingerprint: fingerprint})
+	if err != nil {
+		return false, err
+	}
+
+	return i.opts.Cache.SetNX(c.Request().Context(), key, data, i.opts.Processamento)
+}
+
+func (i *idempotencyImpl) delete(c echo.Context, key string) {
+	if err := i.opts.Cache.Delete(c.Request().Context(), key); err != nil {
+		logger.FromContext(c.Request().Context()).WithError(err).Error("idempotency.delete")
+	}
+}
+
+// fingerprint identifica a requisição, normalizando o json para que diferenças
+// de formatação não sejam tratadas como outro corpo
+func fingerprint(method, path string, body []byte) string {
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err == nil {
+		if normalized, err := json.Marshal(v); err == nil {
+			body = normalized
+		}
+	}
+
+	h := sha256.New()
+	io.WriteString(h, method+" "+path+"\n")
+	h.Write(body)
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// captureWriter copia o corpo da resposta enquanto ele é escrito para o cliente
+type captureWriter struct {
+	http.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *captureWriter) Write(b []byte) (int, error) {
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
@@ -0,0 +1,144 @@
+package idempotency
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/GianGoulart/CrudProdutos/store/cache"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Middleware(t *testing.T) {
+	e := echo.New()
+
+	calls := 0
+	h := Middleware(Options{Cache: cache.NewLRU(10), TTL: time.Minute})(func(c echo.Context) error {
+		calls++
+		if strings.Contains(c.Request().Header.Get("X-Falha"), "1") {
+			return c.JSON(http.StatusInternalServerError, map[string]int{"call": calls})
+		}
+		return c.JSON(http.StatusOK, map[string]int{"call": calls})
+	})
+
+	// os casos são sequenciais, cada um depende das chaves guardadas pelos anteriores
+	cases := []struct {
+		Name             string
+		Key              string
+		Body             string
+		Falha            bool
+		RemoteAddr       string
+		ExpectedCode     int
+		ExpectedBody     string
+		ExpectedReplayed bool
+	}{
+		{Name: "deve executar a primeira requisição", Key: "a", Body: `{"nome":"TV"}`, ExpectedCode: http.StatusOK, ExpectedBody: `{"call":1}`},
+		{Name: "deve reproduzir a resposta na repetição", Key: "a", Body: `{ "nome": "TV" }`, ExpectedCode: http.StatusOK, ExpectedBody: `{"call":1}`, ExpectedReplayed: true},
+		{Name: "deve reproduzir a repetição vinda de outro ip", Key: "a", Body: `{"nome":"TV"}`, RemoteAddr: "198.51.100.7:4321", ExpectedCode: http.StatusOK, ExpectedBody: `{"call":1}`, ExpectedReplayed: true},
+		{Name: "deve rejeitar a chave com outro corpo", Key: "a", Body: `{"nome":"Geladeira"}`, ExpectedCode: http.StatusUnprocessableEntity},
+		{Name: "deve executar sem a chave", Body: `{"nome":"TV"}`, ExpectedCode: http.StatusOK, ExpectedBody: `{"call":2}`},
+		{Name: "não deve guardar erros do servidor", Key: "b", Body: `{}`, Falha: true, ExpectedCode: http.StatusInternalServerError, ExpectedBody: `{"call":3}`},
+		{Name: "deve executar novamente após erro do servidor", Key: "b", Body: `{}`, ExpectedCode: http.StatusOK, ExpectedBody: `{"call":4}`},
+	}
+
+	for _, cs := range cases {
+		t.Run(cs.Name, func(t *testing.T) {
+			request := httptest.NewRequest(http.MethodPost, "/produtos", strings.NewReader(cs.Body))
+			request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			if cs.Key != "" {
+				request.Header.Set(HeaderIdempotencyKey, cs.Key)
+			}
+			if cs.Falha {
+				request.Header.Set("X-Falha", "1")
+			}
+			if cs.RemoteAddr != "" {
+				request.RemoteAddr = cs.RemoteAddr
+			}
+			rr := httptest.NewRecorder()
+
+			assert.NoError(t, h(e.NewContext(request, rr)))
+			assert.Equal(t, cs.ExpectedCode, rr.Code)
+			if cs.ExpectedBody != "" {
+				assert.JSONEq(t, cs.ExpectedBody, rr.Body.String())
+			}
+			assert.Equal(t, cs.ExpectedReplayed, rr.Header().Get(HeaderReplayed) == "true")
+		})
+	}
+}
+
+// ttlCache registra o ttl de cada gravação
+type ttlCache struct {
+	cache.Cache
+	ttls map[string]time.Duration
+}
+
+func (c *ttlCache) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	c.ttls["Set"] = ttl
+	return c.Cache.Set(ctx, key, value, ttl)
+}
+
+func (c *ttlCache) SetNX(ctx context.Context, key string, value []byte, ttl time.Duration) (bool, error) {
+	c.ttls["SetNX"] = ttl
+	return c.Cache.SetNX(ctx, key, value, ttl)
+}
+
+func Test_MiddlewareReserva(t *testing.T) {
+	e := echo.New()
+
+	request := func(h echo.HandlerFunc) *httptest.ResponseRecorder {
+		request := httptest.NewRequest(http.MethodPost, "/produtos", strings.NewReader(`{"nome":"TV"}`))
+		request.Header.Set(HeaderIdempotencyKey, "a")
+		rr := httptest.NewRecorder()
+
+		assert.NoError(t, h(e.NewContext(request, rr)))
+		return rr
+	}
+
+	ok := func(c echo.Context) error { return c.NoContent(http.StatusCreated) }
+
+	t.Run("deve reservar a chave apenas pelo tempo de processamento", func(t *testing.T) {
+		c := &ttlCache{Cache: cache.NewLRU(10), ttls: map[string]time.Duration{}}
+
+		rr := request(Middleware(Options{Cache: c, TTL: time.Hour, Processamento: time.Second})(ok))
+		assert.Equal(t, http.StatusCreated, rr.Code)
+		assert.Equal(t, map[string]time.Duration{"SetNX": time.Second, "Set": time.Hour}, c.ttls)
+	})
+
+	t.Run("deve rejeitar a chave reservada por outra instancia", func(t *testing.T) {
+		c := cache.NewLRU(10)
+		iniciou, liberar, terminou := make(chan struct{}), make(chan struct{}), make(chan struct{})
+
+		go func() {
+			defer close(terminou)
+			request(Middleware(Options{Cache: c, TTL: time.Hour})(func(c echo.Context) error {
+				close(iniciou)
+				<-liberar
+				return c.NoContent(http.StatusCreated)
+			}))
+		}()
+		<-iniciou
+
+		rr := request(Middleware(Options{Cache: c, TTL: time.Hour})(ok))
+		assert.Equal(t, http.StatusConflict, rr.Code)
+
+		close(liberar)
+		<-terminou
+	})
+
+	t.Run("deve liberar a chave após um panic", func(t *testing.T) {
+		c := cache.NewLRU(10)
+
+		assert.Panics(t, func() {
+			request(Middleware(Options{Cache: c, TTL: time.Hour})(func(c echo.Context) error {
+				panic("falha")
+			}))
+		})
+
+		rr := request(Middleware(Options{Cache: c, TTL: time.Hour})(ok))
+		assert.Equal(t, http.StatusCreated, rr.Code)
+	})
+}
@@ -0,0 +1,80 @@
+package logger
+
+import (
+	"context"
+	"os"
+
+	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus"
+)
+
+type ctxKey struct{}
+
+// Options struct de opções para a configuração do logger
+type Options struct {
+	Level  string
+	Format string
+}
+
+// Setup configura o logger padrão com saída em json e o nível informado
+func Setup(opts Options) {
+	logrus.SetOutput(os.Stdout)
+
+	if opts.Format == "text" {
+		logrus.SetFormatter(&logrus.TextFormatter{FullTimestamp: true})
+	} else {
+		logrus.SetFormatter(&logrus.JSONFormatter{})
+	}
+
+	level, err := logrus.ParseLevel(opts.Level)
+	if err != nil {
+		level = logrus.InfoLevel
+	}
+	logrus.SetLevel(level)
+}
+
+// WithContext retorna um novo context carregando o logger informado
+func WithContext(ctx context.Context, entry *logrus.Entry) context.Context {
+	return context.WithValue(ctx, ctxKey{}, entry)
+}
+
+// FromContext retorna o logger do request, ou o logger padrão caso o context não tenha um
+func FromContext(ctx context.Context) *logrus.Entry {
+	if ctx != nil {
+		if entry, ok := ctx.Value(ctxKey{}).(*logrus.Entry); ok {
+			return entry
+		}
+	}
+
+	return logrus.NewEntry(logrus.StandardLogger())
+}
+
+// Middleware cria o logger do request com o request id, a rota e o codigo do produto
+// e o guarda no context do request. Deve ser registrado depois do middleware.RequestID
+func Middleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+
+			requestID := req.Header.Get(echo.HeaderXRequestID)
+			if requestID == "" {
+				requestID = c.Response().Header().Get(echo.HeaderXRequestID)
+			}
+
+			fields := logrus.Fields{
+				"request_id": requestID,
+				"method":     req.Method,
+				"route":      c.Path(),
+			}
+
+			if codigo := c.Param("codigo"); codigo != "" {
+				fields["codigo"] = codigo
+			}
+
+			entry := logrus.WithFields(fields)
+			c.SetRequest(req.WithContext(WithContext(req.Context(), entry)))
+
+			return next(c)
+		}
+	}
+}
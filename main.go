@@ -1,19 +1,25 @@
 package main
 
 import (
-	"fmt"
+	"context"
+	"net"
 	"net/http"
+	"os"
 	"time"
 
 	"github.com/GianGoulart/CrudProdutos/api"
+	"github.com/GianGoulart/CrudProdutos/api/graphql"
 	"github.com/GianGoulart/CrudProdutos/app"
+	"github.com/GianGoulart/CrudProdutos/idempotency"
+	"github.com/GianGoulart/CrudProdutos/logger"
 	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/GianGoulart/CrudProdutos/ratelimit"
+	"github.com/GianGoulart/CrudProdutos/rpc"
 	"github.com/GianGoulart/CrudProdutos/store"
 	"github.com/labstack/echo/v4"
 	middleware "github.com/labstack/echo/v4/middleware"
 	"github.com/sirupsen/logrus"
-	"gorm.io/driver/mysql"
-	"gorm.io/gorm"
+	"google.golang.org/grpc"
 )
 
 // main configure swagger
@@ -23,50 +29,131 @@ import (
 // @in header
 // @name Authorization
 func main() {
+	// subcomandos `config check` e `indice rebuild`
+	if runCommand(os.Args[1:]) {
+		return
+	}
+
 	startedAt := time.Now()
 	time.Sleep(8 * time.Second)
 
 	model.Watch(func(c model.Config, quit chan bool) {
+		settings := c.Settings()
+
+		logger.Setup(logger.Options{
+			Level:  settings.Log.Level,
+			Format: settings.Log.Format,
+		})
+
 		e := echo.New()
 		e.Validator = model.New()
-		e.Debug = c.GetString("crudProdutos") != "prod"
+		e.Debug = !settings.IsProduction()
 		e.HideBanner = true
 
+		ipExtractor, err := ratelimit.IPExtractor(settings.Server.ProxiesConfiaveis)
+		if err != nil {
+			panic(err)
+		}
+		e.IPExtractor = ipExtractor
+
 		e.Use(middleware.CORSWithConfig(middleware.CORSConfig{
-			AllowOrigins: []string{"https://labstack.com", "https://labstack.net"},
-			AllowHeaders: []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept},
+			AllowOrigins:  []string{"https://labstack.com", "https://labstack.net"},
+			AllowHeaders:  []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept, idempotency.HeaderIdempotencyKey},
+			ExposeHeaders: []string{"Retry-After", "X-RateLimit-Limit", "X-RateLimit-Remaining", idempotency.HeaderReplayed},
 		}))
 
 		e.Use(middleware.Logger())
 		e.Use(middleware.BodyLimit("2M"))
 		e.Use(middleware.Recover())
 		e.Use(middleware.RequestID())
+		e.Use(logger.Middleware())
+
+		var limiter *ratelimit.Limiter
+		if settings.RateLimit.Enabled {
+			limiter = ratelimit.New(ratelimit.Options{
+				Read:        ratelimit.Limit{Rate: settings.RateLimit.Read.Rate, Burst: settings.RateLimit.Read.Burst},
+				Write:       ratelimit.Limit{Rate: settings.RateLimit.Write.Rate, Burst: settings.RateLimit.Write.Burst},
+				MaxClientes: settings.RateLimit.MaxClientes,
+			})
+			e.Use(limiter.Middleware())
+		}
 
-		fmt.Println(c.GetString("database.writer.url"))
-		dbWriter, err := gorm.Open(mysql.Open(c.GetString("database.writer.url")), &gorm.Config{})
+		stores, closeStores, err := newStores(settings)
 		if err != nil {
 			panic(err)
 		}
 
-		// criação dos stores com a injeção do banco de escrita e leitura
-		stores := store.New(store.Options{
-			DB: dbWriter,
-		})
-
 		// criação dos serviços
 		apps := app.New(app.Options{
 			Stores:    stores,
-			Version:   c.GetString("version"),
+			Version:   settings.Version,
 			StartedAt: startedAt,
 		})
 
+		// o índice em memória, ou ainda vazio, é populado a partir do banco
+		if stores.Indice != nil {
+			if count, err := stores.Indice.Count(); err == nil && (count == 0 || settings.Indice.Path == "") {
+				total, err := apps.Produto.RebuildIndice(context.Background())
+				if err != nil {
+					logrus.WithError(err).Error("não consegui popular o índice de sugestões")
+				} else {
+					logrus.WithField("total", total).Info("índice de sugestões populado")
+				}
+			}
+		}
+
+		if stores.Entregador != nil {
+			stores.Entregador.Start()
+		}
+
+		if stores.Relay != nil {
+			stores.Relay.Start()
+		}
+
+		var idempotent echo.MiddlewareFunc
+		if settings.Idempotency.Enabled {
+			idempotencyCache, err := store.NewCache(settings.Cache.Backend, settings.Idempotency.Size, settings.Cache.Redis.URL)
+			if err != nil {
+				panic(err)
+			}
+
+			idempotent = idempotency.Middleware(idempotency.Options{
+				Cache:         idempotencyCache,
+				TTL:           settings.Idempotency.TTL,
+				Processamento: settings.Idempotency.Processamento,
+			})
+		}
+
+		var limitesGraphQL *graphql.Limites
+		if settings.GraphQL.Enabled {
+			limitesGraphQL = &graphql.Limites{
+				Profundidade: settings.GraphQL.MaxProfundidade,
+				Complexidade: settings.GraphQL.MaxComplexidade,
+			}
+		}
+
 		// registros dos handlers
 		api.Register(api.Options{
-			Group: e.Group(""),
-			Apps:  apps,
+			Group:       e.Group(""),
+			Apps:        apps,
+			Idempotency: idempotent,
+			GraphQL:     limitesGraphQL,
+			RateLimit:   limiter,
 		})
 
-		port := c.GetString("server.port")
+		// o server gRPC compartilha os mesmos serviços da api http
+		var grpcServer *grpc.Server
+		if settings.GRPC.Enabled {
+			lis, err := net.Listen("tcp", settings.GRPC.Port)
+			if err != nil {
+				panic(err)
+			}
+
+			grpcServer = rpc.New(rpc.Options{Apps: apps})
+			go grpcServer.Serve(lis)
+		}
+
+		port := settings.Server.Port
 		// if e.Debug {
 		// 	swagger.Register(swagger.Options{
 		// 		Port:      port,
@@ -82,15 +169,25 @@ func main() {
 			}
 
 			if err := c.JSON(http.StatusInternalServerError, model.Response{Err: err.Error()}); err != nil {
-				logrus.Error(c.Request().Context(), err)
+				logger.FromContext(c.Request().Context()).WithError(err).Error("api.HTTPErrorHandler")
 			}
 		}
 
-		// função para fechar as conexões
+		// função para fechar as conexões, avisando no quit quando terminar
 		go func() {
 			<-quit
 
-			e.Close()
+			if err := e.Close(); err != nil {
+				logrus.WithError(err).Error("erro ao encerrar o server")
+			}
+
+			if grpcServer != nil {
+				grpcServer.GracefulStop()
+			}
+
+			closeStores()
+
+			quit <- true
 		}()
 
 		go e.Start(port)
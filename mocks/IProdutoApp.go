@@ -6,6 +6,8 @@ import (
 	context "context"
 
 	model "github.com/GianGoulart/CrudProdutos/model"
+	cache "github.com/GianGoulart/CrudProdutos/store/cache"
+	stream "github.com/GianGoulart/CrudProdutos/stream"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -14,6 +16,82 @@ type IProdutoApp struct {
 	mock.Mock
 }
 
+// AplicarReajuste provides a mock function with given fields: ctx, reajuste
+func (_m *IProdutoApp) AplicarReajuste(ctx context.Context, reajuste model.Reajuste) (*model.PreviaReajuste, error) {
+	ret := _m.Called(ctx, reajuste)
+
+	var r0 *model.PreviaReajuste
+	if rf, ok := ret.Get(0).(func(context.Context, model.Reajuste) *model.PreviaReajuste); ok {
+		r0 = rf(ctx, reajuste)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PreviaReajuste)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, model.Reajuste) error); ok {
+		r1 = rf(ctx, reajuste)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AssinarEventos provides a mock function with given fields: ctx, filtro, ultimoID
+func (_m *IProdutoApp) AssinarEventos(ctx context.Context, filtro stream.Filtro, ultimoID string) (*stream.Assinatura, bool, error) {
+	ret := _m.Called(ctx, filtro, ultimoID)
+
+	var r0 *stream.Assinatura
+	if rf, ok := ret.Get(0).(func(context.Context, stream.Filtro, string) *stream.Assinatura); ok {
+		r0 = rf(ctx, filtro, ultimoID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*stream.Assinatura)
+		}
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func(context.Context, stream.Filtro, string) bool); ok {
+		r1 = rf(ctx, filtro, ultimoID)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, stream.Filtro, string) error); ok {
+		r2 = rf(ctx, filtro, ultimoID)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// BuscarProdutos provides a mock function with given fields: ctx, q, limite
+func (_m *IProdutoApp) BuscarProdutos(ctx context.Context, q string, limite int) (*[]model.ResultadoBusca, error) {
+	ret := _m.Called(ctx, q, limite)
+
+	var r0 *[]model.ResultadoBusca
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) *[]model.ResultadoBusca); ok {
+		r0 = rf(ctx, q, limite)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]model.ResultadoBusca)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = rf(ctx, q, limite)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateProduto provides a mock function with given fields: ctx, _a1
 func (_m *IProdutoApp) CreateProduto(ctx context.Context, _a1 *model.Produto) (*model.Produto, error) {
 	ret := _m.Called(ctx, _a1)
@@ -60,6 +138,52 @@ func (_m *IProdutoApp) DeleteProduto(ctx context.Context, codigo string) (*model
 	return r0, r1
 }
 
+// FiltrarProdutos provides a mock function with given fields: ctx, filtro
+func (_m *IProdutoApp) FiltrarProdutos(ctx context.Context, filtro model.FiltroProduto) (*[]model.Produto, error) {
+	ret := _m.Called(ctx, filtro)
+
+	var r0 *[]model.Produto
+	if rf, ok := ret.Get(0).(func(context.Context, model.FiltroProduto) *[]model.Produto); ok {
+		r0 = rf(ctx, filtro)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]model.Produto)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, model.FiltroProduto) error); ok {
+		r1 = rf(ctx, filtro)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCacheStats provides a mock function with given fields: ctx
+func (_m *IProdutoApp) GetCacheStats(ctx context.Context) (*cache.Stats, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *cache.Stats
+	if rf, ok := ret.Get(0).(func(context.Context) *cache.Stats); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cache.Stats)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetProdutoByCodigo provides a mock function with given fields: ctx, codigo
 func (_m *IProdutoApp) GetProdutoByCodigo(ctx context.Context, codigo string) (*model.Produto, error) {
 	ret := _m.Called(ctx, codigo)
@@ -129,6 +253,96 @@ func (_m *IProdutoApp) GetProdutos(ctx context.Context) (*[]model.Produto, error
 	return r0, r1
 }
 
+// GetSugestoes provides a mock function with given fields: ctx, q, limite
+func (_m *IProdutoApp) GetSugestoes(ctx context.Context, q string, limite int) (*[]model.Sugestao, error) {
+	ret := _m.Called(ctx, q, limite)
+
+	var r0 *[]model.Sugestao
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) *[]model.Sugestao); ok {
+		r0 = rf(ctx, q, limite)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]model.Sugestao)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = rf(ctx, q, limite)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PatchProduto provides a mock function with given fields: ctx, codigo, patch
+func (_m *IProdutoApp) PatchProduto(ctx context.Context, codigo string, patch []byte) (*model.Produto, error) {
+	ret := _m.Called(ctx, codigo, patch)
+
+	var r0 *model.Produto
+	if rf, ok := ret.Get(0).(func(context.Context, string, []byte) *model.Produto); ok {
+		r0 = rf(ctx, codigo, patch)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Produto)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, []byte) error); ok {
+		r1 = rf(ctx, codigo, patch)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PreverReajuste provides a mock function with given fields: ctx, reajuste
+func (_m *IProdutoApp) PreverReajuste(ctx context.Context, reajuste model.Reajuste) (*model.PreviaReajuste, error) {
+	ret := _m.Called(ctx, reajuste)
+
+	var r0 *model.PreviaReajuste
+	if rf, ok := ret.Get(0).(func(context.Context, model.Reajuste) *model.PreviaReajuste); ok {
+		r0 = rf(ctx, reajuste)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PreviaReajuste)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, model.Reajuste) error); ok {
+		r1 = rf(ctx, reajuste)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RebuildIndice provides a mock function with given fields: ctx
+func (_m *IProdutoApp) RebuildIndice(ctx context.Context) (int, error) {
+	ret := _m.Called(ctx)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateProduto provides a mock function with given fields: ctx, _a1
 func (_m *IProdutoApp) UpdateProduto(ctx context.Context, _a1 *model.Produto) (*model.Produto, error) {
 	ret := _m.Called(ctx, _a1)
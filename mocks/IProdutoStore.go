@@ -120,6 +120,98 @@ func (_m *IProdutoStore) FindProdutos(ctx context.Context) (*[]model.Produto, er
 	return r0, r1
 }
 
+// FindProdutosByCodigos provides a mock function with given fields: ctx, codigos
+func (_m *IProdutoStore) FindProdutosByCodigos(ctx context.Context, codigos []string) (*[]model.Produto, error) {
+	ret := _m.Called(ctx, codigos)
+
+	var r0 *[]model.Produto
+	if rf, ok := ret.Get(0).(func(context.Context, []string) *[]model.Produto); ok {
+		r0 = rf(ctx, codigos)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]model.Produto)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, codigos)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindProdutosByMarca provides a mock function with given fields: ctx, marca
+func (_m *IProdutoStore) FindProdutosByMarca(ctx context.Context, marca string) (*[]model.Produto, error) {
+	ret := _m.Called(ctx, marca)
+
+	var r0 *[]model.Produto
+	if rf, ok := ret.Get(0).(func(context.Context, string) *[]model.Produto); ok {
+		r0 = rf(ctx, marca)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]model.Produto)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, marca)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReajustarPrecos provides a mock function with given fields: ctx, itens
+func (_m *IProdutoStore) ReajustarPrecos(ctx context.Context, itens []model.ItemReajuste) (*[]model.Produto, error) {
+	ret := _m.Called(ctx, itens)
+
+	var r0 *[]model.Produto
+	if rf, ok := ret.Get(0).(func(context.Context, []model.ItemReajuste) *[]model.Produto); ok {
+		r0 = rf(ctx, itens)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]model.Produto)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []model.ItemReajuste) error); ok {
+		r1 = rf(ctx, itens)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchProdutos provides a mock function with given fields: ctx, termos
+func (_m *IProdutoStore) SearchProdutos(ctx context.Context, termos []string) (*[]model.Produto, error) {
+	ret := _m.Called(ctx, termos)
+
+	var r0 *[]model.Produto
+	if rf, ok := ret.Get(0).(func(context.Context, []string) *[]model.Produto); ok {
+		r0 = rf(ctx, termos)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]model.Produto)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, termos)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateProduto provides a mock function with given fields: ctx, _a1
 func (_m *IProdutoStore) UpdateProduto(ctx context.Context, _a1 *model.Produto) (*model.Produto, error) {
 	ret := _m.Called(ctx, _a1)
@@ -0,0 +1,54 @@
+package model
+
+// Níveis de alerta de estoque
+const (
+	// AlertaEstoqueBaixo estoque disponível no limite de alerta ou abaixo dele
+	AlertaEstoqueBaixo = "baixo"
+	// AlertaEstoqueEsgotado estoque disponível zerado, o estoque de corte foi atingido
+	AlertaEstoqueEsgotado = "esgotado"
+)
+
+// Alerta aviso de estoque baixo de um produto, mantido até ser reconhecido
+type Alerta struct {
+	Codigo            string `json:"codigo,omitempty" gorm:"primary_key"`
+	ProdutoCodigo     string `json:"produto" gorm:"size:64;index;not null"`
+	Nome              string `json:"nome,omitempty" gorm:"size:255"`
+	Nivel             string `json:"nivel" gorm:"size:16;not null"`
+	EstoqueDisponivel int64  `json:"estoque_disponivel" gorm:"not null"`
+	Limite            int64  `json:"limite" gorm:"not null"`
+	Reconhecido       bool   `json:"reconhecido" gorm:"not null;index"`
+	ReconhecidoEm     string `json:"reconhecido_em,omitempty"`
+	CriadoEm          string `json:"criado_em,omitempty" gorm:"not null"`
+}
+
+// FiltroAlerta filtros da listagem de alertas, campos vazios não filtram
+type FiltroAlerta struct {
+	Produto     string
+	Reconhecido *bool
+}
+
+func (Alerta) TableName() string {
+	return "alertas"
+}
+
+// NovoAlerta avalia o estoque disponível do produto contra o limite e retorna o
+// alerta correspondente, ou nil quando o estoque está acima do limite
+func NovoAlerta(produto *Produto, limite int64) *Alerta {
+	if produto.EstoqueDisponivel > limite {
+		return nil
+	}
+
+	nivel := AlertaEstoqueBaixo
+	if produto.EstoqueDisponivel <= 0 {
+		nivel = AlertaEstoqueEsgotado
+	}
+
+	return &Alerta{
+		Codigo:            NewId(),
+		ProdutoCodigo:     produto.Codigo,
+		Nome:              produto.Nome,
+		Nivel:             nivel,
+		EstoqueDisponivel: produto.EstoqueDisponivel,
+		Limite:            limite,
+	}
+}
@@ -0,0 +1,61 @@
+package model
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// ResultadoBusca produto encontrado na busca com a sua relevancia e o nome com
+// os termos encontrados destacados
+type ResultadoBusca struct {
+	Produto
+	Relevancia float64 `json:"relevancia"`
+	Destaque   string  `json:"destaque"`
+}
+
+// FoldRune normaliza uma letra para comparação, removendo acentos e caixa (Ã -> a)
+func FoldRune(r rune) string {
+	var b strings.Builder
+	for _, d := range norm.NFD.String(string(r)) {
+		if unicode.Is(unicode.Mn, d) {
+			continue
+		}
+		b.WriteRune(unicode.ToLower(d))
+	}
+
+	return b.String()
+}
+
+// Normalize normaliza um texto para comparação sem acentos e sem caixa ("Televisão" -> "televisao")
+func Normalize(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		b.WriteString(FoldRune(r))
+	}
+
+	return b.String()
+}
+
+// Termos normaliza e separa o texto da busca em termos únicos
+func Termos(q string) []string {
+	seen := map[string]bool{}
+	termos := []string{}
+
+	for _, t := range strings.Fields(Normalize(q)) {
+		if !seen[t] {
+			seen[t] = true
+			termos = append(termos, t)
+		}
+	}
+
+	return termos
+}
+
+// Sugestao produto sugerido pelo autocomplete
+type Sugestao struct {
+	Codigo string  `json:"codigo"`
+	Nome   string  `json:"nome"`
+	Score  float64 `json:"score"`
+}
@@ -0,0 +1,116 @@
+package model
+
+import (
+	"errors"
+	"sort"
+	"strings"
+)
+
+// Categoria nó da árvore de classificação dos produtos. Pai vazio indica uma
+// categoria raiz
+type Categoria struct {
+	Codigo          string      `json:"codigo,omitempty" gorm:"primary_key"`
+	Nome            string      `json:"nome,omitempty" gorm:"size:255;not null"`
+	Pai             string      `json:"pai,omitempty" gorm:"size:64;index"`
+	CriadoEm        string      `json:"criado_em,omitempty" gorm:"not null"`
+	UltimaAlteracao string      `json:"ultima_alteracao,omitempty" gorm:"not null"`
+	Filhas          []Categoria `json:"filhas,omitempty" gorm:"-"`
+}
+
+// ProdutoCategoria associação de um produto a uma categoria
+type ProdutoCategoria struct {
+	ProdutoCodigo   string `json:"produto" gorm:"primary_key;size:64"`
+	CategoriaCodigo string `json:"categoria" gorm:"primary_key;size:64;index"`
+}
+
+func (Categoria) TableName() string {
+	return "categorias"
+}
+
+func (ProdutoCategoria) TableName() string {
+	return "produto_categorias"
+}
+
+func (me *Categoria) PreSave() {
+	me.Codigo = NewId()
+}
+
+func (me *Categoria) Validate() error {
+	me.Nome = strings.TrimSpace(me.Nome)
+	if me.Nome == "" {
+		return errors.New("nome da categoria é obrigatório")
+	}
+
+	if me.Pai != "" && me.Pai == me.Codigo {
+		return errors.New("categoria não pode ser pai dela mesma")
+	}
+
+	return nil
+}
+
+// Descendentes retorna o codigo informado seguido dos codigos de todas as
+// categorias abaixo dele na árvore
+func Descendentes(categorias []Categoria, codigo string) []string {
+	filhas := map[string][]string{}
+	for _, c := range categorias {
+		filhas[c.Pai] = append(filhas[c.Pai], c.Codigo)
+	}
+
+	codigos := []string{}
+	visitados := map[string]bool{}
+	pendentes := []string{codigo}
+	for len(pendentes) > 0 {
+		atual := pendentes[0]
+		pendentes = pendentes[1:]
+
+		if visitados[atual] {
+			continue
+		}
+		visitados[atual] = true
+
+		codigos = append(codigos, atual)
+		pendentes = append(pendentes, filhas[atual]...)
+	}
+
+	return codigos
+}
+
+// Arvore monta a árvore de categorias a partir da lista, com as filhas ordenadas
+// por nome. Categorias com pai inexistente são tratadas como raiz
+func Arvore(categorias []Categoria) []Categoria {
+	existe := map[string]bool{}
+	for _, c := range categorias {
+		existe[c.Codigo] = true
+	}
+
+	filhas := map[string][]Categoria{}
+	for _, c := range categorias {
+		pai := c.Pai
+		if !existe[pai] {
+			pai = ""
+		}
+		filhas[pai] = append(filhas[pai], c)
+	}
+
+	var montar func(pai string, visitados map[string]bool) []Categoria
+	montar = func(pai string, visitados map[string]bool) []Categoria {
+		nos := []Categoria{}
+		for _, c := range filhas[pai] {
+			if visitados[c.Codigo] {
+				continue
+			}
+			visitados[c.Codigo] = true
+
+			c.Filhas = montar(c.Codigo, visitados)
+			nos = append(nos, c)
+		}
+
+		sort.Slice(nos, func(i, j int) bool {
+			return nos[i].Nome < nos[j].Nome
+		})
+
+		return nos
+	}
+
+	return montar("", map[string]bool{})
+}
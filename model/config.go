@@ -3,9 +3,14 @@ package model
 import (
 	"bytes"
 	"encoding/base32"
+	"fmt"
+	"path/filepath"
+	"reflect"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/fsnotify/fsnotify"
 	"github.com/go-playground/validator/v10"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/viper"
@@ -20,58 +25,119 @@ type Config interface {
 	GetFloat64(key string) float64
 	GetDuration(key string) time.Duration
 	GetStringSlice(key string) []string
+	Settings() Settings
 	Close()
 }
 
 type configImpl struct {
+	mu sync.RWMutex
+
 	vmain     *viper.Viper
 	vreplacer *viper.Viper
+	settings  *Settings
+
+	secretsDir string
 
 	kill chan bool
 }
 
 func (c *configImpl) GetBool(key string) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
 	return c.vmain.GetBool(key)
 }
 
 // GetString realiza a troca das variaveis em tempo de execução e retorna uma string
 func (c *configImpl) GetString(key string) string {
-	value := c.vmain.GetString(key)
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.replace(c.vmain.GetString(key))
+}
+
+// replace troca as variaveis $chave$ do valor, buscando primeiro no config remoto
+// de replace, depois nas variaveis de ambiente e por fim nos arquivos de secrets
+func (c *configImpl) replace(value string) string {
+	return placeholder.ReplaceAllStringFunc(value, func(match string) string {
+		if resolved, ok := c.lookup(match[1 : len(match)-1]); ok {
+			return resolved
+		}
+
+		return match
+	})
+}
 
-	for k, v := range c.vreplacer.AllSettings() {
-		old := "$" + k + "$"
-		new, ok := v.(string)
-		if !ok {
-			continue
+func (c *configImpl) lookup(key string) (string, bool) {
+	if c.vreplacer.IsSet(key) {
+		if value, ok := c.vreplacer.Get(key).(string); ok {
+			return value, true
 		}
+	}
 
-		value = strings.ReplaceAll(value, old, new)
+	if value, ok := lookupEnv(key); ok {
+		return value, true
 	}
 
-	return value
+	return lookupSecretFile(c.secretsDir, key)
 }
 
 func (c *configImpl) GetDuration(key string) time.Duration {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
 	return c.vmain.GetDuration(key)
 }
 
 func (c *configImpl) GetInt(key string) int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
 	return c.vmain.GetInt(key)
 }
 
 func (c *configImpl) GetFloat64(key string) float64 {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
 	return c.vmain.GetFloat64(key)
 }
 
 func (c *configImpl) GetStringSlice(key string) []string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
 	return c.vmain.GetStringSlice(key)
 }
 
+// Settings retorna as configurações tipadas e validadas
+func (c *configImpl) Settings() Settings {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return *c.settings
+}
+
 // Close encerra a função de watch
 func (c *configImpl) Close() {
 	c.kill <- true
 }
 
+// swap troca as configurações em uso pelas recém carregadas
+func (c *configImpl) swap(next *configImpl) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.vmain, c.vreplacer, c.settings = next.vmain, next.vreplacer, next.settings
+	c.secretsDir = next.secretsDir
+}
+
+const (
+	localConfigFile       = "./config.json"
+	defaultRemoteInterval = 30 * time.Second
+	localReloadDebounce   = 500 * time.Millisecond
+)
+
 // readRemoteConfig adiciona o provider e le a configuração remota
 func readRemoteConfig(v *viper.Viper, provider, endpoint, path, token string) error {
 	v.SetConfigType("json")
@@ -82,60 +148,223 @@ func readRemoteConfig(v *viper.Viper, provider, endpoint, path, token string) er
 	return nil
 }
 
-// Watch fica escutando modificações no config remoto
-func Watch(fn func(c Config, quit chan bool)) {
-	quit, kill := make(chan bool), make(chan bool)
-	vmain, vreplacer := viper.New(), viper.New()
-
-	c := &configImpl{
-		vmain:     vmain,
-		vreplacer: vreplacer,
-		kill:      kill,
-	}
+// newViper cria uma instancia do viper com o bind das variaveis de ambiente
+func newViper() *viper.Viper {
+	v := viper.New()
 
 	//Substitui o _ por .
-	vmain.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
+	v.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
 	// realiza o bind das variaveis de ambiente
-	vmain.AutomaticEnv()
-
-	// setando por default os ambientes com config remoto
-	tcenv := vmain.GetString("tc")
-	if tcenv == "prod" || tcenv == "preprod" || tcenv == "hml" {
-		provider := vmain.GetString("remote.provider")
-		endpoint := vmain.GetString("remote.endpoint")
-		token := vmain.GetString("remote.token")
-		path := vmain.GetString("remote.path")
-
-		if err := readRemoteConfig(vmain, provider, endpoint, path, token); err != nil {
-			logrus.Fatal("não consegui ler o arquivo de configuração remoto. com o path", path, err.Error())
-		}
+	v.AutomaticEnv()
 
-		replaces := vmain.GetStringSlice("remote.replace")
-		for _, rpath := range replaces {
-			vpath := viper.New()
+	return v
+}
 
-			if err := readRemoteConfig(vpath, provider, endpoint, rpath, token); err != nil {
-				logrus.Fatal("não consegui ler o arquivo de configuração remoto. com o path", rpath, err.Error())
-			}
+// isRemote indica se o ambiente utiliza o config remoto
+func isRemote(v *viper.Viper) bool {
+	tcenv := v.GetString("tc")
+	return tcenv == "prod" || tcenv == "preprod" || tcenv == "hml"
+}
 
-			if err := vreplacer.MergeConfigMap(vpath.AllSettings()); err != nil {
-				logrus.Fatal("não consegui realizar o merge do arquivo de configuração remoto. com o path", rpath, err.Error())
-			}
-		}
-	} else {
+// load realiza a leitura das configurações, remota ou local de acordo com o ambiente
+func load() (*viper.Viper, *viper.Viper, error) {
+	vmain, vreplacer := newViper(), viper.New()
+
+	if !isRemote(vmain) {
 		// seta o arquivo de configuração local
-		vmain.SetConfigFile("./config.json")
+		vmain.SetConfigFile(localConfigFile)
 
 		// realiza a leitura das configurações locais
 		if err := vmain.ReadInConfig(); err != nil {
-			logrus.Fatal("não consegui ler o arquivo de configuração local")
+			return nil, nil, fmt.Errorf("não consegui ler o arquivo de configuração local: %w", err)
 		}
+
+		return vmain, vreplacer, nil
+	}
+
+	provider := vmain.GetString("remote.provider")
+	endpoint := vmain.GetString("remote.endpoint")
+	token := vmain.GetString("remote.token")
+	path := vmain.GetString("remote.path")
+
+	if err := readRemoteConfig(vmain, provider, endpoint, path, token); err != nil {
+		return nil, nil, fmt.Errorf("não consegui ler o arquivo de configuração remoto. com o path %s: %w", path, err)
+	}
+
+	replaces := vmain.GetStringSlice("remote.replace")
+	for _, rpath := range replaces {
+		vpath := viper.New()
+
+		if err := readRemoteConfig(vpath, provider, endpoint, rpath, token); err != nil {
+			return nil, nil, fmt.Errorf("não consegui ler o arquivo de configuração remoto. com o path %s: %w", rpath, err)
+		}
+
+		if err := vreplacer.MergeConfigMap(vpath.AllSettings()); err != nil {
+			return nil, nil, fmt.Errorf("não consegui realizar o merge do arquivo de configuração remoto. com o path %s: %w", rpath, err)
+		}
+	}
+
+	return vmain, vreplacer, nil
+}
+
+// parse le e valida as configurações, retornando uma nova instancia pronta para uso
+func parse() (*configImpl, error) {
+	vmain, vreplacer, err := load()
+	if err != nil {
+		return nil, err
+	}
+
+	vmain.SetDefault("secrets.dir", defaultSecretsDir)
+
+	c := &configImpl{
+		vmain:      vmain,
+		vreplacer:  vreplacer,
+		secretsDir: vmain.GetString("secrets.dir"),
+	}
+
+	if c.settings, err = decode(vmain, c.replace); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// watchLocal observa o arquivo de configuração local e avisa no channel a cada alteração
+func watchLocal(changes chan<- struct{}, done <-chan struct{}) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+
+	file, err := filepath.Abs(localConfigFile)
+	if err != nil {
+		watcher.Close()
+		return err
+	}
+
+	// observa o diretório para não perder o arquivo quando o editor o recria
+	if err := watcher.Add(filepath.Dir(file)); err != nil {
+		watcher.Close()
+		return err
+	}
+
+	go func() {
+		defer watcher.Close()
+
+		var debounce <-chan time.Time
+		for {
+			select {
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				if filepath.Clean(event.Name) != file || event.Op&(fsnotify.Write|fsnotify.Create|fsnotify.Rename) == 0 {
+					continue
+				}
+				debounce = time.After(localReloadDebounce)
+			case <-debounce:
+				debounce = nil
+				select {
+				case changes <- struct{}{}:
+				case <-done:
+					return
+				}
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				logrus.WithError(err).Error("model.config.watchLocal")
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	return nil
+}
+
+// watchRemote consulta periodicamente o config remoto e avisa no channel quando houver diferença
+func watchRemote(c *configImpl, interval time.Duration, changes chan<- struct{}, done <-chan struct{}) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				vmain, vreplacer, err := load()
+				if err != nil {
+					logrus.WithError(err).Error("model.config.watchRemote")
+					continue
+				}
+
+				c.mu.RLock()
+				changed := !reflect.DeepEqual(vmain.AllSettings(), c.vmain.AllSettings()) ||
+					!reflect.DeepEqual(vreplacer.AllSettings(), c.vreplacer.AllSettings())
+				c.mu.RUnlock()
+
+				if !changed {
+					continue
+				}
+
+				select {
+				case changes <- struct{}{}:
+				case <-done:
+					return
+				}
+			case <-done:
+				return
+			}
+		}
+	}()
+}
+
+// Watch le as configurações, inicia o server e fica escutando modificações no config
+// local (via arquivo) ou remoto (via polling). A cada modificação válida o server é
+// sinalizado pelo channel quit e deve responder no mesmo channel após encerrar as suas
+// conexões, só então o fn é chamado novamente com as novas configurações
+func Watch(fn func(c Config, quit chan bool)) {
+	quit, kill := make(chan bool), make(chan bool)
+	changes, done := make(chan struct{}), make(chan struct{})
+	defer close(done)
+
+	c, err := parse()
+	if err != nil {
+		logrus.Fatal(err.Error())
+	}
+	c.kill = kill
+
+	if isRemote(c.vmain) {
+		watchRemote(c, c.settings.Remote.Interval, changes, done)
+	} else if err := watchLocal(changes, done); err != nil {
+		logrus.WithError(err).Error("não consegui observar o arquivo de configuração local, hot reload desabilitado")
 	}
 
 	// inicia o server
 	go fn(c, quit)
 
-	<-kill
+	for {
+		select {
+		case <-changes:
+			next, err := parse()
+			if err != nil {
+				logrus.WithError(err).Error("configuração alterada inválida, mantendo a atual")
+				continue
+			}
+
+			logrus.Info("configuração alterada, reiniciando o server")
+
+			// sinaliza o server e aguarda o encerramento das conexões
+			quit <- true
+			<-quit
+
+			c.swap(next)
+
+			go fn(c, quit)
+		case <-kill:
+			return
+		}
+	}
 }
 
 // validatorImpl modelo para a validação do bind dos requests
@@ -160,8 +389,12 @@ func New() Validator {
 	}
 }
 
-var encoding = base32.NewEncoding("ybndrfg8ejkmcxotp11uwisza345h769")
+// encoding alfabeto base32 dos códigos gerados. Os 32 caracteres precisam ser
+// distintos: o alfabeto anterior repetia o "1" (e não tinha o "q"), o que as versões
+// recentes do Go rejeitam com panic em base32.NewEncoding ao iniciar o pacote
+var encoding = base32.NewEncoding("ybndrfg8ejkmcpqxot1uwisza345h769")
 
+// NewId gera um código aleatório de 26 caracteres a partir de um UUID
 func NewId() string {
 	var b bytes.Buffer
 	encoder := base32.NewEncoder(encoding, &b)
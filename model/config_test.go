@@ -0,0 +1,21 @@
+package model_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NewId(t *testing.T) {
+	alfabeto := regexp.MustCompile(`^[ybndrfg8ejkmcpqxot1uwisza345h769]{26}$`)
+
+	vistos := map[string]bool{}
+	for i := 0; i < 1000; i++ {
+		id := model.NewId()
+		assert.Regexp(t, alfabeto, id)
+		assert.False(t, vistos[id], "código repetido: %s", id)
+		vistos[id] = true
+	}
+}
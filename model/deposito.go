@@ -0,0 +1,104 @@
+package model
+
+import (
+	"errors"
+	"strings"
+)
+
+// Deposito centro de distribuição que mantém estoque dos produtos
+type Deposito struct {
+	Codigo          string `json:"codigo,omitempty" gorm:"primary_key"`
+	Nome            string `json:"nome,omitempty" gorm:"size:255;not null"`
+	Inativo         bool   `json:"inativo" gorm:"not null"`
+	CriadoEm        string `json:"criado_em,omitempty" gorm:"not null"`
+	UltimaAlteracao string `json:"ultima_alteracao,omitempty" gorm:"not null"`
+}
+
+// EstoqueDeposito estoque de um produto em um depósito, com o corte próprio do depósito
+type EstoqueDeposito struct {
+	ProdutoCodigo     string `json:"produto" gorm:"primary_key;size:64"`
+	DepositoCodigo    string `json:"deposito" gorm:"primary_key;size:64;index"`
+	EstoqueTotal      int64  `json:"estoque_total" gorm:"not null"`
+	EstoqueCorte      int64  `json:"estoque_corte" gorm:"not null"`
+	EstoqueDisponivel int64  `json:"estoque_disponivel" gorm:"not null"`
+	UltimaAlteracao   string `json:"ultima_alteracao,omitempty" gorm:"not null"`
+
+	// Ativo indica se o depósito entra na soma do estoque do produto
+	Ativo bool `json:"ativo" gorm:"-"`
+}
+
+// EstoqueProduto estoque do produto com a quebra por depósito
+type EstoqueProduto struct {
+	Produto           string            `json:"produto"`
+	EstoqueTotal      int64             `json:"estoque_total"`
+	EstoqueCorte      int64             `json:"estoque_corte"`
+	EstoqueDisponivel int64             `json:"estoque_disponivel"`
+	Depositos         []EstoqueDeposito `json:"depositos"`
+}
+
+func (Deposito) TableName() string {
+	return "depositos"
+}
+
+func (EstoqueDeposito) TableName() string {
+	return "produto_depositos"
+}
+
+func (me *Deposito) PreSave() {
+	me.Codigo = NewId()
+}
+
+func (me *Deposito) Validate() error {
+	me.Nome = strings.TrimSpace(me.Nome)
+	if me.Nome == "" {
+		return errors.New("nome do depósito é obrigatório")
+	}
+
+	return nil
+}
+
+func (me *EstoqueDeposito) PreSave() {
+	me.EstoqueDisponivel = me.EstoqueTotal - me.EstoqueCorte
+}
+
+func (me *EstoqueDeposito) Validate() error {
+	if me.EstoqueTotal < 0 || me.EstoqueCorte < 0 {
+		return errors.New("estoque não pode ser negativo")
+	}
+
+	if me.EstoqueTotal < me.EstoqueCorte {
+		return ErrEstoqueIndisponivel
+	}
+
+	return nil
+}
+
+// AgregarEstoqueDepositos marca os estoques dos depósitos ativos e substitui o
+// estoque do produto pela soma deles. Sem estoque por depósito o estoque do
+// próprio produto é mantido
+func AgregarEstoqueDepositos(produto *Produto, estoques []EstoqueDeposito, depositos []Deposito) {
+	if len(estoques) == 0 {
+		return
+	}
+
+	inativos := make(map[string]bool, len(depositos))
+	for _, d := range depositos {
+		inativos[d.Codigo] = d.Inativo
+	}
+
+	produto.EstoqueTotal, produto.EstoqueCorte, produto.EstoqueDisponivel = 0, 0, 0
+	for i := range estoques {
+		e := &estoques[i]
+
+		// um depósito removido ou desativado não conta no estoque do produto
+		inativo, ok := inativos[e.DepositoCodigo]
+		e.Ativo = ok && !inativo
+		if !e.Ativo {
+			continue
+		}
+
+		produto.EstoqueTotal += e.EstoqueTotal
+		produto.EstoqueCorte += e.EstoqueCorte
+		produto.EstoqueDisponivel += e.EstoqueTotal - e.EstoqueCorte
+	}
+}
@@ -0,0 +1,14 @@
+package model
+
+// FiltroProduto filtros aceitos na listagem de produtos
+type FiltroProduto struct {
+	// Categoria inclui os produtos das categorias descendentes
+	Categoria  string
+	Marca      string
+	Fornecedor string
+}
+
+// Vazio indica que nenhum filtro foi informado
+func (f FiltroProduto) Vazio() bool {
+	return f.Categoria == "" && f.Marca == "" && f.Fornecedor == ""
+}
@@ -0,0 +1,63 @@
+package model
+
+import (
+	"errors"
+	"strings"
+)
+
+// Fornecedor empresa que fornece os produtos
+type Fornecedor struct {
+	Codigo          string `json:"codigo,omitempty" gorm:"primary_key"`
+	Nome            string `json:"nome,omitempty" gorm:"size:255;not null"`
+	Documento       string `json:"documento,omitempty" gorm:"size:32"`
+	Email           string `json:"email,omitempty" gorm:"size:255"`
+	CriadoEm        string `json:"criado_em,omitempty" gorm:"not null"`
+	UltimaAlteracao string `json:"ultima_alteracao,omitempty" gorm:"not null"`
+}
+
+// ProdutoFornecedor condições de fornecimento de um produto por um fornecedor
+type ProdutoFornecedor struct {
+	ProdutoCodigo    string  `json:"produto" gorm:"primary_key;size:64"`
+	FornecedorCodigo string  `json:"fornecedor" gorm:"primary_key;size:64;index"`
+	SKU              string  `json:"sku" gorm:"column:sku;size:64;not null"`
+	Custo            float64 `json:"custo" gorm:"not null"`
+	PrazoEntrega     int     `json:"prazo_entrega_dias" gorm:"not null"`
+}
+
+func (Fornecedor) TableName() string {
+	return "fornecedores"
+}
+
+func (ProdutoFornecedor) TableName() string {
+	return "produto_fornecedores"
+}
+
+func (me *Fornecedor) PreSave() {
+	me.Codigo = NewId()
+}
+
+func (me *Fornecedor) Validate() error {
+	me.Nome = strings.TrimSpace(me.Nome)
+	if me.Nome == "" {
+		return errors.New("nome do fornecedor é obrigatório")
+	}
+
+	return nil
+}
+
+func (me *ProdutoFornecedor) Validate() error {
+	me.SKU = strings.TrimSpace(me.SKU)
+	if me.SKU == "" {
+		return errors.New("sku do fornecedor é obrigatório")
+	}
+
+	if me.Custo < 0 {
+		return errors.New("custo não pode ser negativo")
+	}
+
+	if me.PrazoEntrega < 0 {
+		return errors.New("prazo de entrega não pode ser negativo")
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+var (
+	// ErrDescontoAcimaDoMaximo erro retornado quando o preço por fica mais abaixo do preço de que o permitido
+	ErrDescontoAcimaDoMaximo = errors.New("desconto acima do máximo permitido")
+	// ErrVariacaoAcimaDaMaxima erro retornado quando um preço muda mais que o permitido em relação ao gravado
+	ErrVariacaoAcimaDaMaxima = errors.New("variação de preço acima da máxima permitida")
+	// ErrPrecoAbaixoDoMinimo erro retornado quando um preço fica abaixo do mínimo permitido
+	ErrPrecoAbaixoDoMinimo = errors.New("preço abaixo do mínimo permitido")
+	// ErrPrecoAcimaDoMaximo erro retornado quando um preço fica acima do máximo permitido
+	ErrPrecoAcimaDoMaximo = errors.New("preço acima do máximo permitido")
+)
+
+// LimitesPreco regras de preço verificadas nas escritas de produto, além do preço
+// de não ser inferior ao preço por. Os limites zerados não são verificados
+type LimitesPreco struct {
+	// DescontoMaximo percentual máximo do preço por abaixo do preço de
+	DescontoMaximo float64
+	// VariacaoMaxima percentual máximo de alteração de cada preço em relação ao gravado
+	VariacaoMaxima float64
+	// PrecoMinimo e PrecoMaximo valem para o preço de e para o preço por
+	PrecoMinimo float64
+	PrecoMaximo float64
+}
+
+// Verificar verifica os preços do produto. A variação só é verificada quando o
+// produto anterior, já gravado, é informado
+func (l LimitesPreco) Verificar(anterior, produto *Produto) error {
+	precos := []struct {
+		nome            string
+		valor, anterior float64
+	}{
+		{nome: "preço de", valor: produto.PrecoDe},
+		{nome: "preço por", valor: produto.PrecoPor},
+	}
+	if anterior != nil && anterior.Codigo != "" {
+		precos[0].anterior, precos[1].anterior = anterior.PrecoDe, anterior.PrecoPor
+	}
+
+	for _, preco := range precos {
+		if l.PrecoMinimo > 0 && preco.valor < l.PrecoMinimo {
+			return fmt.Errorf("%w: %s %.2f, mínimo %.2f", ErrPrecoAbaixoDoMinimo, preco.nome, preco.valor, l.PrecoMinimo)
+		}
+
+		if l.PrecoMaximo > 0 && preco.valor > l.PrecoMaximo {
+			return fmt.Errorf("%w: %s %.2f, máximo %.2f", ErrPrecoAcimaDoMaximo, preco.nome, preco.valor, l.PrecoMaximo)
+		}
+
+		if l.VariacaoMaxima > 0 && preco.anterior > 0 {
+			variacao := percentual(math.Abs(preco.valor-preco.anterior), preco.anterior)
+			if variacao > l.VariacaoMaxima {
+				return fmt.Errorf("%w: %s alterado de %.2f para %.2f (%.2f%%), máximo %.2f%%", ErrVariacaoAcimaDaMaxima, preco.nome, preco.anterior, preco.valor, variacao, l.VariacaoMaxima)
+			}
+		}
+	}
+
+	if l.DescontoMaximo > 0 && produto.PrecoDe > 0 {
+		desconto := percentual(produto.PrecoDe-produto.PrecoPor, produto.PrecoDe)
+		if desconto > l.DescontoMaximo {
+			return fmt.Errorf("%w: %.2f%%, máximo %.2f%%", ErrDescontoAcimaDoMaximo, desconto, l.DescontoMaximo)
+		}
+	}
+
+	return nil
+}
+
+// percentual retorna quanto o valor representa da base, em percentual com duas casas
+func percentual(valor, base float64) float64 {
+	return math.Round(valor/base*100*100) / 100
+}
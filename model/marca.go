@@ -0,0 +1,31 @@
+package model
+
+import (
+	"errors"
+	"strings"
+)
+
+// Marca fabricante ou marca comercial dos produtos
+type Marca struct {
+	Codigo          string `json:"codigo,omitempty" gorm:"primary_key"`
+	Nome            string `json:"nome,omitempty" gorm:"size:255;not null"`
+	CriadoEm        string `json:"criado_em,omitempty" gorm:"not null"`
+	UltimaAlteracao string `json:"ultima_alteracao,omitempty" gorm:"not null"`
+}
+
+func (Marca) TableName() string {
+	return "marcas"
+}
+
+func (me *Marca) PreSave() {
+	me.Codigo = NewId()
+}
+
+func (me *Marca) Validate() error {
+	me.Nome = strings.TrimSpace(me.Nome)
+	if me.Nome == "" {
+		return errors.New("nome da marca é obrigatório")
+	}
+
+	return nil
+}
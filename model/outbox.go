@@ -0,0 +1,52 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// EventoOutbox evento de domínio gravado na mesma transação da alteração que o
+// originou, publicado depois no broker pelo relay
+type EventoOutbox struct {
+	// Sequencia ordem de gravação, que é a ordem de publicação
+	Sequencia uint64 `json:"sequencia" gorm:"primaryKey;autoIncrement"`
+	Codigo    string `json:"codigo" gorm:"size:64;uniqueIndex;not null"`
+	// Agregado codigo do produto, os eventos de um mesmo produto são publicados em ordem
+	Agregado  string `json:"agregado" gorm:"size:64;index;not null"`
+	Tipo      string `json:"tipo" gorm:"size:64;not null"`
+	Payload   string `json:"payload" gorm:"type:text;not null"`
+	Publicado bool   `json:"publicado" gorm:"index;not null"`
+	// Falhou evento que esgotou as tentativas de publicação e não é mais publicado
+	Falhou      bool   `json:"falhou" gorm:"index;not null;default:false"`
+	Tentativas  int    `json:"tentativas" gorm:"not null"`
+	UltimoErro  string `json:"ultimo_erro,omitempty" gorm:"size:1024"`
+	CriadoEm    string `json:"criado_em,omitempty" gorm:"not null"`
+	PublicadoEm int64  `json:"publicado_em,omitempty" gorm:"index;not null"`
+}
+
+func (EventoOutbox) TableName() string {
+	return "outbox"
+}
+
+// NovoEventoOutbox cria o evento do produto com o mesmo corpo enviado aos webhooks
+func NovoEventoOutbox(tipo string, produto *Produto) (*EventoOutbox, error) {
+	evento := Evento{
+		Codigo:     NewId(),
+		Tipo:       tipo,
+		Produto:    produto.Codigo,
+		OcorridoEm: time.Now().UTC().Format(time.RFC3339),
+		Dados:      produto,
+	}
+
+	payload, err := json.Marshal(evento)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EventoOutbox{
+		Codigo:   evento.Codigo,
+		Agregado: produto.Codigo,
+		Tipo:     tipo,
+		Payload:  string(payload),
+	}, nil
+}
@@ -0,0 +1,94 @@
+package model
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// ErrPatchInvalido erro retornado quando o corpo não é um merge patch de produto válido
+var ErrPatchInvalido = errors.New("patch inválido")
+
+// camposSomenteLeitura campos do produto que não podem ser alterados pelo patch.
+// As variações são mantidas pelas rotas próprias e as promoções são calculadas
+var camposSomenteLeitura = []string{"codigo", "criado_em", "ultima_alteracao", "estoque_disponivel", "variacoes", "preco_promocional", "promocoes"}
+
+// MergePatch aplica o patch sobre o documento seguindo a RFC 7396: os membros
+// null são removidos, os objetos são combinados recursivamente e os demais
+// valores substituem os do documento
+func MergePatch(doc, patch interface{}) interface{} {
+	p, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+
+	d, ok := doc.(map[string]interface{})
+	if !ok {
+		d = map[string]interface{}{}
+	}
+
+	for k, v := range p {
+		if v == nil {
+			delete(d, k)
+			continue
+		}
+		d[k] = MergePatch(d[k], v)
+	}
+
+	return d
+}
+
+// AplicarPatch retorna o produto com o merge patch aplicado. Os campos ausentes
+// no patch mantém o valor atual e os campos null voltam ao valor zero
+func AplicarPatch(produto *Produto, patch []byte) (*Produto, error) {
+	var p interface{}
+	if err := json.Unmarshal(patch, &p); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrPatchInvalido, err)
+	}
+
+	campos, ok := p.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%w: o patch deve ser um objeto json", ErrPatchInvalido)
+	}
+
+	bloqueados := []string{}
+	for _, campo := range camposSomenteLeitura {
+		if _, ok := campos[campo]; ok {
+			bloqueados = append(bloqueados, campo)
+		}
+	}
+	if len(bloqueados) > 0 {
+		sort.Strings(bloqueados)
+		return nil, fmt.Errorf("%w: campos somente leitura %v", ErrPatchInvalido, bloqueados)
+	}
+
+	atual, err := json.Marshal(produto)
+	if err != nil {
+		return nil, err
+	}
+
+	var doc interface{}
+	if err := json.Unmarshal(atual, &doc); err != nil {
+		return nil, err
+	}
+
+	merged, err := json.Marshal(MergePatch(doc, p))
+	if err != nil {
+		return nil, err
+	}
+
+	// os campos omitidos no json do produto atual são os de valor zero, então o
+	// produto decodificado a partir do zero tem exatamente o resultado do patch
+	res := new(Produto)
+	decoder := json.NewDecoder(bytes.NewReader(merged))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(res); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrPatchInvalido, err)
+	}
+
+	res.EstoqueDisponivel = res.EstoqueTotal - res.EstoqueCorte
+
+	return res, nil
+}
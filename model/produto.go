@@ -6,6 +6,15 @@ import (
 	"io"
 )
 
+var (
+	// ErrPrecoDeInferior erro retornado quando o preço de fica abaixo do preço por
+	ErrPrecoDeInferior = errors.New("preço de não pode ser inferior a Preço por")
+	// ErrEstoqueIndisponivel erro retornado quando o estoque de corte passa do estoque total
+	ErrEstoqueIndisponivel = errors.New("estoque indisponivel")
+	// ErrLimiteAlertaNegativo erro retornado quando o limite de alerta é negativo
+	ErrLimiteAlertaNegativo = errors.New("limite de alerta não pode ser negativo")
+)
+
 type Produto struct {
 	Codigo            string  `json:"codigo,omitempty" gorm:"primary_key"`
 Nome              string  `json:"nome,omitempty" gorm:"size:255;not null"`
@@ -16,6 +25,13 @@ UltimaAlteracao   string  `json:"ultima_alteracao,omitempty" gorm:"not null"`
 EstoqueTotal      int64   `json:"estoque_total,omitempty" gorm:"not null"`
 EstoqueCorte      int64   `json:"estoque_corte,omitempty" gorm:"not null"`
 EstoqueDisponivel int64   `json:"estoque_disponivel,omitempty" gorm:"not null"`
+NomeBusca         string  `json:"-" gorm:"size:255;index"`
+Marca             string  `json:"marca,omitempty" gorm:"size:64;index"`
+LimiteAlerta      int64   `json:"limite_alerta,omitempty" gorm:"not null;default:0"`
+Variacoes         []Variacao `json:"variacoes,omitempty" gorm:"-"`
+// PrecoPromocional preço por com as promoções vigentes, calculado na leitura
+PrecoPromocional  float64            `json:"preco_promocional,omitempty" gorm:"-"`
+Promocoes         []PromocaoAplicada `json:"promocoes,omitempty" gorm:"-"`
 }
 
 func (me *Produto) PreSave() {
@@ -25,11 +41,15 @@ func (me *Produto) PreSave() {
 
 func (me *Produto) Validate() error {
 	if me.PrecoDe < me.PrecoPor {
-		return errors.New("preço de não pode ser inferior a Preço por")
+		return ErrPrecoDeInferior
 	}
 
 	if me.EstoqueTotal < me.EstoqueCorte {
-		return errors.New("estoque indisponivel")
+		return ErrEstoqueIndisponivel
+	}
+
+	if me.LimiteAlerta < 0 {
+		return ErrLimiteAlertaNegativo
 	}
 
 	return nil
@@ -0,0 +1,195 @@
+package model
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Tipos de desconto da promoção
+const (
+	DescontoPercentual = "percentual"
+	DescontoFixo       = "fixo"
+)
+
+// Alvos da promoção
+const (
+	AlvoProduto   = "produto"
+	AlvoCategoria = "categoria"
+)
+
+// Promocao desconto sobre o preço por dos produtos informados, ou das categorias
+// informadas e das suas descendentes, válido entre o inicio e o fim. Quando mais de
+// uma promoção vale para o produto a de maior prioridade é aplicada primeiro e as
+// seguintes só são somadas a ela quando todas são acumulativas
+type Promocao struct {
+	Codigo          string   `json:"codigo,omitempty" gorm:"primary_key"`
+	Nome            string   `json:"nome,omitempty" gorm:"size:255;not null"`
+	Tipo            string   `json:"tipo,omitempty" gorm:"size:16;not null"`
+	Valor           float64  `json:"valor,omitempty" gorm:"not null"`
+	Inicio          string   `json:"inicio,omitempty" gorm:"size:32;not null"`
+	Fim             string   `json:"fim,omitempty" gorm:"size:32;not null"`
+	Prioridade      int      `json:"prioridade" gorm:"not null;default:0"`
+	Acumulativa     bool     `json:"acumulativa" gorm:"not null"`
+	Produtos        []string `json:"produtos,omitempty" gorm:"-"`
+	Categorias      []string `json:"categorias,omitempty" gorm:"-"`
+	CriadoEm        string   `json:"criado_em,omitempty" gorm:"not null"`
+	UltimaAlteracao string   `json:"ultima_alteracao,omitempty" gorm:"not null"`
+}
+
+// PromocaoAlvo produto ou categoria que recebe a promoção
+type PromocaoAlvo struct {
+	PromocaoCodigo string `json:"promocao" gorm:"primary_key;size:64"`
+	Tipo           string `json:"tipo" gorm:"primary_key;size:16"`
+	Alvo           string `json:"alvo" gorm:"primary_key;size:64;index"`
+}
+
+// PromocaoAplicada promoção que compõe o preço promocional do produto, com o
+// valor descontado por ela
+type PromocaoAplicada struct {
+	Codigo   string  `json:"codigo"`
+	Nome     string  `json:"nome"`
+	Desconto float64 `json:"desconto"`
+}
+
+func (Promocao) TableName() string {
+	return "promocoes"
+}
+
+func (PromocaoAlvo) TableName() string {
+	return "promocao_alvos"
+}
+
+func (me *Promocao) PreSave() {
+	me.Codigo = NewId()
+}
+
+// Validate valida a promoção e normaliza o inicio e o fim para RFC 3339 em UTC
+func (me *Promocao) Validate() error {
+	me.Nome = strings.TrimSpace(me.Nome)
+	if me.Nome == "" {
+		return errors.New("nome da promoção é obrigatório")
+	}
+
+	switch me.Tipo {
+	case DescontoPercentual:
+		if me.Valor <= 0 || me.Valor > 100 {
+			return errors.New("desconto percentual deve ser maior que 0 e até 100")
+		}
+	case DescontoFixo:
+		if me.Valor <= 0 {
+			return errors.New("desconto fixo deve ser maior que 0")
+		}
+	default:
+		return errors.New("tipo da promoção deve ser percentual ou fixo")
+	}
+
+	inicio, err := time.Parse(time.RFC3339, me.Inicio)
+	if err != nil {
+		return errors.New("inicio da promoção deve estar no formato RFC 3339")
+	}
+
+	fim, err := time.Parse(time.RFC3339, me.Fim)
+	if err != nil {
+		return errors.New("fim da promoção deve estar no formato RFC 3339")
+	}
+
+	if !fim.After(inicio) {
+		return errors.New("fim da promoção deve ser posterior ao inicio")
+	}
+
+	me.Inicio = inicio.UTC().Format(time.RFC3339)
+	me.Fim = fim.UTC().Format(time.RFC3339)
+
+	if len(me.Produtos) == 0 && len(me.Categorias) == 0 {
+		return errors.New("informe os produtos ou as categorias da promoção")
+	}
+
+	return nil
+}
+
+// Vigente indica se a promoção vale no instante informado. O fim não está incluído
+func (me *Promocao) Vigente(agora time.Time) bool {
+	inicio, err := time.Parse(time.RFC3339, me.Inicio)
+	if err != nil {
+		return false
+	}
+
+	fim, err := time.Parse(time.RFC3339, me.Fim)
+	if err != nil {
+		return false
+	}
+
+	return !agora.Before(inicio) && agora.Before(fim)
+}
+
+// Alvos retorna os produtos e as categorias da promoção
+func (me *Promocao) Alvos() []PromocaoAlvo {
+	alvos := []PromocaoAlvo{}
+	for _, produto := range me.Produtos {
+		alvos = append(alvos, PromocaoAlvo{PromocaoCodigo: me.Codigo, Tipo: AlvoProduto, Alvo: produto})
+	}
+	for _, categoria := range me.Categorias {
+		alvos = append(alvos, PromocaoAlvo{PromocaoCodigo: me.Codigo, Tipo: AlvoCategoria, Alvo: categoria})
+	}
+
+	return alvos
+}
+
+// desconto valor descontado pela promoção sobre o preço informado, limitado ao próprio preço
+func (me *Promocao) desconto(preco float64) float64 {
+	desconto := me.Valor
+	if me.Tipo == DescontoPercentual {
+		desconto = preco * me.Valor / 100
+	}
+
+	return math.Min(Arredondar(desconto, ArredondamentoCentavo), preco)
+}
+
+// AplicarPromocoes preenche o preço promocional do produto com as promoções que
+// valem para ele. As promoções são ordenadas por prioridade e, na mesma
+// prioridade, pelo maior desconto. A primeira é sempre aplicada e as seguintes
+// são acumuladas, uma sobre o preço da anterior, enquanto forem acumulativas
+func AplicarPromocoes(produto *Produto, promocoes []Promocao) {
+	produto.PrecoPromocional = 0
+	produto.Promocoes = nil
+
+	ordenadas := append([]Promocao{}, promocoes...)
+	sort.SliceStable(ordenadas, func(i, j int) bool {
+		if ordenadas[i].Prioridade != ordenadas[j].Prioridade {
+			return ordenadas[i].Prioridade > ordenadas[j].Prioridade
+		}
+
+		di, dj := ordenadas[i].desconto(produto.PrecoPor), ordenadas[j].desconto(produto.PrecoPor)
+		if di != dj {
+			return di > dj
+		}
+
+		return ordenadas[i].Codigo < ordenadas[j].Codigo
+	})
+
+	preco := produto.PrecoPor
+	aplicadas := []PromocaoAplicada{}
+	for i, promocao := range ordenadas {
+		if i > 0 && (!ordenadas[0].Acumulativa || !promocao.Acumulativa) {
+			continue
+		}
+
+		desconto := promocao.desconto(preco)
+		if desconto <= 0 {
+			continue
+		}
+
+		preco = Arredondar(preco-desconto, ArredondamentoCentavo)
+		aplicadas = append(aplicadas, PromocaoAplicada{Codigo: promocao.Codigo, Nome: promocao.Nome, Desconto: desconto})
+	}
+
+	if len(aplicadas) == 0 {
+		return
+	}
+
+	produto.PrecoPromocional = preco
+	produto.Promocoes = aplicadas
+}
@@ -0,0 +1,175 @@
+package model
+
+import (
+	"errors"
+	"math"
+)
+
+const (
+	// CampoPrecoDe reajusta apenas o preço de
+	CampoPrecoDe = "preco_de"
+	// CampoPrecoPor reajusta apenas o preço por
+	CampoPrecoPor = "preco_por"
+	// CampoPrecos reajusta os dois preços, cada um sobre o seu valor atual
+	CampoPrecos = "precos"
+
+	// ReajustePercentual aplica o valor como percentual sobre a base
+	ReajustePercentual = "percentual"
+	// ReajusteFixo soma o valor à base
+	ReajusteFixo = "fixo"
+
+	// ArredondamentoCentavo arredonda para o centavo mais próximo
+	ArredondamentoCentavo = "centavo"
+	// ArredondamentoInteiro arredonda para o real mais próximo
+	ArredondamentoInteiro = "inteiro"
+	// ArredondamentoFinal99 mantém os reais e termina o preço em ,99
+	ArredondamentoFinal99 = "final_99"
+)
+
+// Reajuste ajuste de preço em lote, sobre os produtos dos codigos informados ou
+// sobre os que atendem ao filtro. A base é o preço sobre o qual o novo valor é
+// calculado, o próprio campo quando não informada
+type Reajuste struct {
+	Codigos    []string `json:"codigos,omitempty"`
+	Categoria  string   `json:"categoria,omitempty"`
+	Marca      string   `json:"marca,omitempty"`
+	Fornecedor string   `json:"fornecedor,omitempty"`
+
+	Campo          string  `json:"campo"`
+	Base           string  `json:"base,omitempty"`
+	Tipo           string  `json:"tipo"`
+	Valor          float64 `json:"valor"`
+	Arredondamento string  `json:"arredondamento,omitempty"`
+
+	// Confirmacao é a confirmação da prévia, obrigatória para aplicar o reajuste
+	Confirmacao string `json:"confirmacao,omitempty"`
+}
+
+// ItemReajuste alteração de preço de um produto no reajuste. O erro indica que o
+// produto ficaria inválido com os novos preços
+type ItemReajuste struct {
+	Codigo           string  `json:"codigo"`
+	Nome             string  `json:"nome,omitempty"`
+	PrecoDeAnterior  float64 `json:"preco_de_anterior"`
+	PrecoPorAnterior float64 `json:"preco_por_anterior"`
+	PrecoDe          float64 `json:"preco_de"`
+	PrecoPor         float64 `json:"preco_por"`
+	Erro             string  `json:"erro,omitempty"`
+}
+
+// PreviaReajuste resultado do reajuste, com os produtos que terão o preço alterado
+type PreviaReajuste struct {
+	Selecionados int            `json:"selecionados"`
+	Alterados    int            `json:"alterados"`
+	Invalidos    int            `json:"invalidos"`
+	Itens        []ItemReajuste `json:"itens"`
+	Confirmacao  string         `json:"confirmacao"`
+}
+
+// Filtro retorna o filtro da listagem usado para selecionar os produtos
+func (r Reajuste) Filtro() FiltroProduto {
+	return FiltroProduto{Categoria: r.Categoria, Marca: r.Marca, Fornecedor: r.Fornecedor}
+}
+
+func (r Reajuste) Validate() error {
+	if len(r.Codigos) == 0 && r.Filtro().Vazio() {
+		return errors.New("informe os codigos ou um filtro dos produtos")
+	}
+
+	if len(r.Codigos) > 0 && !r.Filtro().Vazio() {
+		return errors.New("informe os codigos ou um filtro dos produtos, não os dois")
+	}
+
+	switch r.Campo {
+	case CampoPrecoDe, CampoPrecoPor:
+	case CampoPrecos:
+		if r.Base != "" {
+			return errors.New("base não pode ser informada ao reajustar os dois preços")
+		}
+	default:
+		return errors.New("campo deve ser preco_de, preco_por ou precos")
+	}
+
+	if r.Base != "" && r.Base != CampoPrecoDe && r.Base != CampoPrecoPor {
+		return errors.New("base deve ser preco_de ou preco_por")
+	}
+
+	switch r.Tipo {
+	case ReajustePercentual:
+		if r.Valor < -100 {
+			return errors.New("percentual não pode ser inferior a -100")
+		}
+	case ReajusteFixo:
+	default:
+		return errors.New("tipo deve ser percentual ou fixo")
+	}
+
+	switch r.Arredondamento {
+	case "", ArredondamentoCentavo, ArredondamentoInteiro, ArredondamentoFinal99:
+	default:
+		return errors.New("arredondamento deve ser centavo, inteiro ou final_99")
+	}
+
+	return nil
+}
+
+// Aplicar retorna o item com os novos preços do produto, sem alterá-lo
+func (r Reajuste) Aplicar(produto Produto) ItemReajuste {
+	item := ItemReajuste{
+		Codigo:           produto.Codigo,
+		Nome:             produto.Nome,
+		PrecoDeAnterior:  produto.PrecoDe,
+		PrecoPorAnterior: produto.PrecoPor,
+		PrecoDe:          produto.PrecoDe,
+		PrecoPor:         produto.PrecoPor,
+	}
+
+	if r.Campo == CampoPrecoDe || r.Campo == CampoPrecos {
+		item.PrecoDe = r.calcular(r.base(produto, produto.PrecoDe))
+	}
+	if r.Campo == CampoPrecoPor || r.Campo == CampoPrecos {
+		item.PrecoPor = r.calcular(r.base(produto, produto.PrecoPor))
+	}
+
+	return item
+}
+
+func (r Reajuste) base(produto Produto, atual float64) float64 {
+	switch r.Base {
+	case CampoPrecoDe:
+		return produto.PrecoDe
+	case CampoPrecoPor:
+		return produto.PrecoPor
+	}
+
+	return atual
+}
+
+func (r Reajuste) calcular(base float64) float64 {
+	novo := base + r.Valor
+	if r.Tipo == ReajustePercentual {
+		novo = base * (1 + r.Valor/100)
+	}
+
+	return Arredondar(novo, r.Arredondamento)
+}
+
+// Arredondar arredonda o preço pela regra informada, por padrão para o centavo
+func Arredondar(preco float64, regra string) float64 {
+	switch regra {
+	case ArredondamentoInteiro:
+		return math.Round(preco)
+	case ArredondamentoFinal99:
+		if preco < 1 {
+			return math.Round(preco*100) / 100
+		}
+		return math.Round((math.Floor(math.Round(preco*100)/100)+0.99)*100) / 100
+	}
+
+	return math.Round(preco*100) / 100
+}
+
+// Alterado indica se o reajuste muda algum dos preços do produto
+func (i ItemReajuste) Alterado() bool {
+	return i.PrecoDe != i.PrecoDeAnterior || i.PrecoPor != i.PrecoPorAnterior
+}
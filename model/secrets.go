@@ -0,0 +1,72 @@
+package model
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+const defaultSecretsDir = "/run/secrets"
+
+// placeholder identifica as variaveis no formato $chave$ dentro das configurações
+var placeholder = regexp.MustCompile(`\$([A-Za-z0-9_.\-]+)\$`)
+
+// envName converte a chave do placeholder para o nome da variavel de ambiente (db.password -> DB_PASSWORD)
+func envName(key string) string {
+	return strings.ToUpper(strings.NewReplacer(".", "_", "-", "_").Replace(key))
+}
+
+// lookupEnv busca o valor do placeholder na variavel de ambiente ou no arquivo
+// apontado pela variavel <CHAVE>_FILE, seguindo a convenção das imagens docker
+func lookupEnv(key string) (string, bool) {
+	name := envName(key)
+
+	if value, ok := os.LookupEnv(name); ok {
+		return value, true
+	}
+
+	if file, ok := os.LookupEnv(name + "_FILE"); ok {
+		return readSecretFile(file)
+	}
+
+	return "", false
+}
+
+// lookupSecretFile busca o valor do placeholder em um arquivo de secret montado
+// no diretório informado (docker secrets / kubernetes secret volume)
+func lookupSecretFile(dir, key string) (string, bool) {
+	if dir == "" {
+		return "", false
+	}
+
+	for _, name := range []string{key, strings.ToLower(key), envName(key)} {
+		if value, ok := readSecretFile(filepath.Join(dir, name)); ok {
+			return value, true
+		}
+	}
+
+	return "", false
+}
+
+func readSecretFile(path string) (string, bool) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", false
+	}
+
+	return strings.TrimRight(string(data), "\r\n"), true
+}
+
+// unresolvedError monta o erro com os placeholders que ficaram sem valor
+func unresolvedError(keys []string, dir string) error {
+	hints := make([]string, 0, len(keys))
+	for _, key := range keys {
+		hints = append(hints, fmt.Sprintf("$%s$ (defina a variavel de ambiente %s, %s_FILE ou o arquivo %s)",
+			key, envName(key), envName(key), filepath.Join(dir, key)))
+	}
+
+	return fmt.Errorf("configuração inválida: placeholders sem valor: %s", strings.Join(hints, "; "))
+}
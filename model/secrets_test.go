@@ -0,0 +1,150 @@
+package model
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_lookupEnv(t *testing.T) {
+	dir := t.TempDir()
+	arquivo := filepath.Join(dir, "senha")
+	if err := ioutil.WriteFile(arquivo, []byte("do-arquivo\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := map[string]struct {
+		Env map[string]string
+
+		Expected   string
+		ExpectedOk bool
+	}{
+		"deve buscar a variavel de ambiente":           {Env: map[string]string{"DB_SENHA": "do-env"}, Expected: "do-env", ExpectedOk: true},
+		"deve aceitar a variavel vazia":                {Env: map[string]string{"DB_SENHA": ""}, Expected: "", ExpectedOk: true},
+		"deve ler o arquivo apontado pelo _FILE":       {Env: map[string]string{"DB_SENHA_FILE": arquivo}, Expected: "do-arquivo", ExpectedOk: true},
+		"deve preferir a variavel ao _FILE":            {Env: map[string]string{"DB_SENHA": "do-env", "DB_SENHA_FILE": arquivo}, Expected: "do-env", ExpectedOk: true},
+		"não deve resolver com o _FILE inexistente":    {Env: map[string]string{"DB_SENHA_FILE": filepath.Join(dir, "xpto")}},
+		"não deve resolver sem a variavel de ambiente": {Env: map[string]string{}},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			for key, value := range cs.Env {
+				t.Setenv(key, value)
+			}
+
+			value, ok := lookupEnv("db.senha")
+			assert.Equal(t, cs.ExpectedOk, ok)
+			assert.Equal(t, cs.Expected, value)
+		})
+	}
+}
+
+func Test_lookupSecretFile(t *testing.T) {
+	cases := map[string]struct {
+		Arquivo string
+		Chave   string
+
+		Expected   string
+		ExpectedOk bool
+	}{
+		// os arquivos terminam com quebra de linha, que não faz parte do segredo
+		"deve ler o arquivo com o nome da chave":       {Arquivo: "db.senha", Chave: "db.senha", Expected: "segredo", ExpectedOk: true},
+		"deve ler o arquivo com a chave em minúsculas": {Arquivo: "db.senha", Chave: "DB.Senha", Expected: "segredo", ExpectedOk: true},
+		"deve ler o arquivo com o nome da variavel":    {Arquivo: "DB_SENHA", Chave: "db.senha", Expected: "segredo", ExpectedOk: true},
+		"não deve resolver sem o arquivo":              {Arquivo: "outro", Chave: "db.senha"},
+		"não deve resolver sem o diretório de secrets": {Chave: "db.senha"},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			dir := ""
+			if cs.Arquivo != "" {
+				dir = t.TempDir()
+				if err := ioutil.WriteFile(filepath.Join(dir, cs.Arquivo), []byte("segredo\r\n"), 0600); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			value, ok := lookupSecretFile(dir, cs.Chave)
+			assert.Equal(t, cs.ExpectedOk, ok)
+			assert.Equal(t, cs.Expected, value)
+		})
+	}
+}
+
+func Test_replace(t *testing.T) {
+	dir := t.TempDir()
+	if err := ioutil.WriteFile(filepath.Join(dir, "db.senha"), []byte("do-arquivo"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := map[string]struct {
+		Replacer map[string]interface{}
+		Env      map[string]string
+
+		Expected string
+	}{
+		"deve buscar no config remoto primeiro":       {Replacer: map[string]interface{}{"db.senha": "do-remoto"}, Env: map[string]string{"DB_SENHA": "do-env"}, Expected: "root:do-remoto@tcp(db)/produtos"},
+		"deve buscar na variavel de ambiente":         {Env: map[string]string{"DB_SENHA": "do-env"}, Expected: "root:do-env@tcp(db)/produtos"},
+		"deve buscar no diretório de secrets":         {Expected: "root:do-arquivo@tcp(db)/produtos"},
+		"deve ignorar o valor remoto que não é texto": {Replacer: map[string]interface{}{"db.senha": 10}, Expected: "root:do-arquivo@tcp(db)/produtos"},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			for key, value := range cs.Env {
+				t.Setenv(key, value)
+			}
+
+			vreplacer := viper.New()
+			for key, value := range cs.Replacer {
+				vreplacer.Set(key, value)
+			}
+
+			c := &configImpl{vmain: viper.New(), vreplacer: vreplacer, secretsDir: dir}
+			assert.Equal(t, cs.Expected, c.replace("root:$db.senha$@tcp(db)/produtos"))
+		})
+	}
+}
+
+func Test_decodePlaceholders(t *testing.T) {
+	dir := t.TempDir()
+
+	cases := map[string]struct {
+		Config map[string]interface{}
+
+		ExpectedErr string
+	}{
+		"deve listar todos os placeholders sem valor": {Config: map[string]interface{}{
+			"database.writer.url": "root:$db.senha$@tcp(db)/produtos",
+			"remote.token":        "$remote-token$",
+		}, ExpectedErr: "configuração inválida: placeholders sem valor: " +
+			"$db.senha$ (defina a variavel de ambiente DB_SENHA, DB_SENHA_FILE ou o arquivo " + filepath.Join(dir, "db.senha") + "); " +
+			"$remote-token$ (defina a variavel de ambiente REMOTE_TOKEN, REMOTE_TOKEN_FILE ou o arquivo " + filepath.Join(dir, "remote-token") + ")"},
+		"deve listar os placeholders das listas": {Config: map[string]interface{}{
+			"database.writer.url":  "root:senha@tcp(db)/produtos",
+			"outbox.kafka.brokers": []string{"$kafka.host$:9092"},
+		}, ExpectedErr: "placeholders sem valor: $kafka.host$"},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			vmain := viper.New()
+			for key, value := range cs.Config {
+				vmain.Set(key, value)
+			}
+			vmain.Set("secrets.dir", dir)
+
+			c := &configImpl{vmain: vmain, vreplacer: viper.New(), secretsDir: dir}
+
+			_, err := decode(vmain, c.replace)
+			if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), cs.ExpectedErr)
+			}
+		})
+	}
+}
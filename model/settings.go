@@ -0,0 +1,497 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/spf13/viper"
+)
+
+// Settings configuração tipada da aplicação
+type Settings struct {
+	Env     string `json:"tc" mapstructure:"tc" validate:"required"`
+	Version string `json:"version" mapstructure:"version"`
+
+	Server      ServerSettings      `json:"server" mapstructure:"server"`
+	Log         LogSettings         `json:"log" mapstructure:"log"`
+	Database    DatabaseSettings    `json:"database" mapstructure:"database"`
+	Remote      RemoteSettings      `json:"remote" mapstructure:"remote"`
+	Secrets     SecretsSettings     `json:"secrets" mapstructure:"secrets"`
+	Cache       CacheSettings       `json:"cache" mapstructure:"cache"`
+	RateLimit   RateLimitSettings   `json:"ratelimit" mapstructure:"ratelimit"`
+	Idempotency IdempotencySettings `json:"idempotency" mapstructure:"idempotency"`
+	Indice      IndiceSettings      `json:"indice" mapstructure:"indice"`
+	Alertas     AlertasSettings     `json:"alertas" mapstructure:"alertas"`
+	Webhooks    WebhooksSettings    `json:"webhooks" mapstructure:"webhooks"`
+	Outbox      OutboxSettings      `json:"outbox" mapstructure:"outbox"`
+	Stream      StreamSettings      `json:"stream" mapstructure:"stream"`
+	GRPC        GRPCSettings        `json:"grpc" mapstructure:"grpc"`
+	GraphQL     GraphQLSettings     `json:"graphql" mapstructure:"graphql"`
+	Precos      PrecosSettings      `json:"precos" mapstructure:"precos"`
+}
+
+// ServerSettings configurações do server http
+type ServerSettings struct {
+	Port string `json:"port" mapstructure:"port" validate:"required"`
+	// ProxiesConfiaveis redes, em notação CIDR, dos proxies dos quais o ip do cliente
+	// é lido do X-Forwarded-For. Sem proxies é usado o ip da conexão
+	ProxiesConfiaveis []string `json:"proxies_confiaveis" mapstructure:"proxies_confiaveis" validate:"dive,cidr"`
+}
+
+// LogSettings configurações do logger
+type LogSettings struct {
+	Level  string `json:"level" mapstructure:"level" validate:"oneof=trace debug info warn warning error fatal panic"`
+	Format string `json:"format" mapstructure:"format" validate:"oneof=json text"`
+}
+
+// DatabaseSettings configurações de acesso ao banco
+type DatabaseSettings struct {
+	Driver string               `json:"driver" mapstructure:"driver" validate:"oneof=mysql postgres sqlite memory"`
+	Writer DatabaseConnSettings `json:"writer" mapstructure:"writer"`
+}
+
+// DatabaseConnSettings configurações de uma conexão com o banco
+type DatabaseConnSettings struct {
+	URL string `json:"url" mapstructure:"url"`
+}
+
+// RemoteSettings configurações do provider de config remoto
+type RemoteSettings struct {
+	Provider string        `json:"provider" mapstructure:"provider"`
+	Endpoint string        `json:"endpoint" mapstructure:"endpoint"`
+	Path     string        `json:"path" mapstructure:"path"`
+	Token    string        `json:"token" mapstructure:"token"`
+	Replace  []string      `json:"replace" mapstructure:"replace"`
+	Interval time.Duration `json:"interval" mapstructure:"interval" validate:"gt=0"`
+}
+
+// SecretsSettings configurações da resolução dos placeholders $chave$
+type SecretsSettings struct {
+	Dir string `json:"dir" mapstructure:"dir"`
+}
+
+// CacheSettings configurações do cache de leitura dos produtos
+type CacheSettings struct {
+	Enabled bool          `json:"enabled" mapstructure:"enabled"`
+	Backend string        `json:"backend" mapstructure:"backend" validate:"oneof=lru redis"`
+	Size    int           `json:"size" mapstructure:"size" validate:"gt=0"`
+	TTL     time.Duration `json:"ttl" mapstructure:"ttl" validate:"gt=0"`
+	Redis   RedisSettings `json:"redis" mapstructure:"redis"`
+}
+
+// RedisSettings configurações de conexão com um servidor compatível com o redis
+type RedisSettings struct {
+	URL string `json:"url" mapstructure:"url"`
+}
+
+// RateLimitSettings configurações do rate limit por cliente
+type RateLimitSettings struct {
+	Enabled bool          `json:"enabled" mapstructure:"enabled"`
+	Read    LimitSettings `json:"read" mapstructure:"read"`
+	Write   LimitSettings `json:"write" mapstructure:"write"`
+	// MaxClientes quantidade máxima de clientes acompanhados em memória
+	MaxClientes int `json:"max_clientes" mapstructure:"max_clientes" validate:"gte=0"`
+}
+
+// LimitSettings limite de um token bucket, em requisições por segundo e rajada máxima
+type LimitSettings struct {
+	Rate  float64 `json:"rate" mapstructure:"rate" validate:"gt=0"`
+	Burst int     `json:"burst" mapstructure:"burst" validate:"gt=0"`
+}
+
+// IdempotencySettings configurações do suporte ao header Idempotency-Key. As
+// respostas são guardadas no mesmo backend configurado para o cache
+type IdempotencySettings struct {
+	Enabled bool          `json:"enabled" mapstructure:"enabled"`
+	TTL     time.Duration `json:"ttl" mapstructure:"ttl" validate:"gt=0"`
+	Size    int           `json:"size" mapstructure:"size" validate:"gt=0"`
+	// Processamento tempo máximo que a chave fica reservada pela requisição em execução
+	Processamento time.Duration `json:"processamento" mapstructure:"processamento" validate:"gt=0"`
+}
+
+// IndiceSettings configurações do índice de texto das sugestões. Sem path o índice
+// fica em memória e é recriado a cada subida
+type IndiceSettings struct {
+	Enabled bool   `json:"enabled" mapstructure:"enabled"`
+	Path    string `json:"path" mapstructure:"path"`
+}
+
+// AlertasSettings configurações dos alertas de estoque baixo. O limite padrão vale
+// para os produtos sem limite_alerta próprio
+type AlertasSettings struct {
+	LimitePadrao int64           `json:"limite_padrao" mapstructure:"limite_padrao" validate:"gte=0"`
+	Canais       []string        `json:"canais" mapstructure:"canais" validate:"dive,oneof=log webhook"`
+	Webhook      WebhookSettings `json:"webhook" mapstructure:"webhook"`
+}
+
+// WebhookSettings configurações do canal de notificação por webhook
+type WebhookSettings struct {
+	URL     string        `json:"url" mapstructure:"url"`
+	Timeout time.Duration `json:"timeout" mapstructure:"timeout" validate:"gt=0"`
+}
+
+// WebhooksSettings configurações da entrega dos eventos aos webhooks assinados
+type WebhooksSettings struct {
+	Enabled       bool          `json:"enabled" mapstructure:"enabled"`
+	Intervalo     time.Duration `json:"intervalo" mapstructure:"intervalo" validate:"gt=0"`
+	Timeout       time.Duration `json:"timeout" mapstructure:"timeout" validate:"gt=0"`
+	MaxTentativas int           `json:"max_tentativas" mapstructure:"max_tentativas" validate:"gt=0"`
+	Espera        time.Duration `json:"espera" mapstructure:"espera" validate:"gt=0"`
+	EsperaMaxima  time.Duration `json:"espera_maxima" mapstructure:"espera_maxima" validate:"gt=0"`
+	Lote          int           `json:"lote" mapstructure:"lote" validate:"gt=0"`
+	// PermitirInternos aceita os destinos em loopback, link-local e redes privadas
+	PermitirInternos bool `json:"permitir_internos" mapstructure:"permitir_internos"`
+}
+
+// OutboxSettings configurações da publicação dos eventos de produto no broker
+type OutboxSettings struct {
+	Enabled   bool          `json:"enabled" mapstructure:"enabled"`
+	Broker    string        `json:"broker" mapstructure:"broker" validate:"oneof=memory nats kafka"`
+	Intervalo time.Duration `json:"intervalo" mapstructure:"intervalo" validate:"gt=0"`
+	Lote      int           `json:"lote" mapstructure:"lote" validate:"gt=0"`
+	// Retencao tempo que os eventos publicados ficam na outbox, zero mantém para sempre
+	Retencao time.Duration `json:"retencao" mapstructure:"retencao" validate:"gte=0"`
+	// MaxTentativas tentativas de publicação de um evento antes de marcá-lo como falho
+	MaxTentativas int           `json:"max_tentativas" mapstructure:"max_tentativas" validate:"gt=0"`
+	NATS          NATSSettings  `json:"nats" mapstructure:"nats"`
+	Kafka         KafkaSettings `json:"kafka" mapstructure:"kafka"`
+}
+
+// NATSSettings configurações do broker NATS
+type NATSSettings struct {
+	URL     string `json:"url" mapstructure:"url"`
+	Prefixo string `json:"prefixo" mapstructure:"prefixo"`
+}
+
+// KafkaSettings configurações do broker Kafka
+type KafkaSettings struct {
+	Brokers []string `json:"brokers" mapstructure:"brokers"`
+	Topico  string   `json:"topico" mapstructure:"topico"`
+	// EsperaLote tempo máximo que cada publicação aguarda para formar o lote
+	EsperaLote time.Duration `json:"espera_lote" mapstructure:"espera_lote" validate:"gt=0"`
+}
+
+// StreamSettings configurações do stream de eventos de produto (SSE). O buffer
+// guarda os últimos eventos para os clientes que se reconectam, e os pendentes
+// limitam os eventos esperando um cliente lento antes de desconectá-lo
+type StreamSettings struct {
+	Enabled   bool `json:"enabled" mapstructure:"enabled"`
+	Buffer    int  `json:"buffer" mapstructure:"buffer" validate:"gte=0"`
+	Pendentes int  `json:"pendentes" mapstructure:"pendentes" validate:"gt=0"`
+}
+
+// GRPCSettings configurações do server gRPC, iniciado junto com o server http
+type GRPCSettings struct {
+	Enabled bool   `json:"enabled" mapstructure:"enabled"`
+	Port    string `json:"port" mapstructure:"port" validate:"required_if=Enabled true"`
+}
+
+// GraphQLSettings configurações do endpoint /graphql. A profundidade e a
+// complexidade máximas são verificadas antes de executar cada consulta
+type GraphQLSettings struct {
+	Enabled         bool `json:"enabled" mapstructure:"enabled"`
+	MaxProfundidade int  `json:"max_profundidade" mapstructure:"max_profundidade" validate:"gte=0"`
+	MaxComplexidade int  `json:"max_complexidade" mapstructure:"max_complexidade" validate:"gte=0"`
+}
+
+// PrecosSettings limites de preço verificados nas escritas de produto, zero
+// desabilita o limite. Os percentuais vão de 0 a 100
+type PrecosSettings struct {
+	DescontoMaximo float64 `json:"desconto_maximo" mapstructure:"desconto_maximo" validate:"gte=0,lte=100"`
+	VariacaoMaxima float64 `json:"variacao_maxima" mapstructure:"variacao_maxima" validate:"gte=0"`
+	Minimo         float64 `json:"minimo" mapstructure:"minimo" validate:"gte=0"`
+	Maximo         float64 `json:"maximo" mapstructure:"maximo" validate:"gte=0"`
+}
+
+// LimitesPreco retorna os limites configurados para a verificação dos produtos
+func (s PrecosSettings) LimitesPreco() LimitesPreco {
+	return LimitesPreco{
+		DescontoMaximo: s.DescontoMaximo,
+		VariacaoMaxima: s.VariacaoMaxima,
+		PrecoMinimo:    s.Minimo,
+		PrecoMaximo:    s.Maximo,
+	}
+}
+
+// IsProduction indica se a aplicação está rodando em produção
+func (s Settings) IsProduction() bool {
+	return s.Env == "prod"
+}
+
+// Redacted retorna uma cópia das configurações com os segredos mascarados
+func (s Settings) Redacted() Settings {
+	s.Database.Writer.URL = redactDSN(s.Database.Writer.URL)
+	s.Remote.Token = redact(s.Remote.Token)
+	s.Cache.Redis.URL = redactDSN(s.Cache.Redis.URL)
+	s.Alertas.Webhook.URL = redact(s.Alertas.Webhook.URL)
+	s.Outbox.NATS.URL = redactDSN(s.Outbox.NATS.URL)
+
+	return s
+}
+
+const redactedValue = "******"
+
+func redact(value string) string {
+	if value == "" {
+		return value
+	}
+
+	return redactedValue
+}
+
+// dsnPassword identifica a senha nos dsn no formato chave=valor (postgres)
+var dsnPassword = regexp.MustCompile(`(password=)[^\s&]+`)
+
+// redactDSN mascara a senha de um dsn no formato user:senha@... ou password=senha
+func redactDSN(dsn string) string {
+	dsn = dsnPassword.ReplaceAllString(dsn, "${1}"+redactedValue)
+
+	at := strings.LastIndex(dsn, "@")
+	if at < 0 {
+		return dsn
+	}
+
+	userinfo := dsn[:at]
+	if i := strings.Index(userinfo, "://"); i >= 0 {
+		userinfo = userinfo[i+3:]
+	}
+
+	colon := strings.Index(userinfo, ":")
+	if colon < 0 {
+		return dsn
+	}
+
+	prefix := dsn[:at-len(userinfo)+colon+1]
+	return prefix + redactedValue + dsn[at:]
+}
+
+// setDefaults registra os valores padrão das configurações
+func setDefaults(v *viper.Viper) {
+	v.SetDefault("tc", "local")
+	v.SetDefault("version", "")
+	v.SetDefault("server.port", ":5055")
+	v.SetDefault("server.proxies_confiaveis", []string{})
+	v.SetDefault("log.level", "info")
+	v.SetDefault("log.format", "json")
+	v.SetDefault("database.driver", "mysql")
+	v.SetDefault("database.writer.url", "")
+	v.SetDefault("remote.interval", defaultRemoteInterval)
+	v.SetDefault("cache.enabled", true)
+	v.SetDefault("cache.backend", "lru")
+	v.SetDefault("cache.size", 10000)
+	v.SetDefault("cache.ttl", time.Minute)
+	v.SetDefault("cache.redis.url", "")
+	v.SetDefault("ratelimit.enabled", true)
+	v.SetDefault("ratelimit.read.rate", 50)
+	v.SetDefault("ratelimit.read.burst", 100)
+	v.SetDefault("ratelimit.write.rate", 10)
+	v.SetDefault("ratelimit.write.burst", 20)
+	v.SetDefault("ratelimit.max_clientes", 100000)
+	v.SetDefault("idempotency.enabled", true)
+	v.SetDefault("idempotency.ttl", 24*time.Hour)
+	v.SetDefault("idempotency.size", 100000)
+	v.SetDefault("idempotency.processamento", time.Minute)
+	v.SetDefault("indice.enabled", true)
+	v.SetDefault("indice.path", "")
+	v.SetDefault("alertas.limite_padrao", 0)
+	v.SetDefault("alertas.canais", []string{"log"})
+	v.SetDefault("alertas.webhook.url", "")
+	v.SetDefault("alertas.webhook.timeout", 5*time.Second)
+	v.SetDefault("webhooks.enabled", true)
+	v.SetDefault("webhooks.intervalo", 5*time.Second)
+	v.SetDefault("webhooks.timeout", 10*time.Second)
+	v.SetDefault("webhooks.max_tentativas", 8)
+	v.SetDefault("webhooks.espera", 10*time.Second)
+	v.SetDefault("webhooks.espera_maxima", time.Hour)
+	v.SetDefault("webhooks.lote", 100)
+	v.SetDefault("webhooks.permitir_internos", false)
+	v.SetDefault("outbox.enabled", false)
+	v.SetDefault("outbox.broker", "memory")
+	v.SetDefault("outbox.intervalo", time.Second)
+	v.SetDefault("outbox.lote", 100)
+	v.SetDefault("outbox.retencao", 7*24*time.Hour)
+	v.SetDefault("outbox.max_tentativas", 10)
+	v.SetDefault("outbox.nats.url", "")
+	v.SetDefault("outbox.nats.prefixo", "produtos")
+	v.SetDefault("outbox.kafka.brokers", []string{})
+	v.SetDefault("outbox.kafka.topico", "produtos")
+	v.SetDefault("outbox.kafka.espera_lote", 10*time.Millisecond)
+	v.SetDefault("stream.enabled", true)
+	v.SetDefault("stream.buffer", 1000)
+	v.SetDefault("stream.pendentes", 256)
+	v.SetDefault("grpc.enabled", true)
+	v.SetDefault("grpc.port", ":5056")
+	v.SetDefault("graphql.enabled", true)
+	v.SetDefault("graphql.max_profundidade", 6)
+	v.SetDefault("graphql.max_complexidade", 5000)
+	v.SetDefault("precos.desconto_maximo", 0)
+	v.SetDefault("precos.variacao_maxima", 0)
+	v.SetDefault("precos.minimo", 0)
+	v.SetDefault("precos.maximo", 0)
+}
+
+// decode converte as configurações lidas para o modelo tipado, aplicando as
+// substituições das variaveis e validando os campos obrigatórios
+func decode(vmain *viper.Viper, replace func(string) string) (*Settings, error) {
+	setDefaults(vmain)
+
+	s := new(Settings)
+	if err := vmain.Unmarshal(s); err != nil {
+		return nil, fmt.Errorf("não consegui converter as configurações: %w", err)
+	}
+
+	// o driver em memória não usa a conexão, então a url não precisa ser resolvida
+	if s.Database.Driver == "memory" {
+		s.Database.Writer.URL = ""
+	}
+
+	var unresolved []string
+	replaceStrings(reflect.ValueOf(s).Elem(), func(value string) string {
+		value = replace(value)
+		for _, m := range placeholder.FindAllStringSubmatch(value, -1) {
+			unresolved = append(unresolved, m[1])
+		}
+		return value
+	})
+
+	if len(unresolved) > 0 {
+		return nil, unresolvedError(unresolved, s.Secrets.Dir)
+	}
+
+	if err := validateSettings(s); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// replaceStrings aplica a função de substituição em todos os campos string
+func replaceStrings(v reflect.Value, replace func(string) string) {
+	switch v.Kind() {
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			replaceStrings(v.Field(i), replace)
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			replaceStrings(v.Index(i), replace)
+		}
+	case reflect.String:
+		if v.CanSet() {
+			v.SetString(replace(v.String()))
+		}
+	}
+}
+
+var settingsValidator = func() *validator.Validate {
+	v := validator.New()
+	v.RegisterTagNameFunc(func(f reflect.StructField) string {
+		return strings.Split(f.Tag.Get("mapstructure"), ",")[0]
+	})
+	v.RegisterStructValidation(validateDatabase, DatabaseSettings{})
+	v.RegisterStructValidation(validateCache, CacheSettings{})
+	v.RegisterStructValidation(validateOutbox, OutboxSettings{})
+	return v
+}()
+
+// validateDatabase exige a url de conexão para todos os drivers, exceto o em memória
+func validateDatabase(sl validator.StructLevel) {
+	db := sl.Current().Interface().(DatabaseSettings)
+
+	if db.Driver != "memory" && db.Writer.URL == "" {
+		sl.ReportError(db.Writer.URL, "writer.url", "URL", "required", "")
+	}
+}
+
+// validateCache exige a url do servidor quando o backend for o redis, que também
+// guarda as chaves de idempotência
+func validateCache(sl validator.StructLevel) {
+	c := sl.Current().Interface().(CacheSettings)
+
+	if c.Backend == "redis" && c.Redis.URL == "" {
+		sl.ReportError(c.Redis.URL, "redis.url", "URL", "required", "")
+	}
+}
+
+// validateOutbox exige as conexões do broker configurado quando a outbox está habilitada
+func validateOutbox(sl validator.StructLevel) {
+	o := sl.Current().Interface().(OutboxSettings)
+	if !o.Enabled {
+		return
+	}
+
+	switch o.Broker {
+	case "nats":
+		if o.NATS.URL == "" {
+			sl.ReportError(o.NATS.URL, "nats.url", "URL", "required", "")
+		}
+	case "kafka":
+		if len(o.Kafka.Brokers) == 0 {
+			sl.ReportError(o.Kafka.Brokers, "kafka.brokers", "Brokers", "required", "")
+		}
+		if o.Kafka.Topico == "" {
+			sl.ReportError(o.Kafka.Topico, "kafka.topico", "Topico", "required", "")
+		}
+	}
+}
+
+// validateSettings valida as configurações e monta um erro com todos os campos inválidos
+func validateSettings(s *Settings) error {
+	err := settingsValidator.Struct(s)
+	if err == nil {
+		return nil
+	}
+
+	verrs, ok := err.(validator.ValidationErrors)
+	if !ok {
+		return err
+	}
+
+	msgs := make([]string, 0, len(verrs))
+	for _, fe := range verrs {
+		// remove o nome da struct raiz do namespace (Settings.server.port -> server.port)
+		key := fe.Namespace()[strings.Index(fe.Namespace(), ".")+1:]
+
+		switch fe.Tag() {
+		case "required", "required_if":
+			msgs = append(msgs, fmt.Sprintf("%s é obrigatório", key))
+		case "oneof":
+			msgs = append(msgs, fmt.Sprintf("%s deve ser um de [%s], recebido %q", key, fe.Param(), fe.Value()))
+		default:
+			msgs = append(msgs, fmt.Sprintf("%s inválido (%s=%s), recebido %v", key, fe.Tag(), fe.Param(), fe.Value()))
+		}
+	}
+
+	return fmt.Errorf("configuração inválida: %s", strings.Join(msgs, "; "))
+}
+
+// Load carrega e valida as configurações uma única vez, sem observar alterações,
+// para uso nos subcomandos
+func Load() (Settings, error) {
+	c, err := parse()
+	if err != nil {
+		return Settings{}, err
+	}
+
+	return *c.settings, nil
+}
+
+// Check carrega e valida as configurações, escrevendo no writer a configuração
+// efetiva com os segredos mascarados
+func Check(w io.Writer) error {
+	c, err := parse()
+	if err != nil {
+		return err
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(c.settings.Redacted())
+}
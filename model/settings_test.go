@@ -0,0 +1,156 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_decode(t *testing.T) {
+	mysql := map[string]interface{}{"database.writer.url": "root:senha@tcp(localhost:3306)/produtos"}
+
+	cases := map[string]struct {
+		Config  map[string]interface{}
+		Replace func(string) string
+
+		ExpectedErr string
+		Check       func(t *testing.T, s *Settings)
+	}{
+		"deve aplicar os valores padrão": {Config: mysql, Check: func(t *testing.T, s *Settings) {
+			assert.Equal(t, "local", s.Env)
+			assert.Equal(t, ":5055", s.Server.Port)
+			assert.Empty(t, s.Server.ProxiesConfiaveis)
+			assert.Equal(t, "mysql", s.Database.Driver)
+			assert.Equal(t, "lru", s.Cache.Backend)
+			assert.Equal(t, 10000, s.Cache.Size)
+			assert.Equal(t, time.Minute, s.Cache.TTL)
+			assert.Equal(t, 100000, s.RateLimit.MaxClientes)
+			assert.Equal(t, 24*time.Hour, s.Idempotency.TTL)
+			assert.Equal(t, time.Minute, s.Idempotency.Processamento)
+			assert.Equal(t, []string{"log"}, s.Alertas.Canais)
+			assert.Equal(t, 10, s.Outbox.MaxTentativas)
+			assert.Equal(t, 10*time.Millisecond, s.Outbox.Kafka.EsperaLote)
+			assert.Equal(t, ":5056", s.GRPC.Port)
+			assert.False(t, s.IsProduction())
+		}},
+		"deve converter as durações e sobrescrever os padrões": {Config: map[string]interface{}{
+			"tc":                  "prod",
+			"database.driver":     "postgres",
+			"database.writer.url": "host=db user=app password=senha dbname=produtos",
+			"cache.ttl":           "30s",
+			"webhooks.intervalo":  "1m",
+		}, Check: func(t *testing.T, s *Settings) {
+			assert.Equal(t, "postgres", s.Database.Driver)
+			assert.Equal(t, 30*time.Second, s.Cache.TTL)
+			assert.Equal(t, time.Minute, s.Webhooks.Intervalo)
+			assert.True(t, s.IsProduction())
+		}},
+		"não deve exigir a url com o driver em memória": {Config: map[string]interface{}{"database.driver": "memory", "database.writer.url": "$nao.usada$"}, Check: func(t *testing.T, s *Settings) {
+			assert.Empty(t, s.Database.Writer.URL)
+		}},
+		"deve resolver os placeholders": {Config: map[string]interface{}{"database.writer.url": "root:$db.senha$@tcp(localhost:3306)/produtos"}, Replace: func(v string) string {
+			return placeholder.ReplaceAllString(v, "segredo")
+		}, Check: func(t *testing.T, s *Settings) {
+			assert.Equal(t, "root:segredo@tcp(localhost:3306)/produtos", s.Database.Writer.URL)
+		}},
+		"deve exigir a url do banco":                 {Config: map[string]interface{}{}, ExpectedErr: "database.writer.url é obrigatório"},
+		"deve rejeitar o driver desconhecido":        {Config: map[string]interface{}{"database.driver": "oracle", "database.writer.url": "x"}, ExpectedErr: `database.driver deve ser um de [mysql postgres sqlite memory], recebido "oracle"`},
+		"deve rejeitar o nível de log desconhecido":  {Config: merge(mysql, map[string]interface{}{"log.level": "verbose"}), ExpectedErr: `log.level deve ser um de`},
+		"deve rejeitar o tamanho do cache zerado":    {Config: merge(mysql, map[string]interface{}{"cache.size": 0}), ExpectedErr: "cache.size inválido (gt=0), recebido 0"},
+		"deve rejeitar o proxy fora da notação CIDR": {Config: merge(mysql, map[string]interface{}{"server.proxies_confiaveis": []string{"10.0.0.1"}}), ExpectedErr: "server.proxies_confiaveis[0] inválido (cidr=), recebido 10.0.0.1"},
+		"deve exigir a url do redis":                 {Config: merge(mysql, map[string]interface{}{"cache.backend": "redis"}), ExpectedErr: "cache.redis.url é obrigatório"},
+		"deve exigir a url do nats":                  {Config: merge(mysql, map[string]interface{}{"outbox.enabled": true, "outbox.broker": "nats"}), ExpectedErr: "outbox.nats.url é obrigatório"},
+		"deve exigir os brokers do kafka":            {Config: merge(mysql, map[string]interface{}{"outbox.enabled": true, "outbox.broker": "kafka"}), ExpectedErr: "outbox.kafka.brokers é obrigatório"},
+		"não deve exigir o broker com a outbox desabilitada": {Config: merge(mysql, map[string]interface{}{"outbox.broker": "nats"}), Check: func(t *testing.T, s *Settings) {
+			assert.False(t, s.Outbox.Enabled)
+		}},
+		"deve exigir a porta do grpc habilitado": {Config: merge(mysql, map[string]interface{}{"grpc.port": ""}), ExpectedErr: "grpc.port é obrigatório"},
+		"deve listar todos os campos inválidos":  {Config: map[string]interface{}{"cache.size": -1, "precos.desconto_maximo": 120}, ExpectedErr: "database.writer.url é obrigatório; cache.size inválido (gt=0), recebido -1; precos.desconto_maximo inválido (lte=100), recebido 120"},
+		"deve retornar os placeholders sem valor": {Config: map[string]interface{}{"database.writer.url": "root:$db.senha$@tcp(localhost:3306)/produtos", "secrets.dir": "/run/secrets"},
+			ExpectedErr: "configuração inválida: placeholders sem valor: $db.senha$ (defina a variavel de ambiente DB_SENHA, DB_SENHA_FILE ou o arquivo /run/secrets/db.senha)"},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			v := viper.New()
+			for key, value := range cs.Config {
+				v.Set(key, value)
+			}
+
+			replace := cs.Replace
+			if replace == nil {
+				replace = func(v string) string { return v }
+			}
+
+			s, err := decode(v, replace)
+			if cs.ExpectedErr != "" {
+				if assert.Error(t, err) {
+					assert.Contains(t, err.Error(), cs.ExpectedErr)
+				}
+				return
+			}
+
+			if assert.NoError(t, err) {
+				cs.Check(t, s)
+			}
+		})
+	}
+}
+
+func Test_Redacted(t *testing.T) {
+	s := Settings{
+		Database: DatabaseSettings{Writer: DatabaseConnSettings{URL: "root:senha@tcp(localhost:3306)/produtos"}},
+		Remote:   RemoteSettings{Token: "token"},
+		Cache:    CacheSettings{Redis: RedisSettings{URL: "redis://:senha@localhost:6379/0"}},
+		Outbox:   OutboxSettings{NATS: NATSSettings{URL: "nats://localhost:4222"}},
+	}
+
+	redacted := s.Redacted()
+
+	assert.Equal(t, "root:******@tcp(localhost:3306)/produtos", redacted.Database.Writer.URL)
+	assert.Equal(t, "******", redacted.Remote.Token)
+	assert.Equal(t, "redis://:******@localhost:6379/0", redacted.Cache.Redis.URL)
+	assert.Equal(t, "nats://localhost:4222", redacted.Outbox.NATS.URL)
+	// os segredos vazios continuam vazios, para mostrar que não foram configurados
+	assert.Empty(t, redacted.Alertas.Webhook.URL)
+	// o original não é alterado
+	assert.Equal(t, "root:senha@tcp(localhost:3306)/produtos", s.Database.Writer.URL)
+	assert.Equal(t, "token", s.Remote.Token)
+}
+
+func Test_redactDSN(t *testing.T) {
+	cases := map[string]struct {
+		Input    string
+		Expected string
+	}{
+		"mysql":              {Input: "root:senha@tcp(localhost:3306)/produtos?parseTime=true", Expected: "root:******@tcp(localhost:3306)/produtos?parseTime=true"},
+		"url com esquema":    {Input: "postgres://app:senha@db:5432/produtos", Expected: "postgres://app:******@db:5432/produtos"},
+		"senha com arroba":   {Input: "root:s@nh@@tcp(localhost:3306)/produtos", Expected: "root:******@tcp(localhost:3306)/produtos"},
+		"usuário sem senha":  {Input: "postgres://app@db:5432/produtos", Expected: "postgres://app@db:5432/produtos"},
+		"redis sem usuário":  {Input: "redis://:senha@localhost:6379", Expected: "redis://:******@localhost:6379"},
+		"chave=valor":        {Input: "host=db user=app password=senha dbname=produtos", Expected: "host=db user=app password=****** dbname=produtos"},
+		"parâmetro de query": {Input: "postgres://db/produtos?user=app&password=senha&sslmode=disable", Expected: "postgres://db/produtos?user=app&password=******&sslmode=disable"},
+		"sem credenciais":    {Input: "nats://localhost:4222", Expected: "nats://localhost:4222"},
+		"arquivo":            {Input: "file:/tmp/produtos.db", Expected: "file:/tmp/produtos.db"},
+		"vazio":              {Input: "", Expected: ""},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, cs.Expected, redactDSN(cs.Input))
+		})
+	}
+}
+
+func merge(configs ...map[string]interface{}) map[string]interface{} {
+	merged := map[string]interface{}{}
+	for _, config := range configs {
+		for key, value := range config {
+			merged[key] = value
+		}
+	}
+
+	return merged
+}
@@ -0,0 +1,79 @@
+package model
+
+import (
+	"errors"
+	"strings"
+)
+
+// Variacao SKU de um produto definido pelos seus atributos, com preço e estoque
+// próprios. O estoque do produto pai passa a ser a soma das suas variações
+type Variacao struct {
+	SKU               string  `json:"sku,omitempty" gorm:"column:sku;primary_key"`
+	ProdutoCodigo     string  `json:"produto,omitempty" gorm:"size:64;index;not null"`
+	Tamanho           string  `json:"tamanho,omitempty" gorm:"size:64"`
+	Cor               string  `json:"cor,omitempty" gorm:"size:64"`
+	Voltagem          string  `json:"voltagem,omitempty" gorm:"size:64"`
+	PrecoDe           float64 `json:"preco_de,omitempty" gorm:"not null"`
+	PrecoPor          float64 `json:"preco_por,omitempty" gorm:"not null"`
+	EstoqueTotal      int64   `json:"estoque_total,omitempty" gorm:"not null"`
+	EstoqueCorte      int64   `json:"estoque_corte,omitempty" gorm:"not null"`
+	EstoqueDisponivel int64   `json:"estoque_disponivel,omitempty" gorm:"not null"`
+	CriadoEm          string  `json:"criado_em,omitempty" gorm:"not null"`
+	UltimaAlteracao   string  `json:"ultima_alteracao,omitempty" gorm:"not null"`
+}
+
+func (Variacao) TableName() string {
+	return "variacoes"
+}
+
+// PreSave gera o SKU quando ele não é informado e calcula o estoque disponível
+func (me *Variacao) PreSave() {
+	if strings.TrimSpace(me.SKU) == "" {
+		me.SKU = NewId()
+	}
+	me.EstoqueDisponivel = me.EstoqueTotal - me.EstoqueCorte
+}
+
+func (me *Variacao) Validate() error {
+	me.SKU = strings.TrimSpace(me.SKU)
+	me.Tamanho = strings.TrimSpace(me.Tamanho)
+	me.Cor = strings.TrimSpace(me.Cor)
+	me.Voltagem = strings.TrimSpace(me.Voltagem)
+
+	if me.Tamanho == "" && me.Cor == "" && me.Voltagem == "" {
+		return errors.New("variação deve ter ao menos um atributo: tamanho, cor ou voltagem")
+	}
+
+	if me.PrecoDe < me.PrecoPor {
+		return ErrPrecoDeInferior
+	}
+
+	if me.EstoqueTotal < me.EstoqueCorte {
+		return ErrEstoqueIndisponivel
+	}
+
+	return nil
+}
+
+// MesmosAtributos indica se as duas variações possuem a mesma combinação de
+// atributos, sem diferenciar maiúsculas e minúsculas
+func (me *Variacao) MesmosAtributos(outra Variacao) bool {
+	return strings.EqualFold(me.Tamanho, outra.Tamanho) &&
+		strings.EqualFold(me.Cor, outra.Cor) &&
+		strings.EqualFold(me.Voltagem, outra.Voltagem)
+}
+
+// AgregarEstoque substitui o estoque do produto pela soma do estoque das variações.
+// Sem variações o estoque do próprio produto é mantido
+func AgregarEstoque(produto *Produto, variacoes []Variacao) {
+	if len(variacoes) == 0 {
+		return
+	}
+
+	produto.EstoqueTotal, produto.EstoqueCorte, produto.EstoqueDisponivel = 0, 0, 0
+	for _, v := range variacoes {
+		produto.EstoqueTotal += v.EstoqueTotal
+		produto.EstoqueCorte += v.EstoqueCorte
+		produto.EstoqueDisponivel += v.EstoqueTotal - v.EstoqueCorte
+	}
+}
@@ -0,0 +1,201 @@
+package model
+
+import (
+	"crypto/rand"
+	"database/sql/driver"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+)
+
+// ErrDestinoInterno erro retornado quando o destino do webhook é um endereço da
+// própria rede (loopback, link-local ou privado)
+var ErrDestinoInterno = errors.New("url do webhook não pode apontar para um endereço interno")
+
+// Eventos de produto enviados aos webhooks
+const (
+	EventoProdutoCriado   = "produto.criado"
+	EventoProdutoAlterado = "produto.alterado"
+	EventoProdutoRemovido = "produto.removido"
+	EventoEstoqueAlterado = "estoque.alterado"
+)
+
+// Eventos lista dos eventos que podem ser assinados
+var Eventos = []string{EventoProdutoCriado, EventoProdutoAlterado, EventoProdutoRemovido, EventoEstoqueAlterado}
+
+// Situações de uma entrega
+const (
+	EntregaPendente = "pendente"
+	EntregaEntregue = "entregue"
+	// EntregaFalha entrega que esgotou as tentativas, só é reenviada manualmente
+	EntregaFalha = "falha"
+)
+
+// Webhook assinatura de um sistema externo para receber os eventos de produto
+type Webhook struct {
+	Codigo          string       `json:"codigo,omitempty" gorm:"primary_key"`
+	URL             string       `json:"url,omitempty" gorm:"size:2048;not null"`
+	Segredo         string       `json:"segredo,omitempty" gorm:"size:128;not null"`
+	Eventos         ListaEventos `json:"eventos,omitempty" gorm:"size:255;not null"`
+	Inativo         bool         `json:"inativo" gorm:"not null"`
+	CriadoEm        string       `json:"criado_em,omitempty" gorm:"not null"`
+	UltimaAlteracao string       `json:"ultima_alteracao,omitempty" gorm:"not null"`
+}
+
+// Evento corpo enviado aos webhooks
+type Evento struct {
+	Codigo     string      `json:"codigo"`
+	Tipo       string      `json:"tipo"`
+	Produto    string      `json:"produto"`
+	OcorridoEm string      `json:"ocorrido_em"`
+	Dados      interface{} `json:"dados,omitempty"`
+}
+
+// EstoqueAlterado indica se o estoque do produto mudou entre as duas versões
+func EstoqueAlterado(anterior, atual *Produto) bool {
+	return anterior.EstoqueTotal != atual.EstoqueTotal ||
+		anterior.EstoqueCorte != atual.EstoqueCorte ||
+		anterior.EstoqueDisponivel != atual.EstoqueDisponivel
+}
+
+// Entrega envio de um evento para um webhook, com o histórico das tentativas
+type Entrega struct {
+	Codigo           string `json:"codigo" gorm:"primary_key"`
+	WebhookCodigo    string `json:"webhook" gorm:"size:64;index;not null"`
+	Evento           string `json:"evento" gorm:"size:64;not null"`
+	Produto          string `json:"produto" gorm:"size:64"`
+	Payload          string `json:"payload" gorm:"type:text;not null"`
+	Status           string `json:"status" gorm:"size:16;index;not null"`
+	Tentativas       int    `json:"tentativas" gorm:"not null"`
+	ProximaTentativa int64  `json:"proxima_tentativa,omitempty" gorm:"index;not null"`
+	UltimoStatus     int    `json:"ultimo_status,omitempty"`
+	UltimoErro       string `json:"ultimo_erro,omitempty" gorm:"size:1024"`
+	CriadoEm         string `json:"criado_em,omitempty" gorm:"not null"`
+	UltimaAlteracao  string `json:"ultima_alteracao,omitempty" gorm:"not null"`
+}
+
+// FiltroEntrega filtros da listagem de entregas, campos vazios não filtram
+type FiltroEntrega struct {
+	Webhook string
+	Status  string
+}
+
+func (Webhook) TableName() string {
+	return "webhooks"
+}
+
+func (Entrega) TableName() string {
+	return "webhook_entregas"
+}
+
+// PreSave gera o codigo e, quando não informado, o segredo usado nas assinaturas
+func (me *Webhook) PreSave() {
+	me.Codigo = NewId()
+
+	if strings.TrimSpace(me.Segredo) == "" {
+		b := make([]byte, 32)
+		rand.Read(b)
+		me.Segredo = hex.EncodeToString(b)
+	}
+}
+
+func (me *Webhook) Validate() error {
+	me.URL = strings.TrimSpace(me.URL)
+
+	u, err := url.Parse(me.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errors.New("url do webhook deve ser http ou https")
+	}
+
+	if len(me.Eventos) == 0 {
+		return errors.New("webhook deve assinar ao menos um evento")
+	}
+
+	for _, evento := range me.Eventos {
+		if !eventoValido(evento) {
+			return fmt.Errorf("evento não suportado: %s", evento)
+		}
+	}
+
+	return nil
+}
+
+// ValidarDestino rejeita as urls cujo host é um endereço interno. Os nomes só são
+// resolvidos na entrega, onde o endereço também é verificado
+func (me *Webhook) ValidarDestino() error {
+	u, err := url.Parse(me.URL)
+	if err != nil {
+		return err
+	}
+
+	host := strings.ToLower(strings.TrimSuffix(u.Hostname(), "."))
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return ErrDestinoInterno
+	}
+
+	if ip := net.ParseIP(host); ip != nil && EnderecoInterno(ip) {
+		return ErrDestinoInterno
+	}
+
+	return nil
+}
+
+// EnderecoInterno indica se o ip é de loopback, link-local, privado ou não
+// especificado, endereços que não devem ser alcançados pelos webhooks
+func EnderecoInterno(ip net.IP) bool {
+	return ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast()
+}
+
+// Assina indica se o webhook recebe o evento informado
+func (me *Webhook) Assina(evento string) bool {
+	for _, e := range me.Eventos {
+		if e == evento {
+			return true
+		}
+	}
+
+	return false
+}
+
+func eventoValido(evento string) bool {
+	for _, e := range Eventos {
+		if e == evento {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ListaEventos eventos assinados, gravados no banco separados por vírgula
+type ListaEventos []string
+
+func (l ListaEventos) Value() (driver.Value, error) {
+	return strings.Join(l, ","), nil
+}
+
+func (l *ListaEventos) Scan(value interface{}) error {
+	var s string
+	switch v := value.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	case nil:
+	default:
+		return fmt.Errorf("tipo inválido para a lista de eventos: %T", value)
+	}
+
+	*l = nil
+	for _, e := range strings.Split(s, ",") {
+		if e = strings.TrimSpace(e); e != "" {
+			*l = append(*l, e)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func Test_ValidarDestino(t *testing.T) {
+	cases := map[string]struct {
+		URL         string
+		ExpectedErr error
+	}{
+		"deve aceitar o host público":               {URL: "https://erp.exemplo.com.br/hook"},
+		"deve aceitar o ip público":                 {URL: "http://203.0.113.10:8080/hook"},
+		"deve rejeitar o localhost":                 {URL: "http://localhost:5055/produtos", ExpectedErr: model.ErrDestinoInterno},
+		"deve rejeitar o subdomínio local":          {URL: "http://api.localhost./hook", ExpectedErr: model.ErrDestinoInterno},
+		"deve rejeitar o loopback":                  {URL: "http://127.0.0.2/hook", ExpectedErr: model.ErrDestinoInterno},
+		"deve rejeitar o loopback ipv6":             {URL: "http://[::1]:8080/hook", ExpectedErr: model.ErrDestinoInterno},
+		"deve rejeitar o link-local":                {URL: "http://169.254.169.254/latest/meta-data", ExpectedErr: model.ErrDestinoInterno},
+		"deve rejeitar a rede privada":              {URL: "https://10.0.0.5/hook", ExpectedErr: model.ErrDestinoInterno},
+		"deve rejeitar a rede privada ipv6":         {URL: "https://[fd00::1]/hook", ExpectedErr: model.ErrDestinoInterno},
+		"deve rejeitar o ipv4 mapeado":              {URL: "http://[::ffff:192.168.0.1]/hook", ExpectedErr: model.ErrDestinoInterno},
+		"deve rejeitar o endereço não especificado": {URL: "http://0.0.0.0/hook", ExpectedErr: model.ErrDestinoInterno},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := (&model.Webhook{URL: cs.URL}).ValidarDestino()
+
+			if diff := cmp.Diff(err, cs.ExpectedErr, cmpopts.EquateErrors()); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,173 @@
+package notificacao
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/GianGoulart/CrudProdutos/logger"
+	"github.com/GianGoulart/CrudProdutos/model"
+)
+
+// Canais de notificação suportados
+const (
+	CanalLog     = "log"
+	CanalWebhook = "webhook"
+)
+
+// Canal destino das notificações dos alertas de estoque. Novos canais (e-mail,
+// chat, fila) só precisam implementar essa interface
+type Canal interface {
+	Nome() string
+	Notificar(ctx context.Context, alerta model.Alerta) error
+}
+
+// INotificador interface para o envio dos alertas aos canais configurados
+type INotificador interface {
+	Notificar(ctx context.Context, alerta model.Alerta)
+	Wait()
+}
+
+// Options struct de opções para a criação do notificador
+type Options struct {
+	Canais []string
+
+	WebhookURL string
+	Timeout    time.Duration
+}
+
+// New cria o notificador com os canais configurados
+func New(opts Options) (*Notificador, error) {
+	canais := make([]Canal, 0, len(opts.Canais))
+
+	for _, nome := range opts.Canais {
+		switch nome {
+		case CanalLog:
+			canais = append(canais, NewLog())
+		case CanalWebhook:
+			if opts.WebhookURL == "" {
+				return nil, fmt.Errorf("canal %s sem url configurada", CanalWebhook)
+			}
+			canais = append(canais, NewWebhook(opts.WebhookURL))
+		default:
+			return nil, fmt.Errorf("canal de notificação não suportado: %s", nome)
+		}
+	}
+
+	return NewNotificador(opts.Timeout, canais...), nil
+}
+
+// Notificador envia cada alerta para todos os canais em segundo plano, para não
+// atrasar a operação que gerou o alerta. A falha de um canal não afeta os outros
+type Notificador struct {
+	canais  []Canal
+	timeout time.Duration
+
+	wg sync.WaitGroup
+}
+
+// NewNotificador cria o notificador com os canais informados
+func NewNotificador(timeout time.Duration, canais ...Canal) *Notificador {
+	return &Notificador{
+		canais:  canais,
+		timeout: timeout,
+	}
+}
+
+func (n *Notificador) Notificar(ctx context.Context, alerta model.Alerta) {
+	log := logger.FromContext(ctx).WithField("alerta", alerta.Codigo).WithField("produto", alerta.ProdutoCodigo)
+
+	for _, canal := range n.canais {
+		n.wg.Add(1)
+
+		go func(canal Canal) {
+			defer n.wg.Done()
+
+			// o envio sobrevive ao fim da requisição, mas não passa do timeout
+			ctx, cancel := context.WithTimeout(logger.WithContext(context.Background(), log), n.timeout)
+			defer cancel()
+
+			if err := canal.Notificar(ctx, alerta); err != nil {
+				log.WithError(err).WithField("canal", canal.Nome()).Error("notificacao.Notificar")
+			}
+		}(canal)
+	}
+}
+
+// Wait aguarda o fim dos envios em andamento
+func (n *Notificador) Wait() {
+	n.wg.Wait()
+}
+
+// NewLog cria o canal que registra os alertas no log da aplicação
+func NewLog() Canal {
+	return logImpl{}
+}
+
+type logImpl struct{}
+
+func (logImpl) Nome() string {
+	return CanalLog
+}
+
+func (logImpl) Notificar(ctx context.Context, alerta model.Alerta) error {
+	logger.FromContext(ctx).
+		WithField("nivel", alerta.Nivel).
+		WithField("estoque_disponivel", alerta.EstoqueDisponivel).
+		WithField("limite", alerta.Limite).
+		Warn("alerta de estoque")
+
+	return nil
+}
+
+// NewWebhook cria o canal que envia os alertas em json para a url informada
+func NewWebhook(url string) Canal {
+	return &webhookImpl{
+		url:    url,
+		client: &http.Client{},
+	}
+}
+
+type webhookImpl struct {
+	url    string
+	client *http.Client
+}
+
+// evento corpo enviado ao webhook
+type evento struct {
+	Tipo   string       `json:"tipo"`
+	Alerta model.Alerta `json:"alerta"`
+}
+
+func (w *webhookImpl) Nome() string {
+	return CanalWebhook
+}
+
+func (w *webhookImpl) Notificar(ctx context.Context, alerta model.Alerta) error {
+	body, err := json.Marshal(evento{Tipo: "estoque.alerta", Alerta: alerta})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook respondeu com status %d", resp.StatusCode)
+	}
+
+	return nil
+}
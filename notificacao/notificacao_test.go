@@ -0,0 +1,84 @@
+package notificacao_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/GianGoulart/CrudProdutos/notificacao"
+	"github.com/stretchr/testify/assert"
+)
+
+type canalFake struct {
+	mu      sync.Mutex
+	err     error
+	alertas []model.Alerta
+}
+
+func (c *canalFake) Nome() string { return "fake" }
+
+func (c *canalFake) Notificar(ctx context.Context, alerta model.Alerta) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.alertas = append(c.alertas, alerta)
+	return c.err
+}
+
+func Test_Notificador(t *testing.T) {
+	falha := &canalFake{err: errors.New("fora do ar")}
+	ok := &canalFake{}
+
+	n := notificacao.NewNotificador(time.Second, falha, ok)
+	n.Notificar(context.Background(), model.Alerta{Codigo: "a1", ProdutoCodigo: "p1"})
+	n.Wait()
+
+	// a falha de um canal não impede o envio para os outros
+	assert.Len(t, falha.alertas, 1)
+	assert.Len(t, ok.alertas, 1)
+}
+
+func Test_Webhook(t *testing.T) {
+	cases := map[string]struct {
+		Status      int
+		ExpectedErr bool
+	}{
+		"deve enviar o alerta":              {Status: http.StatusNoContent},
+		"deve retornar erro com status 5xx": {Status: http.StatusBadGateway, ExpectedErr: true},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			var recebido map[string]interface{}
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&recebido))
+				w.WriteHeader(cs.Status)
+			}))
+			defer srv.Close()
+
+			err := notificacao.NewWebhook(srv.URL).Notificar(context.Background(), model.Alerta{Codigo: "a1", ProdutoCodigo: "p1", Nivel: model.AlertaEstoqueBaixo})
+
+			assert.Equal(t, cs.ExpectedErr, err != nil)
+			assert.Equal(t, "estoque.alerta", recebido["tipo"])
+		})
+	}
+}
+
+func Test_New(t *testing.T) {
+	_, err := notificacao.New(notificacao.Options{Canais: []string{notificacao.CanalLog}})
+	assert.NoError(t, err)
+
+	_, err = notificacao.New(notificacao.Options{Canais: []string{notificacao.CanalWebhook}})
+	assert.Error(t, err)
+
+	_, err = notificacao.New(notificacao.Options{Canais: []string{"pombo"}})
+	assert.Error(t, err)
+}
@@ -0,0 +1,947 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.19.4
+// source: produto.proto
+
+package produtopb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Produto struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Codigo            string      `protobuf:"bytes,1,opt,name=codigo,proto3" json:"codigo,omitempty"`
+	Nome              string      `protobuf:"bytes,2,opt,name=nome,proto3" json:"nome,omitempty"`
+	PrecoDe           float64     `protobuf:"fixed64,3,opt,name=preco_de,json=precoDe,proto3" json:"preco_de,omitempty"`
+	PrecoPor          float64     `protobuf:"fixed64,4,opt,name=preco_por,json=precoPor,proto3" json:"preco_por,omitempty"`
+	CriadoEm          string      `protobuf:"bytes,5,opt,name=criado_em,json=criadoEm,proto3" json:"criado_em,omitempty"`
+	UltimaAlteracao   string      `protobuf:"bytes,6,opt,name=ultima_alteracao,json=ultimaAlteracao,proto3" json:"ultima_alteracao,omitempty"`
+	EstoqueTotal      int64       `protobuf:"varint,7,opt,name=estoque_total,json=estoqueTotal,proto3" json:"estoque_total,omitempty"`
+	EstoqueCorte      int64       `protobuf:"varint,8,opt,name=estoque_corte,json=estoqueCorte,proto3" json:"estoque_corte,omitempty"`
+	EstoqueDisponivel int64       `protobuf:"varint,9,opt,name=estoque_disponivel,json=estoqueDisponivel,proto3" json:"estoque_disponivel,omitempty"`
+	Marca             string      `protobuf:"bytes,10,opt,name=marca,proto3" json:"marca,omitempty"`
+	LimiteAlerta      int64       `protobuf:"varint,11,opt,name=limite_alerta,json=limiteAlerta,proto3" json:"limite_alerta,omitempty"`
+	Variacoes         []*Variacao `protobuf:"bytes,12,rep,name=variacoes,proto3" json:"variacoes,omitempty"`
+}
+
+func (x *Produto) Reset() {
+	*x = Produto{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_produto_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Produto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Produto) ProtoMessage() {}
+
+func (x *Produto) ProtoReflect() protoreflect.Message {
+	mi := &file_produto_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Produto.ProtoReflect.Descriptor instead.
+func (*Produto) Descriptor() ([]byte, []int) {
+	return file_produto_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Produto) GetCodigo() string {
+	if x != nil {
+		return x.Codigo
+	}
+	return ""
+}
+
+func (x *Produto) GetNome() string {
+	if x != nil {
+		return x.Nome
+	}
+	return ""
+}
+
+func (x *Produto) GetPrecoDe() float64 {
+	if x != nil {
+		return x.PrecoDe
+	}
+	return 0
+}
+
+func (x *Produto) GetPrecoPor() float64 {
+	if x != nil {
+		return x.PrecoPor
+	}
+	return 0
+}
+
+func (x *Produto) GetCriadoEm() string {
+	if x != nil {
+		return x.CriadoEm
+	}
+	return ""
+}
+
+func (x *Produto) GetUltimaAlteracao() string {
+	if x != nil {
+		return x.UltimaAlteracao
+	}
+	return ""
+}
+
+func (x *Produto) GetEstoqueTotal() int64 {
+	if x != nil {
+		return x.EstoqueTotal
+	}
+	return 0
+}
+
+func (x *Produto) GetEstoqueCorte() int64 {
+	if x != nil {
+		return x.EstoqueCorte
+	}
+	return 0
+}
+
+func (x *Produto) GetEstoqueDisponivel() int64 {
+	if x != nil {
+		return x.EstoqueDisponivel
+	}
+	return 0
+}
+
+func (x *Produto) GetMarca() string {
+	if x != nil {
+		return x.Marca
+	}
+	return ""
+}
+
+func (x *Produto) GetLimiteAlerta() int64 {
+	if x != nil {
+		return x.LimiteAlerta
+	}
+	return 0
+}
+
+func (x *Produto) GetVariacoes() []*Variacao {
+	if x != nil {
+		return x.Variacoes
+	}
+	return nil
+}
+
+type Variacao struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sku               string  `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	Produto           string  `protobuf:"bytes,2,opt,name=produto,proto3" json:"produto,omitempty"`
+	Tamanho           string  `protobuf:"bytes,3,opt,name=tamanho,proto3" json:"tamanho,omitempty"`
+	Cor               string  `protobuf:"bytes,4,opt,name=cor,proto3" json:"cor,omitempty"`
+	Voltagem          string  `protobuf:"bytes,5,opt,name=voltagem,proto3" json:"voltagem,omitempty"`
+	PrecoDe           float64 `protobuf:"fixed64,6,opt,name=preco_de,json=precoDe,proto3" json:"preco_de,omitempty"`
+	PrecoPor          float64 `protobuf:"fixed64,7,opt,name=preco_por,json=precoPor,proto3" json:"preco_por,omitempty"`
+	EstoqueTotal      int64   `protobuf:"varint,8,opt,name=estoque_total,json=estoqueTotal,proto3" json:"estoque_total,omitempty"`
+	EstoqueCorte      int64   `protobuf:"varint,9,opt,name=estoque_corte,json=estoqueCorte,proto3" json:"estoque_corte,omitempty"`
+	EstoqueDisponivel int64   `protobuf:"varint,10,opt,name=estoque_disponivel,json=estoqueDisponivel,proto3" json:"estoque_disponivel,omitempty"`
+	CriadoEm          string  `protobuf:"bytes,11,opt,name=criado_em,json=criadoEm,proto3" json:"criado_em,omitempty"`
+	UltimaAlteracao   string  `protobuf:"bytes,12,opt,name=ultima_alteracao,json=ultimaAlteracao,proto3" json:"ultima_alteracao,omitempty"`
+}
+
+func (x *Variacao) Reset() {
+	*x = Variacao{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_produto_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Variacao) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Variacao) ProtoMessage() {}
+
+func (x *Variacao) ProtoReflect() protoreflect.Message {
+	mi := &file_produto_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Variacao.ProtoReflect.Descriptor instead.
+func (*Variacao) Descriptor() ([]byte, []int) {
+	return file_produto_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Variacao) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *Variacao) GetProduto() string {
+	if x != nil {
+		return x.Produto
+	}
+	return ""
+}
+
+func (x *Variacao) GetTamanho() string {
+	if x != nil {
+		return x.Tamanho
+	}
+	return ""
+}
+
+func (x *Variacao) GetCor() string {
+	if x != nil {
+		return x.Cor
+	}
+	return ""
+}
+
+func (x *Variacao) GetVoltagem() string {
+	if x != nil {
+		return x.Voltagem
+	}
+	return ""
+}
+
+func (x *Variacao) GetPrecoDe() float64 {
+	if x != nil {
+		return x.PrecoDe
+	}
+	return 0
+}
+
+func (x *Variacao) GetPrecoPor() float64 {
+	if x != nil {
+		return x.PrecoPor
+	}
+	return 0
+}
+
+func (x *Variacao) GetEstoqueTotal() int64 {
+	if x != nil {
+		return x.EstoqueTotal
+	}
+	return 0
+}
+
+func (x *Variacao) GetEstoqueCorte() int64 {
+	if x != nil {
+		return x.EstoqueCorte
+	}
+	return 0
+}
+
+func (x *Variacao) GetEstoqueDisponivel() int64 {
+	if x != nil {
+		return x.EstoqueDisponivel
+	}
+	return 0
+}
+
+func (x *Variacao) GetCriadoEm() string {
+	if x != nil {
+		return x.CriadoEm
+	}
+	return ""
+}
+
+func (x *Variacao) GetUltimaAlteracao() string {
+	if x != nil {
+		return x.UltimaAlteracao
+	}
+	return ""
+}
+
+// ListProdutosRequest filtros da listagem, a categoria inclui as descendentes
+type ListProdutosRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Categoria  string `protobuf:"bytes,1,opt,name=categoria,proto3" json:"categoria,omitempty"`
+	Marca      string `protobuf:"bytes,2,opt,name=marca,proto3" json:"marca,omitempty"`
+	Fornecedor string `protobuf:"bytes,3,opt,name=fornecedor,proto3" json:"fornecedor,omitempty"`
+}
+
+func (x *ListProdutosRequest) Reset() {
+	*x = ListProdutosRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_produto_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListProdutosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProdutosRequest) ProtoMessage() {}
+
+func (x *ListProdutosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_produto_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProdutosRequest.ProtoReflect.Descriptor instead.
+func (*ListProdutosRequest) Descriptor() ([]byte, []int) {
+	return file_produto_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListProdutosRequest) GetCategoria() string {
+	if x != nil {
+		return x.Categoria
+	}
+	return ""
+}
+
+func (x *ListProdutosRequest) GetMarca() string {
+	if x != nil {
+		return x.Marca
+	}
+	return ""
+}
+
+func (x *ListProdutosRequest) GetFornecedor() string {
+	if x != nil {
+		return x.Fornecedor
+	}
+	return ""
+}
+
+type ListProdutosResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Produtos []*Produto `protobuf:"bytes,1,rep,name=produtos,proto3" json:"produtos,omitempty"`
+}
+
+func (x *ListProdutosResponse) Reset() {
+	*x = ListProdutosResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_produto_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListProdutosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProdutosResponse) ProtoMessage() {}
+
+func (x *ListProdutosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_produto_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProdutosResponse.ProtoReflect.Descriptor instead.
+func (*ListProdutosResponse) Descriptor() ([]byte, []int) {
+	return file_produto_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListProdutosResponse) GetProdutos() []*Produto {
+	if x != nil {
+		return x.Produtos
+	}
+	return nil
+}
+
+type GetProdutoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Codigo string `protobuf:"bytes,1,opt,name=codigo,proto3" json:"codigo,omitempty"`
+}
+
+func (x *GetProdutoRequest) Reset() {
+	*x = GetProdutoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_produto_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetProdutoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProdutoRequest) ProtoMessage() {}
+
+func (x *GetProdutoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_produto_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProdutoRequest.ProtoReflect.Descriptor instead.
+func (*GetProdutoRequest) Descriptor() ([]byte, []int) {
+	return file_produto_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetProdutoRequest) GetCodigo() string {
+	if x != nil {
+		return x.Codigo
+	}
+	return ""
+}
+
+type GetProdutosByNomeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Nome string `protobuf:"bytes,1,opt,name=nome,proto3" json:"nome,omitempty"`
+}
+
+func (x *GetProdutosByNomeRequest) Reset() {
+	*x = GetProdutosByNomeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_produto_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetProdutosByNomeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProdutosByNomeRequest) ProtoMessage() {}
+
+func (x *GetProdutosByNomeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_produto_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProdutosByNomeRequest.ProtoReflect.Descriptor instead.
+func (*GetProdutosByNomeRequest) Descriptor() ([]byte, []int) {
+	return file_produto_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetProdutosByNomeRequest) GetNome() string {
+	if x != nil {
+		return x.Nome
+	}
+	return ""
+}
+
+type CreateProdutoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Produto *Produto `protobuf:"bytes,1,opt,name=produto,proto3" json:"produto,omitempty"`
+}
+
+func (x *CreateProdutoRequest) Reset() {
+	*x = CreateProdutoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_produto_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateProdutoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProdutoRequest) ProtoMessage() {}
+
+func (x *CreateProdutoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_produto_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProdutoRequest.ProtoReflect.Descriptor instead.
+func (*CreateProdutoRequest) Descriptor() ([]byte, []int) {
+	return file_produto_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateProdutoRequest) GetProduto() *Produto {
+	if x != nil {
+		return x.Produto
+	}
+	return nil
+}
+
+type UpdateProdutoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Produto *Produto `protobuf:"bytes,1,opt,name=produto,proto3" json:"produto,omitempty"`
+}
+
+func (x *UpdateProdutoRequest) Reset() {
+	*x = UpdateProdutoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_produto_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateProdutoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProdutoRequest) ProtoMessage() {}
+
+func (x *UpdateProdutoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_produto_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProdutoRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProdutoRequest) Descriptor() ([]byte, []int) {
+	return file_produto_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateProdutoRequest) GetProduto() *Produto {
+	if x != nil {
+		return x.Produto
+	}
+	return nil
+}
+
+type DeleteProdutoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Codigo string `protobuf:"bytes,1,opt,name=codigo,proto3" json:"codigo,omitempty"`
+}
+
+func (x *DeleteProdutoRequest) Reset() {
+	*x = DeleteProdutoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_produto_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteProdutoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProdutoRequest) ProtoMessage() {}
+
+func (x *DeleteProdutoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_produto_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProdutoRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProdutoRequest) Descriptor() ([]byte, []int) {
+	return file_produto_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteProdutoRequest) GetCodigo() string {
+	if x != nil {
+		return x.Codigo
+	}
+	return ""
+}
+
+var File_produto_proto protoreflect.FileDescriptor
+
+var file_produto_proto_rawDesc = []byte{
+	0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x74, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x74, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x22, 0x9e, 0x03, 0x0a,
+	0x07, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x74, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x64, 0x69,
+	0x67, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x64, 0x69, 0x67, 0x6f,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x6f, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x63, 0x6f, 0x5f, 0x64, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x70, 0x72, 0x65, 0x63, 0x6f, 0x44, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x70, 0x72, 0x65, 0x63, 0x6f, 0x5f, 0x70, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x08, 0x70, 0x72, 0x65, 0x63, 0x6f, 0x50, 0x6f, 0x72, 0x12, 0x1b, 0x0a, 0x09,
+	0x63, 0x72, 0x69, 0x61, 0x64, 0x6f, 0x5f, 0x65, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x63, 0x72, 0x69, 0x61, 0x64, 0x6f, 0x45, 0x6d, 0x12, 0x29, 0x0a, 0x10, 0x75, 0x6c, 0x74,
+	0x69, 0x6d, 0x61, 0x5f, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x61, 0x63, 0x61, 0x6f, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x75, 0x6c, 0x74, 0x69, 0x6d, 0x61, 0x41, 0x6c, 0x74, 0x65, 0x72,
+	0x61, 0x63, 0x61, 0x6f, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x73, 0x74, 0x6f, 0x71, 0x75, 0x65, 0x5f,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x65, 0x73, 0x74,
+	0x6f, 0x71, 0x75, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x73, 0x74,
+	0x6f, 0x71, 0x75, 0x65, 0x5f, 0x63, 0x6f, 0x72, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0c, 0x65, 0x73, 0x74, 0x6f, 0x71, 0x75, 0x65, 0x43, 0x6f, 0x72, 0x74, 0x65, 0x12, 0x2d,
+	0x0a, 0x12, 0x65, 0x73, 0x74, 0x6f, 0x71, 0x75, 0x65, 0x5f, 0x64, 0x69, 0x73, 0x70, 0x6f, 0x6e,
+	0x69, 0x76, 0x65, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x65, 0x73, 0x74, 0x6f,
+	0x71, 0x75, 0x65, 0x44, 0x69, 0x73, 0x70, 0x6f, 0x6e, 0x69, 0x76, 0x65, 0x6c, 0x12, 0x14, 0x0a,
+	0x05, 0x6d, 0x61, 0x72, 0x63, 0x61, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x61,
+	0x72, 0x63, 0x61, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x5f, 0x61, 0x6c,
+	0x65, 0x72, 0x74, 0x61, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x61, 0x12, 0x33, 0x0a, 0x09, 0x76, 0x61, 0x72, 0x69,
+	0x61, 0x63, 0x6f, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x74, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x63,
+	0x61, 0x6f, 0x52, 0x09, 0x76, 0x61, 0x72, 0x69, 0x61, 0x63, 0x6f, 0x65, 0x73, 0x22, 0xf7, 0x02,
+	0x0a, 0x08, 0x56, 0x61, 0x72, 0x69, 0x61, 0x63, 0x61, 0x6f, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x6b,
+	0x75, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x6b, 0x75, 0x12, 0x18, 0x0a, 0x07,
+	0x70, 0x72, 0x6f, 0x64, 0x75, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70,
+	0x72, 0x6f, 0x64, 0x75, 0x74, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x61, 0x6d, 0x61, 0x6e, 0x68,
+	0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x61, 0x6d, 0x61, 0x6e, 0x68, 0x6f,
+	0x12, 0x10, 0x0a, 0x03, 0x63, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63,
+	0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x6f, 0x6c, 0x74, 0x61, 0x67, 0x65, 0x6d, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x74, 0x61, 0x67, 0x65, 0x6d, 0x12, 0x19,
+	0x0a, 0x08, 0x70, 0x72, 0x65, 0x63, 0x6f, 0x5f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x07, 0x70, 0x72, 0x65, 0x63, 0x6f, 0x44, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x72, 0x65,
+	0x63, 0x6f, 0x5f, 0x70, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x70, 0x72,
+	0x65, 0x63, 0x6f, 0x50, 0x6f, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x73, 0x74, 0x6f, 0x71, 0x75,
+	0x65, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x65,
+	0x73, 0x74, 0x6f, 0x71, 0x75, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x65,
+	0x73, 0x74, 0x6f, 0x71, 0x75, 0x65, 0x5f, 0x63, 0x6f, 0x72, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0c, 0x65, 0x73, 0x74, 0x6f, 0x71, 0x75, 0x65, 0x43, 0x6f, 0x72, 0x74, 0x65,
+	0x12, 0x2d, 0x0a, 0x12, 0x65, 0x73, 0x74, 0x6f, 0x71, 0x75, 0x65, 0x5f, 0x64, 0x69, 0x73, 0x70,
+	0x6f, 0x6e, 0x69, 0x76, 0x65, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x65, 0x73,
+	0x74, 0x6f, 0x71, 0x75, 0x65, 0x44, 0x69, 0x73, 0x70, 0x6f, 0x6e, 0x69, 0x76, 0x65, 0x6c, 0x12,
+	0x1b, 0x0a, 0x09, 0x63, 0x72, 0x69, 0x61, 0x64, 0x6f, 0x5f, 0x65, 0x6d, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x72, 0x69, 0x61, 0x64, 0x6f, 0x45, 0x6d, 0x12, 0x29, 0x0a, 0x10,
+	0x75, 0x6c, 0x74, 0x69, 0x6d, 0x61, 0x5f, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x61, 0x63, 0x61, 0x6f,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x75, 0x6c, 0x74, 0x69, 0x6d, 0x61, 0x41, 0x6c,
+	0x74, 0x65, 0x72, 0x61, 0x63, 0x61, 0x6f, 0x22, 0x69, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x72, 0x6f, 0x64, 0x75, 0x74, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x61, 0x12, 0x14, 0x0a, 0x05,
+	0x6d, 0x61, 0x72, 0x63, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x61, 0x72,
+	0x63, 0x61, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x6f, 0x72, 0x6e, 0x65, 0x63, 0x65, 0x64, 0x6f, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x6f, 0x72, 0x6e, 0x65, 0x63, 0x65, 0x64,
+	0x6f, 0x72, 0x22, 0x48, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x74,
+	0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x08, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x74, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70,
+	0x72, 0x6f, 0x64, 0x75, 0x74, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x74, 0x6f, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x74, 0x6f, 0x73, 0x22, 0x2b, 0x0a, 0x11,
+	0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x64, 0x69, 0x67, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x63, 0x6f, 0x64, 0x69, 0x67, 0x6f, 0x22, 0x2e, 0x0a, 0x18, 0x47, 0x65, 0x74,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x74, 0x6f, 0x73, 0x42, 0x79, 0x4e, 0x6f, 0x6d, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x6d, 0x65, 0x22, 0x46, 0x0a, 0x14, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x2e, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x74, 0x6f, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x74, 0x6f, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x74, 0x6f, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x74,
+	0x6f, 0x22, 0x46, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x74, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x07, 0x70, 0x72, 0x6f,
+	0x64, 0x75, 0x74, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f,
+	0x64, 0x75, 0x74, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x74, 0x6f,
+	0x52, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x74, 0x6f, 0x22, 0x2e, 0x0a, 0x14, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x64, 0x69, 0x67, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x63, 0x6f, 0x64, 0x69, 0x67, 0x6f, 0x32, 0xb2, 0x04, 0x0a, 0x0e, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x74, 0x6f, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x53, 0x0a, 0x0c,
+	0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x74, 0x6f, 0x73, 0x12, 0x20, 0x2e, 0x70,
+	0x72, 0x6f, 0x64, 0x75, 0x74, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x72, 0x6f, 0x64, 0x75, 0x74, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
+	0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x74, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x74, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x4a, 0x0a, 0x0e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x74, 0x6f, 0x73, 0x12, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x74, 0x6f, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x74, 0x6f, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x74, 0x6f, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x74, 0x6f, 0x30, 0x01, 0x12, 0x42, 0x0a,
+	0x0a, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x74, 0x6f, 0x12, 0x1e, 0x2e, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x74, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f,
+	0x64, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x74, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x74,
+	0x6f, 0x12, 0x5d, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x74, 0x6f, 0x73,
+	0x42, 0x79, 0x4e, 0x6f, 0x6d, 0x65, 0x12, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x74, 0x6f,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x74, 0x6f, 0x73,
+	0x42, 0x79, 0x4e, 0x6f, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
+	0x70, 0x72, 0x6f, 0x64, 0x75, 0x74, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x74, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x48, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x74,
+	0x6f, 0x12, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x74, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x74, 0x6f, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x74, 0x6f, 0x12, 0x48, 0x0a, 0x0d, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x74, 0x6f, 0x12, 0x21, 0x2e, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x74, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14,
+	0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x74, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f,
+	0x64, 0x75, 0x74, 0x6f, 0x12, 0x48, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x74, 0x6f, 0x12, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x74, 0x6f, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x74,
+	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75,
+	0x74, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x74, 0x6f, 0x42, 0x35,
+	0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x47, 0x69, 0x61,
+	0x6e, 0x47, 0x6f, 0x75, 0x6c, 0x61, 0x72, 0x74, 0x2f, 0x43, 0x72, 0x75, 0x64, 0x50, 0x72, 0x6f,
+	0x64, 0x75, 0x74, 0x6f, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x74, 0x6f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_produto_proto_rawDescOnce sync.Once
+	file_produto_proto_rawDescData = file_produto_proto_rawDesc
+)
+
+func file_produto_proto_rawDescGZIP() []byte {
+	file_produto_proto_rawDescOnce.Do(func() {
+		file_produto_proto_rawDescData = protoimpl.X.CompressGZIP(file_produto_proto_rawDescData)
+	})
+	return file_produto_proto_rawDescData
+}
+
+var file_produto_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_produto_proto_goTypes = []interface{}{
+	(*Produto)(nil),                  // 0: produtos.v1.Produto
+	(*Variacao)(nil),                 // 1: produtos.v1.Variacao
+	(*ListProdutosRequest)(nil),      // 2: produtos.v1.ListProdutosRequest
+	(*ListProdutosResponse)(nil),     // 3: produtos.v1.ListProdutosResponse
+	(*GetProdutoRequest)(nil),        // 4: produtos.v1.GetProdutoRequest
+	(*GetProdutosByNomeRequest)(nil), // 5: produtos.v1.GetProdutosByNomeRequest
+	(*CreateProdutoRequest)(nil),     // 6: produtos.v1.CreateProdutoRequest
+	(*UpdateProdutoRequest)(nil),     // 7: produtos.v1.UpdateProdutoRequest
+	(*DeleteProdutoRequest)(nil),     // 8: produtos.v1.DeleteProdutoRequest
+}
+var file_produto_proto_depIdxs = []int32{
+	1,  // 0: produtos.v1.Produto.variacoes:type_name -> produtos.v1.Variacao
+	0,  // 1: produtos.v1.ListProdutosResponse.produtos:type_name -> produtos.v1.Produto
+	0,  // 2: produtos.v1.CreateProdutoRequest.produto:type_name -> produtos.v1.Produto
+	0,  // 3: produtos.v1.UpdateProdutoRequest.produto:type_name -> produtos.v1.Produto
+	2,  // 4: produtos.v1.ProdutoService.ListProdutos:input_type -> produtos.v1.ListProdutosRequest
+	2,  // 5: produtos.v1.ProdutoService.ExportProdutos:input_type -> produtos.v1.ListProdutosRequest
+	4,  // 6: produtos.v1.ProdutoService.GetProduto:input_type -> produtos.v1.GetProdutoRequest
+	5,  // 7: produtos.v1.ProdutoService.GetProdutosByNome:input_type -> produtos.v1.GetProdutosByNomeRequest
+	6,  // 8: produtos.v1.ProdutoService.CreateProduto:input_type -> produtos.v1.CreateProdutoRequest
+	7,  // 9: produtos.v1.ProdutoService.UpdateProduto:input_type -> produtos.v1.UpdateProdutoRequest
+	8,  // 10: produtos.v1.ProdutoService.DeleteProduto:input_type -> produtos.v1.DeleteProdutoRequest
+	3,  // 11: produtos.v1.ProdutoService.ListProdutos:output_type -> produtos.v1.ListProdutosResponse
+	0,  // 12: produtos.v1.ProdutoService.ExportProdutos:output_type -> produtos.v1.Produto
+	0,  // 13: produtos.v1.ProdutoService.GetProduto:output_type -> produtos.v1.Produto
+	3,  // 14: produtos.v1.ProdutoService.GetProdutosByNome:output_type -> produtos.v1.ListProdutosResponse
+	0,  // 15: produtos.v1.ProdutoService.CreateProduto:output_type -> produtos.v1.Produto
+	0,  // 16: produtos.v1.ProdutoService.UpdateProduto:output_type -> produtos.v1.Produto
+	0,  // 17: produtos.v1.ProdutoService.DeleteProduto:output_type -> produtos.v1.Produto
+	11, // [11:18] is the sub-list for method output_type
+	4,  // [4:11] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_produto_proto_init() }
+func file_produto_proto_init() {
+	if File_produto_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_produto_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Produto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_produto_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Variacao); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_produto_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProdutosRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_produto_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProdutosResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_produto_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProdutoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_produto_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProdutosByNomeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_produto_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateProdutoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_produto_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateProdutoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_produto_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteProdutoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_produto_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_produto_proto_goTypes,
+		DependencyIndexes: file_produto_proto_depIdxs,
+		MessageInfos:      file_produto_proto_msgTypes,
+	}.Build()
+	File_produto_proto = out.File
+	file_produto_proto_rawDesc = nil
+	file_produto_proto_goTypes = nil
+	file_produto_proto_depIdxs = nil
+}
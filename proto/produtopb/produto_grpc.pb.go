@@ -0,0 +1,359 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.19.4
+// source: produto.proto
+
+package produtopb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// ProdutoServiceClient is the client API for ProdutoService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ProdutoServiceClient interface {
+	// ListProdutos lista os produtos, aplicando os filtros informados
+	ListProdutos(ctx context.Context, in *ListProdutosRequest, opts ...grpc.CallOption) (*ListProdutosResponse, error)
+	// ExportProdutos envia os produtos um a um, aplicando os filtros informados
+	ExportProdutos(ctx context.Context, in *ListProdutosRequest, opts ...grpc.CallOption) (ProdutoService_ExportProdutosClient, error)
+	// GetProduto retorna o produto junto com as suas variações
+	GetProduto(ctx context.Context, in *GetProdutoRequest, opts ...grpc.CallOption) (*Produto, error)
+	// GetProdutosByNome lista os produtos com o nome informado
+	GetProdutosByNome(ctx context.Context, in *GetProdutosByNomeRequest, opts ...grpc.CallOption) (*ListProdutosResponse, error)
+	CreateProduto(ctx context.Context, in *CreateProdutoRequest, opts ...grpc.CallOption) (*Produto, error)
+	UpdateProduto(ctx context.Context, in *UpdateProdutoRequest, opts ...grpc.CallOption) (*Produto, error)
+	// DeleteProduto remove o produto e retorna o seu último estado
+	DeleteProduto(ctx context.Context, in *DeleteProdutoRequest, opts ...grpc.CallOption) (*Produto, error)
+}
+
+type produtoServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewProdutoServiceClient(cc grpc.ClientConnInterface) ProdutoServiceClient {
+	return &produtoServiceClient{cc}
+}
+
+func (c *produtoServiceClient) ListProdutos(ctx context.Context, in *ListProdutosRequest, opts ...grpc.CallOption) (*ListProdutosResponse, error) {
+	out := new(ListProdutosResponse)
+	err := c.cc.Invoke(ctx, "/produtos.v1.ProdutoService/ListProdutos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *produtoServiceClient) ExportProdutos(ctx context.Context, in *ListProdutosRequest, opts ...grpc.CallOption) (ProdutoService_ExportProdutosClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ProdutoService_ServiceDesc.Streams[0], "/produtos.v1.ProdutoService/ExportProdutos", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &produtoServiceExportProdutosClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ProdutoService_ExportProdutosClient interface {
+	Recv() (*Produto, error)
+	grpc.ClientStream
+}
+
+type produtoServiceExportProdutosClient struct {
+	grpc.ClientStream
+}
+
+func (x *produtoServiceExportProdutosClient) Recv() (*Produto, error) {
+	m := new(Produto)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *produtoServiceClient) GetProduto(ctx context.Context, in *GetProdutoRequest, opts ...grpc.CallOption) (*Produto, error) {
+	out := new(Produto)
+	err := c.cc.Invoke(ctx, "/produtos.v1.ProdutoService/GetProduto", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *produtoServiceClient) GetProdutosByNome(ctx context.Context, in *GetProdutosByNomeRequest, opts ...grpc.CallOption) (*ListProdutosResponse, error) {
+	out := new(ListProdutosResponse)
+	err := c.cc.Invoke(ctx, "/produtos.v1.ProdutoService/GetProdutosByNome", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *produtoServiceClient) CreateProduto(ctx context.Context, in *CreateProdutoRequest, opts ...grpc.CallOption) (*Produto, error) {
+	out := new(Produto)
+	err := c.cc.Invoke(ctx, "/produtos.v1.ProdutoService/CreateProduto", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *produtoServiceClient) UpdateProduto(ctx context.Context, in *UpdateProdutoRequest, opts ...grpc.CallOption) (*Produto, error) {
+	out := new(Produto)
+	err := c.cc.Invoke(ctx, "/produtos.v1.ProdutoService/UpdateProduto", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *produtoServiceClient) DeleteProduto(ctx context.Context, in *DeleteProdutoRequest, opts ...grpc.CallOption) (*Produto, error) {
+	out := new(Produto)
+	err := c.cc.Invoke(ctx, "/produtos.v1.ProdutoService/DeleteProduto", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProdutoServiceServer is the server API for ProdutoService service.
+// All implementations must embed UnimplementedProdutoServiceServer
+// for forward compatibility
+type ProdutoServiceServer interface {
+	// ListProdutos lista os produtos, aplicando os filtros informados
+	ListProdutos(context.Context, *ListProdutosRequest) (*ListProdutosResponse, error)
+	// ExportProdutos envia os produtos um a um, aplicando os filtros informados
+	ExportProdutos(*ListProdutosRequest, ProdutoService_ExportProdutosServer) error
+	// GetProduto retorna o produto junto com as suas variações
+	GetProduto(context.Context, *GetProdutoRequest) (*Produto, error)
+	// GetProdutosByNome lista os produtos com o nome informado
+	GetProdutosByNome(context.Context, *GetProdutosByNomeRequest) (*ListProdutosResponse, error)
+	CreateProduto(context.Context, *CreateProdutoRequest) (*Produto, error)
+	UpdateProduto(context.Context, *UpdateProdutoRequest) (*Produto, error)
+	// DeleteProduto remove o produto e retorna o seu último estado
+	DeleteProduto(context.Context, *DeleteProdutoRequest) (*Produto, error)
+	mustEmbedUnimplementedProdutoServiceServer()
+}
+
+// UnimplementedProdutoServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedProdutoServiceServer struct {
+}
+
+func (UnimplementedProdutoServiceServer) ListProdutos(context.Context, *ListProdutosRequest) (*ListProdutosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProdutos not implemented")
+}
+func (UnimplementedProdutoServiceServer) ExportProdutos(*ListProdutosRequest, ProdutoService_ExportProdutosServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportProdutos not implemented")
+}
+func (UnimplementedProdutoServiceServer) GetProduto(context.Context, *GetProdutoRequest) (*Produto, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProduto not implemented")
+}
+func (UnimplementedProdutoServiceServer) GetProdutosByNome(context.Context, *GetProdutosByNomeRequest) (*ListProdutosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProdutosByNome not implemented")
+}
+func (UnimplementedProdutoServiceServer) CreateProduto(context.Context, *CreateProdutoRequest) (*Produto, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProduto not implemented")
+}
+func (UnimplementedProdutoServiceServer) UpdateProduto(context.Context, *UpdateProdutoRequest) (*Produto, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProduto not implemented")
+}
+func (UnimplementedProdutoServiceServer) DeleteProduto(context.Context, *DeleteProdutoRequest) (*Produto, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProduto not implemented")
+}
+func (UnimplementedProdutoServiceServer) mustEmbedUnimplementedProdutoServiceServer() {}
+
+// UnsafeProdutoServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ProdutoServiceServer will
+// result in compilation errors.
+type UnsafeProdutoServiceServer interface {
+	mustEmbedUnimplementedProdutoServiceServer()
+}
+
+func RegisterProdutoServiceServer(s grpc.ServiceRegistrar, srv ProdutoServiceServer) {
+	s.RegisterService(&ProdutoService_ServiceDesc, srv)
+}
+
+func _ProdutoService_ListProdutos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProdutosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProdutoServiceServer).ListProdutos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/produtos.v1.ProdutoService/ListProdutos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProdutoServiceServer).ListProdutos(ctx, req.(*ListProdutosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProdutoService_ExportProdutos_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListProdutosRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ProdutoServiceServer).ExportProdutos(m, &produtoServiceExportProdutosServer{stream})
+}
+
+type ProdutoService_ExportProdutosServer interface {
+	Send(*Produto) error
+	grpc.ServerStream
+}
+
+type produtoServiceExportProdutosServer struct {
+	grpc.ServerStream
+}
+
+func (x *produtoServiceExportProdutosServer) Send(m *Produto) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ProdutoService_GetProduto_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProdutoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProdutoServiceServer).GetProduto(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/produtos.v1.ProdutoService/GetProduto",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProdutoServiceServer).GetProduto(ctx, req.(*GetProdutoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProdutoService_GetProdutosByNome_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProdutosByNomeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProdutoServiceServer).GetProdutosByNome(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/produtos.v1.ProdutoService/GetProdutosByNome",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProdutoServiceServer).GetProdutosByNome(ctx, req.(*GetProdutosByNomeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProdutoService_CreateProduto_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProdutoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProdutoServiceServer).CreateProduto(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/produtos.v1.ProdutoService/CreateProduto",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProdutoServiceServer).CreateProduto(ctx, req.(*CreateProdutoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProdutoService_UpdateProduto_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProdutoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProdutoServiceServer).UpdateProduto(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/produtos.v1.ProdutoService/UpdateProduto",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProdutoServiceServer).UpdateProduto(ctx, req.(*UpdateProdutoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProdutoService_DeleteProduto_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProdutoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProdutoServiceServer).DeleteProduto(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/produtos.v1.ProdutoService/DeleteProduto",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProdutoServiceServer).DeleteProduto(ctx, req.(*DeleteProdutoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ProdutoService_ServiceDesc is the grpc.ServiceDesc for ProdutoService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ProdutoService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "produtos.v1.ProdutoService",
+	HandlerType: (*ProdutoServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListProdutos",
+			Handler:    _ProdutoService_ListProdutos_Handler,
+		},
+		{
+			MethodName: "GetProduto",
+			Handler:    _ProdutoService_GetProduto_Handler,
+		},
+		{
+			MethodName: "GetProdutosByNome",
+			Handler:    _ProdutoService_GetProdutosByNome_Handler,
+		},
+		{
+			MethodName: "CreateProduto",
+			Handler:    _ProdutoService_CreateProduto_Handler,
+		},
+		{
+			MethodName: "UpdateProduto",
+			Handler:    _ProdutoService_UpdateProduto_Handler,
+		},
+		{
+			MethodName: "DeleteProduto",
+			Handler:    _ProdutoService_DeleteProduto_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportProdutos",
+			Handler:       _ProdutoService_ExportProdutos_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "produto.proto",
+}
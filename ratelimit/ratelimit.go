@@ -0,0 +1,224 @@
+package ratelimit
+
+import (
+	"container/list"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/GianGoulart/CrudProdutos/logger"
+	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/labstack/echo/v4"
+)
+
+// Limit configuração de um token bucket: Rate tokens por segundo com no máximo Burst acumulados
+type Limit struct {
+	Rate  float64
+	Burst int
+}
+
+// Options struct de opções para a criação do middleware de rate limit
+type Options struct {
+	Read  Limit
+	Write Limit
+
+	// IdleTTL tempo sem requisições após o qual o bucket do cliente é descartado
+	IdleTTL time.Duration
+	// MaxClientes quantidade máxima de buckets mantidos, os usados há mais tempo são descartados
+	MaxClientes int
+}
+
+type bucket struct {
+	key      string
+	tokens   float64
+	last     time.Time
+	rejected uint64
+}
+
+// Limiter mantém um token bucket por cliente e classe de rota
+type Limiter struct {
+	mu sync.Mutex
+
+	opts    Options
+	buckets map[string]*list.Element
+	// lru ordena os buckets do usado mais recentemente para o mais antigo
+	lru *list.List
+	// leituras rotas declaradas como leitura além das dos métodos seguros
+	leituras map[string]bool
+	now      func() time.Time
+}
+
+// New cria uma nova instancia do limiter
+func New(opts Options) *Limiter {
+	if opts.IdleTTL <= 0 {
+		opts.IdleTTL = 10 * time.Minute
+	}
+	if opts.MaxClientes <= 0 {
+		opts.MaxClientes = 100000
+	}
+
+	return &Limiter{
+		opts:     opts,
+		buckets:  make(map[string]*list.Element),
+		lru:      list.New(),
+		leituras: make(map[string]bool),
+		now:      time.Now,
+	}
+}
+
+// Result resultado da verificação de uma requisição
+type Result struct {
+	Allowed    bool
+	Limit      int
+	Remaining  int
+	RetryAfter time.Duration
+	Rejected   uint64
+}
+
+// Allow consome um token do bucket da chave informada
+func (l *Limiter) Allow(key string, limit Limit) Result {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.sweep(now)
+
+	var b *bucket
+	if el, ok := l.buckets[key]; ok {
+		l.lru.MoveToFront(el)
+		b = el.Value.(*bucket)
+	} else {
+		if l.lru.Len() >= l.opts.MaxClientes {
+			l.remove(l.lru.Back())
+		}
+
+		b = &bucket{key: key, tokens: float64(limit.Burst), last: now}
+		l.buckets[key] = l.lru.PushFront(b)
+	}
+
+	// repõe os tokens proporcionalmente ao tempo desde a última requisição
+	b.tokens = math.Min(float64(limit.Burst), b.tokens+now.Sub(b.last).Seconds()*limit.Rate)
+	b.last = now
+
+	if b.tokens >= 1 {
+		b.tokens--
+		return Result{Allowed: true, Limit: limit.Burst, Remaining: int(b.tokens), Rejected: b.rejected}
+	}
+
+	b.rejected++
+
+	retryAfter := time.Duration(math.MaxInt64)
+	if limit.Rate > 0 {
+		retryAfter = time.Duration((1 - b.tokens) / limit.Rate * float64(time.Second))
+	}
+
+	return Result{Limit: limit.Burst, RetryAfter: retryAfter, Rejected: b.rejected}
+}
+
+// sweep descarta os buckets sem uso há mais de IdleTTL, a partir do usado há mais tempo
+func (l *Limiter) sweep(now time.Time) {
+	for el := l.lru.Back(); el != nil && now.Sub(el.Value.(*bucket).last) > l.opts.IdleTTL; el = l.lru.Back() {
+		l.remove(el)
+	}
+}
+
+func (l *Limiter) remove(el *list.Element) {
+	l.lru.Remove(el)
+	delete(l.buckets, el.Value.(*bucket).key)
+}
+
+// Leitura declara a rota, pelo método e caminho registrados no echo, como leitura.
+// Usado pelas rotas que consultam por POST, como a do GraphQL
+func (l *Limiter) Leitura(method, path string) {
+	// o router do echo completa a barra inicial dos grupos sem ela, como em c.Path()
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.leituras[method+" "+path] = true
+}
+
+// isRead indica se a requisição é de leitura, pelo método ou pela rota declarada
+func (l *Limiter) isRead(c echo.Context) bool {
+	req := c.Request()
+	if req.Method == http.MethodGet || req.Method == http.MethodHead || req.Method == http.MethodOptions {
+		return true
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.leituras[req.Method+" "+c.Path()]
+}
+
+// ClientKey identifica o cliente pelo ip, extraído pelo IPExtractor do echo. Sem
+// proxies confiáveis o ip é o da conexão, senão bastaria trocar o X-Forwarded-For
+// a cada requisição para escapar do limite ou consumir o limite de outro cliente
+func ClientKey(c echo.Context) string {
+	return "ip:" + c.RealIP()
+}
+
+// IPExtractor extrai o ip do cliente da conexão ou, quando ela vem de um dos proxies
+// confiáveis informados em notação CIDR, do header X-Forwarded-For
+func IPExtractor(proxies []string) (echo.IPExtractor, error) {
+	if len(proxies) == 0 {
+		return echo.ExtractIPDirect(), nil
+	}
+
+	// apenas os proxies informados são confiáveis, sem as redes privadas do padrão do echo
+	options := []echo.TrustOption{echo.TrustLoopback(false), echo.TrustLinkLocal(false), echo.TrustPrivateNet(false)}
+	for _, proxy := range proxies {
+		_, ipnet, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return nil, err
+		}
+		options = append(options, echo.TrustIPRange(ipnet))
+	}
+
+	return echo.ExtractIPFromXFFHeader(options...), nil
+}
+
+// Middleware aplica os limites de leitura e escrita por cliente, respondendo 429
+// com os headers Retry-After e X-RateLimit-* quando o limite é excedido
+func (l *Limiter) Middleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			class, limit := "write", l.opts.Write
+			if l.isRead(c) {
+				class, limit = "read", l.opts.Read
+			}
+
+			key := ClientKey(c)
+			res := l.Allow(class+"|"+key, limit)
+
+			header := c.Response().Header()
+			header.Set("X-RateLimit-Limit", strconv.Itoa(res.Limit))
+			header.Set("X-RateLimit-Remaining", strconv.Itoa(res.Remaining))
+
+			if res.Allowed {
+				return next(c)
+			}
+
+			retryAfter := int(math.Ceil(res.RetryAfter.Seconds()))
+			header.Set("Retry-After", strconv.Itoa(retryAfter))
+
+			logger.FromContext(c.Request().Context()).WithFields(map[string]interface{}{
+				"client":         key,
+				"limit_class":    class,
+				"rejected_total": res.Rejected,
+				"retry_after":    retryAfter,
+			}).Warn("ratelimit.excedido")
+
+			return c.JSON(http.StatusTooManyRequests, model.Response{
+				Err: "limite de requisições excedido",
+			})
+		}
+	}
+}
@@ -0,0 +1,148 @@
+package ratelimit
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Allow(t *testing.T) {
+	now := time.Now()
+	l := New(Options{})
+	l.now = func() time.Time { return now }
+
+	limit := Limit{Rate: 1, Burst: 2}
+
+	assert.True(t, l.Allow("a", limit).Allowed)
+	assert.True(t, l.Allow("a", limit).Allowed)
+
+	res := l.Allow("a", limit)
+	assert.False(t, res.Allowed)
+	assert.Equal(t, time.Second, res.RetryAfter)
+	assert.Equal(t, uint64(1), res.Rejected)
+
+	// outro cliente tem o seu próprio bucket
+	assert.True(t, l.Allow("b", limit).Allowed)
+
+	now = now.Add(time.Second)
+	assert.True(t, l.Allow("a", limit).Allowed)
+}
+
+func Test_AllowMaxClientes(t *testing.T) {
+	now := time.Now()
+	l := New(Options{MaxClientes: 2, IdleTTL: time.Minute})
+	l.now = func() time.Time { return now }
+
+	limit := Limit{Rate: 1, Burst: 1}
+
+	assert.True(t, l.Allow("a", limit).Allowed)
+	assert.True(t, l.Allow("b", limit).Allowed)
+	assert.False(t, l.Allow("a", limit).Allowed)
+
+	// o bucket usado há mais tempo, b, é descartado para abrir espaço
+	assert.True(t, l.Allow("c", limit).Allowed)
+	assert.Len(t, l.buckets, 2)
+	assert.False(t, l.Allow("a", limit).Allowed)
+	assert.True(t, l.Allow("b", limit).Allowed)
+
+	// os buckets sem uso há mais de IdleTTL são descartados
+	now = now.Add(2 * time.Minute)
+	assert.True(t, l.Allow("d", limit).Allowed)
+	assert.Len(t, l.buckets, 1)
+}
+
+func Test_Middleware(t *testing.T) {
+	e := echo.New()
+	e.IPExtractor = echo.ExtractIPDirect()
+	l := New(Options{
+		Read:  Limit{Rate: 1, Burst: 1},
+		Write: Limit{Rate: 1, Burst: 1},
+	})
+	l.Leitura(http.MethodPost, "/graphql")
+	h := l.Middleware()(func(c echo.Context) error {
+		return c.NoContent(http.StatusOK)
+	})
+
+	// os casos são sequenciais, cada um consome o bucket deixado pelo anterior
+	cases := []struct {
+		Name         string
+		Method       string
+		Path         string
+		Token        string
+		ForwardedFor string
+		ExpectedCode int
+	}{
+		{Name: "deve permitir a primeira leitura", Method: http.MethodGet, ExpectedCode: http.StatusOK},
+		{Name: "deve bloquear a segunda leitura", Method: http.MethodGet, ExpectedCode: http.StatusTooManyRequests},
+		{Name: "deve contar as rotas declaradas como leitura", Method: http.MethodPost, Path: "/graphql", ExpectedCode: http.StatusTooManyRequests},
+		{Name: "deve ter limite separado para escrita", Method: http.MethodPost, ExpectedCode: http.StatusOK},
+		{Name: "não deve confiar no subject do token", Method: http.MethodGet, Token: "eyJhbGciOiJub25lIn0.eyJzdWIiOiJlcnAifQ.", ExpectedCode: http.StatusTooManyRequests},
+		{Name: "não deve confiar no ip do X-Forwarded-For", Method: http.MethodGet, ForwardedFor: "203.0.113.10", ExpectedCode: http.StatusTooManyRequests},
+	}
+
+	for _, cs := range cases {
+		t.Run(cs.Name, func(t *testing.T) {
+			path := "/produtos"
+			if cs.Path != "" {
+				path = cs.Path
+			}
+			request := httptest.NewRequest(cs.Method, path, nil)
+			if cs.Token != "" {
+				request.Header.Set(echo.HeaderAuthorization, "Bearer "+cs.Token)
+			}
+			if cs.ForwardedFor != "" {
+				request.Header.Set(echo.HeaderXForwardedFor, cs.ForwardedFor)
+			}
+			rr := httptest.NewRecorder()
+
+			c := e.NewContext(request, rr)
+			c.SetPath(path)
+
+			assert.NoError(t, h(c))
+			assert.Equal(t, cs.ExpectedCode, rr.Code)
+			assert.Equal(t, "1", rr.Header().Get("X-RateLimit-Limit"))
+
+			if cs.ExpectedCode == http.StatusTooManyRequests {
+				assert.Equal(t, "1", rr.Header().Get("Retry-After"))
+			}
+		})
+	}
+}
+
+func Test_IPExtractor(t *testing.T) {
+	cases := map[string]struct {
+		Proxies      []string
+		RemoteAddr   string
+		ForwardedFor string
+
+		Expected    string
+		ExpectedErr bool
+	}{
+		"deve usar o ip da conexão sem proxies":              {RemoteAddr: "10.0.0.1:4321", ForwardedFor: "203.0.113.10", Expected: "10.0.0.1"},
+		"deve usar o X-Forwarded-For do proxy confiável":     {Proxies: []string{"10.0.0.0/8"}, RemoteAddr: "10.0.0.1:4321", ForwardedFor: "203.0.113.10", Expected: "203.0.113.10"},
+		"deve ignorar o X-Forwarded-For de outra origem":     {Proxies: []string{"10.0.0.0/8"}, RemoteAddr: "198.51.100.7:4321", ForwardedFor: "203.0.113.10", Expected: "198.51.100.7"},
+		"não deve confiar nas redes privadas não informadas": {Proxies: []string{"10.0.0.0/8"}, RemoteAddr: "192.168.0.1:4321", ForwardedFor: "203.0.113.10", Expected: "192.168.0.1"},
+		"deve rejeitar o proxy inválido":                     {Proxies: []string{"10.0.0.1"}, ExpectedErr: true},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			extractor, err := IPExtractor(cs.Proxies)
+			if cs.ExpectedErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+
+			request := httptest.NewRequest(http.MethodGet, "/produtos", nil)
+			request.RemoteAddr = cs.RemoteAddr
+			request.Header.Set(echo.HeaderXForwardedFor, cs.ForwardedFor)
+
+			assert.Equal(t, cs.Expected, extractor(request))
+		})
+	}
+}
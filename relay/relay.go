@@ -0,0 +1,168 @@
+package relay
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/GianGoulart/CrudProdutos/broker"
+	"github.com/GianGoulart/CrudProdutos/logger"
+	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/GianGoulart/CrudProdutos/store/outbox"
+	"github.com/sirupsen/logrus"
+)
+
+// IRelay interface da publicação da outbox em segundo plano
+type IRelay interface {
+	Start()
+	Stop()
+}
+
+// Options struct de opções para a criação do relay
+type Options struct {
+	Store  outbox.IOutboxStore
+	Broker broker.IBroker
+
+	// Intervalo entre as verificações dos eventos pendentes
+	Intervalo time.Duration
+	// Lote quantidade máxima de eventos publicados por verificação
+	Lote int
+	// Retencao tempo que os eventos publicados ficam na outbox, zero mantém para sempre
+	Retencao time.Duration
+	// MaxTentativas tentativas de publicação de um evento antes de marcá-lo como falho
+	MaxTentativas int
+}
+
+// Relay publica no broker os eventos gravados na outbox. A entrega é pelo menos
+// uma vez: um evento publicado pode ser publicado de novo se o processo parar
+// antes de marcá-lo, e o consumidor deve descartar repetições pelo codigo. Os
+// eventos de um mesmo produto são publicados na ordem em que foram gravados,
+// por isso deve haver um único relay ativo por banco
+type Relay struct {
+	opts Options
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// New cria uma nova instancia do relay
+func New(opts Options) *Relay {
+	if opts.MaxTentativas <= 0 {
+		opts.MaxTentativas = 10
+	}
+
+	return &Relay{
+		opts: opts,
+		quit: make(chan struct{}),
+	}
+}
+
+// Start inicia a publicação dos eventos
+func (r *Relay) Start() {
+	r.wg.Add(1)
+
+	go func() {
+		defer r.wg.Done()
+
+		ticker := time.NewTicker(r.opts.Intervalo)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-r.quit:
+				return
+			case <-ticker.C:
+			}
+
+			ctx := context.Background()
+
+			// um lote cheio indica que há mais eventos esperando
+			for r.Processar(ctx) == r.opts.Lote {
+			}
+
+			r.limpar(ctx)
+		}
+	}()
+
+	logrus.Info("Registered -> Relay")
+}
+
+// Stop encerra a publicação, aguardando a verificação em andamento
+func (r *Relay) Stop() {
+	close(r.quit)
+	r.wg.Wait()
+}
+
+// Processar publica os eventos pendentes e retorna quantos foram publicados. A
+// falha de um evento adia os eventos seguintes do mesmo produto para a próxima
+// verificação, sem impedir a publicação dos outros produtos. O evento que esgota
+// as tentativas é marcado como falho e libera os seguintes
+func (r *Relay) Processar(ctx context.Context) int {
+	eventos, err := r.opts.Store.FindPendentes(ctx, r.opts.Lote)
+	if err != nil {
+		return 0
+	}
+
+	bloqueados := make(map[string]bool)
+	publicados := []uint64{}
+
+	for i := range *eventos {
+		evento := &(*eventos)[i]
+
+		if bloqueados[evento.Agregado] {
+			continue
+		}
+
+		if err := r.publicar(ctx, evento); err != nil {
+			bloqueados[evento.Agregado] = true
+			continue
+		}
+
+		publicados = append(publicados, evento.Sequencia)
+	}
+
+	if err := r.opts.Store.MarcarPublicados(ctx, publicados); err != nil {
+		return 0
+	}
+
+	return len(publicados)
+}
+
+func (r *Relay) publicar(ctx context.Context, evento *model.EventoOutbox) error {
+	err := r.opts.Broker.Publicar(ctx, broker.Mensagem{
+		Codigo:  evento.Codigo,
+		Chave:   evento.Agregado,
+		Tipo:    evento.Tipo,
+		Payload: []byte(evento.Payload),
+	})
+	if err == nil {
+		return nil
+	}
+
+	entry := logger.FromContext(ctx).WithError(err).WithField("evento", evento.Codigo).WithField("produto", evento.Agregado)
+
+	evento.Tentativas++
+	evento.UltimoErro = err.Error()
+	evento.Falhou = evento.Tentativas >= r.opts.MaxTentativas
+
+	if evento.Falhou {
+		entry.WithField("tentativas", evento.Tentativas).Error("relay.publicar: evento descartado após esgotar as tentativas")
+	} else {
+		entry.Warn("relay.publicar")
+	}
+
+	r.opts.Store.RegistrarFalha(ctx, evento)
+
+	return err
+}
+
+// limpar remove os eventos publicados há mais tempo que a retenção
+func (r *Relay) limpar(ctx context.Context) {
+	if r.opts.Retencao <= 0 {
+		return
+	}
+
+	if removidos, err := r.opts.Store.DeletePublicados(ctx, time.Now().Add(-r.opts.Retencao).Unix()); err == nil && removidos > 0 {
+		logger.FromContext(ctx).WithField("removidos", removidos).Info("relay.limpar")
+	}
+}
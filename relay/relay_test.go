@@ -0,0 +1,164 @@
+package relay_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/GianGoulart/CrudProdutos/broker"
+	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/GianGoulart/CrudProdutos/relay"
+	"github.com/GianGoulart/CrudProdutos/store/outbox"
+	"github.com/stretchr/testify/assert"
+)
+
+// brokerFalho broker que recusa as mensagens de um produto ou um evento
+type brokerFalho struct {
+	*broker.Memory
+	chave  string
+	codigo string
+}
+
+func (b *brokerFalho) Publicar(ctx context.Context, msg broker.Mensagem) error {
+	if msg.Chave == b.chave || msg.Codigo == b.codigo {
+		return errors.New("broker fora do ar")
+	}
+
+	return b.Memory.Publicar(ctx, msg)
+}
+
+func newOutbox(t *testing.T, eventos ...model.EventoOutbox) outbox.IOutboxStore {
+	s := outbox.NewOutboxMemory()
+	for _, e := range eventos {
+		e := e
+		if err := s.CreateEvento(context.Background(), &e); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return s
+}
+
+func codigos(mensagens []broker.Mensagem) []string {
+	res := []string{}
+	for _, m := range mensagens {
+		res = append(res, m.Codigo)
+	}
+
+	return res
+}
+
+func Test_Processar(t *testing.T) {
+	ctx := context.Background()
+	eventos := []model.EventoOutbox{
+		{Codigo: "e1", Agregado: "p1", Tipo: model.EventoProdutoCriado, Payload: `{"codigo":"e1"}`},
+		{Codigo: "e2", Agregado: "p2", Tipo: model.EventoProdutoCriado, Payload: `{"codigo":"e2"}`},
+		{Codigo: "e3", Agregado: "p1", Tipo: model.EventoProdutoAlterado, Payload: `{"codigo":"e3"}`},
+		{Codigo: "e4", Agregado: "p2", Tipo: model.EventoProdutoRemovido, Payload: `{"codigo":"e4"}`},
+	}
+
+	t.Run("deve publicar na ordem e marcar os eventos", func(t *testing.T) {
+		s := newOutbox(t, eventos...)
+		b := broker.NewMemory()
+
+		r := relay.New(relay.Options{Store: s, Broker: b, Intervalo: time.Hour, Lote: 10})
+
+		assert.Equal(t, 4, r.Processar(ctx))
+		assert.Equal(t, []string{"e1", "e2", "e3", "e4"}, codigos(b.Mensagens()))
+
+		m := b.Mensagens()[2]
+		assert.Equal(t, "p1", m.Chave)
+		assert.Equal(t, model.EventoProdutoAlterado, m.Tipo)
+		assert.Equal(t, `{"codigo":"e3"}`, string(m.Payload))
+
+		// os eventos publicados não são publicados de novo
+		assert.Equal(t, 0, r.Processar(ctx))
+		assert.Len(t, b.Mensagens(), 4)
+	})
+
+	t.Run("deve segurar os eventos seguintes do produto que falhou", func(t *testing.T) {
+		s := newOutbox(t, eventos...)
+		b := &brokerFalho{Memory: broker.NewMemory(), chave: "p1"}
+
+		r := relay.New(relay.Options{Store: s, Broker: b, Intervalo: time.Hour, Lote: 10})
+
+		assert.Equal(t, 2, r.Processar(ctx))
+		assert.Equal(t, []string{"e2", "e4"}, codigos(b.Mensagens()))
+
+		// os seguintes do produto bloqueado ficam de fora dos pendentes até o primeiro sair
+		pendentes, _ := s.FindPendentes(ctx, 10)
+		if assert.Len(t, *pendentes, 1) {
+			assert.Equal(t, "e1", (*pendentes)[0].Codigo)
+			assert.Equal(t, 1, (*pendentes)[0].Tentativas)
+			assert.NotEmpty(t, (*pendentes)[0].UltimoErro)
+		}
+
+		// com o broker de volta os eventos saem na ordem em que foram gravados
+		b.chave = ""
+		assert.Equal(t, 1, r.Processar(ctx))
+		assert.Equal(t, 1, r.Processar(ctx))
+		assert.Equal(t, []string{"e2", "e4", "e1", "e3"}, codigos(b.Mensagens()))
+	})
+
+	t.Run("não deve deixar um produto bloqueado ocupar o lote", func(t *testing.T) {
+		s := newOutbox(t,
+			model.EventoOutbox{Codigo: "e1", Agregado: "p1", Tipo: model.EventoProdutoCriado, Payload: "{}"},
+			model.EventoOutbox{Codigo: "e2", Agregado: "p1", Tipo: model.EventoProdutoAlterado, Payload: "{}"},
+			model.EventoOutbox{Codigo: "e3", Agregado: "p1", Tipo: model.EventoProdutoAlterado, Payload: "{}"},
+			model.EventoOutbox{Codigo: "e4", Agregado: "p2", Tipo: model.EventoProdutoCriado, Payload: "{}"},
+		)
+		b := &brokerFalho{Memory: broker.NewMemory(), chave: "p1"}
+
+		r := relay.New(relay.Options{Store: s, Broker: b, Intervalo: time.Hour, Lote: 2})
+
+		assert.Equal(t, 0, r.Processar(ctx))
+		assert.Equal(t, 1, r.Processar(ctx))
+		assert.Equal(t, []string{"e4"}, codigos(b.Mensagens()))
+	})
+
+	t.Run("deve marcar como falho o evento que esgotou as tentativas", func(t *testing.T) {
+		s := newOutbox(t, eventos...)
+		b := &brokerFalho{Memory: broker.NewMemory(), codigo: "e1"}
+
+		r := relay.New(relay.Options{Store: s, Broker: b, Intervalo: time.Hour, Lote: 10, MaxTentativas: 2})
+
+		assert.Equal(t, 2, r.Processar(ctx))
+		assert.Equal(t, 0, r.Processar(ctx))
+
+		// descartado o e1, o evento seguinte do produto é liberado
+		assert.Equal(t, 1, r.Processar(ctx))
+		assert.Equal(t, []string{"e2", "e4", "e3"}, codigos(b.Mensagens()))
+
+		pendentes, _ := s.FindPendentes(ctx, 10)
+		assert.Empty(t, *pendentes)
+	})
+
+	t.Run("deve manter os eventos com o broker fora do ar", func(t *testing.T) {
+		s := newOutbox(t, eventos...)
+		b := broker.NewMemory()
+		b.Falhar(errors.New("broker fora do ar"))
+
+		r := relay.New(relay.Options{Store: s, Broker: b, Intervalo: time.Hour, Lote: 10})
+
+		assert.Equal(t, 0, r.Processar(ctx))
+
+		pendentes, _ := s.FindPendentes(ctx, 10)
+		if assert.Len(t, *pendentes, 2) {
+			assert.False(t, (*pendentes)[0].Falhou)
+			assert.Equal(t, 1, (*pendentes)[0].Tentativas)
+		}
+	})
+}
+
+func Test_Start(t *testing.T) {
+	s := newOutbox(t, model.EventoOutbox{Codigo: "e1", Agregado: "p1", Tipo: model.EventoProdutoCriado, Payload: "{}"})
+	b := broker.NewMemory()
+
+	r := relay.New(relay.Options{Store: s, Broker: b, Intervalo: 10 * time.Millisecond, Lote: 10, Retencao: time.Hour})
+	r.Start()
+
+	assert.Eventually(t, func() bool { return len(b.Mensagens()) == 1 }, time.Second, 10*time.Millisecond)
+
+	r.Stop()
+}
@@ -0,0 +1,201 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/GianGoulart/CrudProdutos/app"
+	"github.com/GianGoulart/CrudProdutos/logger"
+	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/GianGoulart/CrudProdutos/proto/produtopb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrProdutoNaoEncontrado status retornado quando o codigo não corresponde a um produto
+var ErrProdutoNaoEncontrado = status.Error(codes.NotFound, "produto não encontrado")
+
+// produtoServer implementa o ProdutoService sobre o serviço de produto. Assim como
+// na api http, os erros de leitura são internos e os de escrita são do argumento
+type produtoServer struct {
+	produtopb.UnimplementedProdutoServiceServer
+
+	apps *app.Container
+}
+
+func (s *produtoServer) ListProdutos(ctx context.Context, req *produtopb.ListProdutosRequest) (*produtopb.ListProdutosResponse, error) {
+	produtos, err := s.listar(ctx, req)
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).Error("rpc.produto.ListProdutos")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &produtopb.ListProdutosResponse{Produtos: toProtos(*produtos)}, nil
+}
+
+// ExportProdutos envia um produto por mensagem, parando quando o cliente cancela
+func (s *produtoServer) ExportProdutos(req *produtopb.ListProdutosRequest, stream produtopb.ProdutoService_ExportProdutosServer) error {
+	ctx := stream.Context()
+
+	produtos, err := s.listar(ctx, req)
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).Error("rpc.produto.ExportProdutos")
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	for i := range *produtos {
+		if err := ctx.Err(); err != nil {
+			return status.FromContextError(err).Err()
+		}
+
+		if err := stream.Send(toProto(&(*produtos)[i])); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *produtoServer) GetProduto(ctx context.Context, req *produtopb.GetProdutoRequest) (*produtopb.Produto, error) {
+	if req.GetCodigo() == "" {
+		return nil, status.Error(codes.InvalidArgument, "codigo é obrigatório")
+	}
+
+	produto, err := s.apps.Produto.GetProdutoByCodigo(ctx, req.GetCodigo())
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).Error("rpc.produto.GetProduto")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if produto == nil || produto.Codigo == "" {
+		return nil, ErrProdutoNaoEncontrado
+	}
+
+	return toProto(produto), nil
+}
+
+func (s *produtoServer) GetProdutosByNome(ctx context.Context, req *produtopb.GetProdutosByNomeRequest) (*produtopb.ListProdutosResponse, error) {
+	produtos, err := s.apps.Produto.GetProdutoByNome(ctx, req.GetNome())
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).Error("rpc.produto.GetProdutosByNome")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &produtopb.ListProdutosResponse{Produtos: toProtos(*produtos)}, nil
+}
+
+func (s *produtoServer) CreateProduto(ctx context.Context, req *produtopb.CreateProdutoRequest) (*produtopb.Produto, error) {
+	if req.GetProduto() == nil {
+		return nil, status.Error(codes.InvalidArgument, "produto é obrigatório")
+	}
+
+	produto, err := s.apps.Produto.CreateProduto(ctx, fromProto(req.GetProduto()))
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).Error("rpc.produto.CreateProduto")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return toProto(produto), nil
+}
+
+func (s *produtoServer) UpdateProduto(ctx context.Context, req *produtopb.UpdateProdutoRequest) (*produtopb.Produto, error) {
+	if req.GetProduto().GetCodigo() == "" {
+		return nil, status.Error(codes.InvalidArgument, "codigo é obrigatório")
+	}
+
+	produto, err := s.apps.Produto.UpdateProduto(ctx, fromProto(req.GetProduto()))
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).Error("rpc.produto.UpdateProduto")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return toProto(produto), nil
+}
+
+func (s *produtoServer) DeleteProduto(ctx context.Context, req *produtopb.DeleteProdutoRequest) (*produtopb.Produto, error) {
+	produto, err := s.apps.Produto.DeleteProduto(ctx, req.GetCodigo())
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).Error("rpc.produto.DeleteProduto")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if produto == nil || produto.Codigo == "" {
+		return nil, ErrProdutoNaoEncontrado
+	}
+
+	return toProto(produto), nil
+}
+
+// listar aplica os mesmos filtros da listagem da api http
+func (s *produtoServer) listar(ctx context.Context, req *produtopb.ListProdutosRequest) (*[]model.Produto, error) {
+	filtro := model.FiltroProduto{
+		Categoria:  req.GetCategoria(),
+		Marca:      req.GetMarca(),
+		Fornecedor: req.GetFornecedor(),
+	}
+
+	if filtro.Vazio() {
+		return s.apps.Produto.GetProdutos(ctx)
+	}
+
+	return s.apps.Produto.FiltrarProdutos(ctx, filtro)
+}
+
+func toProtos(produtos []model.Produto) []*produtopb.Produto {
+	res := make([]*produtopb.Produto, 0, len(produtos))
+	for i := range produtos {
+		res = append(res, toProto(&produtos[i]))
+	}
+
+	return res
+}
+
+func toProto(p *model.Produto) *produtopb.Produto {
+	res := &produtopb.Produto{
+		Codigo:            p.Codigo,
+		Nome:              p.Nome,
+		PrecoDe:           p.PrecoDe,
+		PrecoPor:          p.PrecoPor,
+		CriadoEm:          p.CriadoEm,
+		UltimaAlteracao:   p.UltimaAlteracao,
+		EstoqueTotal:      p.EstoqueTotal,
+		EstoqueCorte:      p.EstoqueCorte,
+		EstoqueDisponivel: p.EstoqueDisponivel,
+		Marca:             p.Marca,
+		LimiteAlerta:      p.LimiteAlerta,
+	}
+
+	for _, v := range p.Variacoes {
+		res.Variacoes = append(res.Variacoes, &produtopb.Variacao{
+			Sku:               v.SKU,
+			Produto:           v.ProdutoCodigo,
+			Tamanho:           v.Tamanho,
+			Cor:               v.Cor,
+			Voltagem:          v.Voltagem,
+			PrecoDe:           v.PrecoDe,
+			PrecoPor:          v.PrecoPor,
+			EstoqueTotal:      v.EstoqueTotal,
+			EstoqueCorte:      v.EstoqueCorte,
+			EstoqueDisponivel: v.EstoqueDisponivel,
+			CriadoEm:          v.CriadoEm,
+			UltimaAlteracao:   v.UltimaAlteracao,
+		})
+	}
+
+	return res
+}
+
+// fromProto converte o produto recebido, sem as variações que são mantidas pelas
+// rotas próprias da api http
+func fromProto(p *produtopb.Produto) *model.Produto {
+	return &model.Produto{
+		Codigo:            p.GetCodigo(),
+		Nome:              p.GetNome(),
+		PrecoDe:           p.GetPrecoDe(),
+		PrecoPor:          p.GetPrecoPor(),
+		EstoqueTotal:      p.GetEstoqueTotal(),
+		EstoqueCorte:      p.GetEstoqueCorte(),
+		EstoqueDisponivel: p.GetEstoqueDisponivel(),
+		Marca:             p.GetMarca(),
+		LimiteAlerta:      p.GetLimiteAlerta(),
+	}
+}
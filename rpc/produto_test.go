@@ -0,0 +1,183 @@
+package rpc_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/GianGoulart/CrudProdutos/app"
+	"github.com/GianGoulart/CrudProdutos/mocks"
+	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/GianGoulart/CrudProdutos/proto/produtopb"
+	"github.com/GianGoulart/CrudProdutos/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+var (
+	res = []model.Produto{
+		{Codigo: "p1", Nome: "Televisao SAMSUNG", PrecoDe: 2500, PrecoPor: 2200, EstoqueTotal: 100, EstoqueCorte: 10, EstoqueDisponivel: 90},
+		{Codigo: "p2", Nome: "Geladeira", PrecoDe: 3000, PrecoPor: 3000, Variacoes: []model.Variacao{{SKU: "p2-110", Voltagem: "110"}}},
+	}
+	erro = errors.New("ocorreu um erro")
+)
+
+// newClient sobe o server gRPC em memória com o serviço de produto informado
+func newClient(t *testing.T, produto *mocks.IProdutoApp) produtopb.ProdutoServiceClient {
+	lis := bufconn.Listen(1024 * 1024)
+
+	s := rpc.New(rpc.Options{Apps: &app.Container{Produto: produto}})
+	go s.Serve(lis)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithInsecure(),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		conn.Close()
+		s.Stop()
+	})
+
+	return produtopb.NewProdutoServiceClient(conn)
+}
+
+func Test_GetProduto(t *testing.T) {
+	cases := map[string]struct {
+		Input        string
+		ExpectedCode codes.Code
+
+		PrepareMock func(mock *mocks.IProdutoApp)
+	}{
+		"deve retornar o produto": {Input: "p2", ExpectedCode: codes.OK, PrepareMock: func(m *mocks.IProdutoApp) {
+			m.On("GetProdutoByCodigo", mock.Anything, "p2").Return(&res[1], nil)
+		}},
+		"deve retornar not found": {Input: "xpto", ExpectedCode: codes.NotFound, PrepareMock: func(m *mocks.IProdutoApp) {
+			m.On("GetProdutoByCodigo", mock.Anything, "xpto").Return(&model.Produto{}, nil)
+		}},
+		"deve retornar erro interno": {Input: "p1", ExpectedCode: codes.Internal, PrepareMock: func(m *mocks.IProdutoApp) {
+			m.On("GetProdutoByCodigo", mock.Anything, "p1").Return(nil, erro)
+		}},
+		"deve exigir o codigo": {ExpectedCode: codes.InvalidArgument, PrepareMock: func(m *mocks.IProdutoApp) {}},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			m := new(mocks.IProdutoApp)
+			cs.PrepareMock(m)
+
+			resp, err := newClient(t, m).GetProduto(context.Background(), &produtopb.GetProdutoRequest{Codigo: cs.Input})
+
+			assert.Equal(t, cs.ExpectedCode, status.Code(err))
+			if cs.ExpectedCode == codes.OK {
+				assert.Equal(t, "p2", resp.GetCodigo())
+				assert.Equal(t, "110", resp.GetVariacoes()[0].GetVoltagem())
+			}
+
+			m.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_CreateProduto(t *testing.T) {
+	cases := map[string]struct {
+		Input        *produtopb.Produto
+		ExpectedCode codes.Code
+
+		PrepareMock func(mock *mocks.IProdutoApp)
+	}{
+		"deve criar o produto": {Input: &produtopb.Produto{Nome: "Televisao SAMSUNG", PrecoDe: 2500, PrecoPor: 2200}, ExpectedCode: codes.OK, PrepareMock: func(m *mocks.IProdutoApp) {
+			m.On("CreateProduto", mock.Anything, &model.Produto{Nome: "Televisao SAMSUNG", PrecoDe: 2500, PrecoPor: 2200}).Return(&res[0], nil)
+		}},
+		"deve retornar erro de argumento": {Input: &produtopb.Produto{Nome: "Televisao SAMSUNG", PrecoDe: 1, PrecoPor: 2}, ExpectedCode: codes.InvalidArgument, PrepareMock: func(m *mocks.IProdutoApp) {
+			m.On("CreateProduto", mock.Anything, mock.Anything).Return(nil, erro)
+		}},
+		"deve exigir o produto": {ExpectedCode: codes.InvalidArgument, PrepareMock: func(m *mocks.IProdutoApp) {}},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			m := new(mocks.IProdutoApp)
+			cs.PrepareMock(m)
+
+			_, err := newClient(t, m).CreateProduto(context.Background(), &produtopb.CreateProdutoRequest{Produto: cs.Input})
+
+			assert.Equal(t, cs.ExpectedCode, status.Code(err))
+
+			m.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_DeleteProduto(t *testing.T) {
+	m := new(mocks.IProdutoApp)
+	m.On("DeleteProduto", mock.Anything, "p1").Return(&res[0], nil)
+	m.On("DeleteProduto", mock.Anything, "xpto").Return(&model.Produto{}, nil)
+
+	client := newClient(t, m)
+
+	resp, err := client.DeleteProduto(context.Background(), &produtopb.DeleteProdutoRequest{Codigo: "p1"})
+	assert.NoError(t, err)
+	assert.Equal(t, "p1", resp.GetCodigo())
+
+	_, err = client.DeleteProduto(context.Background(), &produtopb.DeleteProdutoRequest{Codigo: "xpto"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func Test_ExportProdutos(t *testing.T) {
+	cases := map[string]struct {
+		Input        *produtopb.ListProdutosRequest
+		ExpectedCode codes.Code
+		ExpectedData []string
+
+		PrepareMock func(mock *mocks.IProdutoApp)
+	}{
+		"deve enviar todos os produtos": {Input: &produtopb.ListProdutosRequest{}, ExpectedData: []string{"p1", "p2"}, PrepareMock: func(m *mocks.IProdutoApp) {
+			m.On("GetProdutos", mock.Anything).Return(&res, nil)
+		}},
+		"deve aplicar os filtros": {Input: &produtopb.ListProdutosRequest{Marca: "samsung"}, ExpectedData: []string{"p1"}, PrepareMock: func(m *mocks.IProdutoApp) {
+			m.On("FiltrarProdutos", mock.Anything, model.FiltroProduto{Marca: "samsung"}).Return(&[]model.Produto{res[0]}, nil)
+		}},
+		"deve retornar erro interno": {Input: &produtopb.ListProdutosRequest{}, ExpectedCode: codes.Internal, PrepareMock: func(m *mocks.IProdutoApp) {
+			m.On("GetProdutos", mock.Anything).Return(nil, erro)
+		}},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			m := new(mocks.IProdutoApp)
+			cs.PrepareMock(m)
+
+			stream, err := newClient(t, m).ExportProdutos(context.Background(), cs.Input)
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			var codigos []string
+			for {
+				p, err := stream.Recv()
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					assert.Equal(t, cs.ExpectedCode, status.Code(err))
+					break
+				}
+				codigos = append(codigos, p.GetCodigo())
+			}
+
+			assert.Equal(t, cs.ExpectedData, codigos)
+
+			m.AssertExpectations(t)
+		})
+	}
+}
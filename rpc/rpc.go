@@ -0,0 +1,68 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/GianGoulart/CrudProdutos/app"
+	"github.com/GianGoulart/CrudProdutos/logger"
+	"github.com/GianGoulart/CrudProdutos/proto/produtopb"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// Options struct de opções para a criação do server gRPC
+type Options struct {
+	Apps *app.Container
+}
+
+// New cria o server gRPC com os serviços registrados. Os serviços são os mesmos
+// usados pela api http
+func New(opts Options) *grpc.Server {
+	s := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(unaryLogger),
+		grpc.ChainStreamInterceptor(streamLogger),
+	)
+
+	produtopb.RegisterProdutoServiceServer(s, &produtoServer{apps: opts.Apps})
+
+	logrus.Info("Registered -> gRPC")
+
+	return s
+}
+
+// headerRequestID metadata com o id da requisição, o mesmo header da api http
+const headerRequestID = "x-request-id"
+
+// withLogger guarda no context o logger da chamada com o request id e o método
+func withLogger(ctx context.Context, method string) context.Context {
+	fields := logrus.Fields{
+		"method": method,
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if ids := md.Get(headerRequestID); len(ids) > 0 {
+			fields["request_id"] = ids[0]
+		}
+	}
+
+	return logger.WithContext(ctx, logrus.WithFields(fields))
+}
+
+func unaryLogger(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	return handler(withLogger(ctx, info.FullMethod), req)
+}
+
+func streamLogger(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, &serverStream{ServerStream: ss, ctx: withLogger(ss.Context(), info.FullMethod)})
+}
+
+// serverStream troca o context do stream pelo que carrega o logger
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
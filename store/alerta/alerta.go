@@ -0,0 +1,104 @@
+package alerta
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/GianGoulart/CrudProdutos/logger"
+	"github.com/GianGoulart/CrudProdutos/model"
+	"gorm.io/gorm"
+)
+
+// IAlertaStore interface para implementação do repositorio de alertas
+type IAlertaStore interface {
+	FindAlertas(ctx context.Context, filtro model.FiltroAlerta) (*[]model.Alerta, error)
+	FindAlertaByCodigo(ctx context.Context, codigo string) (*model.Alerta, error)
+	CreateAlerta(ctx context.Context, alerta *model.Alerta) (*model.Alerta, error)
+	ReconhecerAlerta(ctx context.Context, alerta *model.Alerta) (*model.Alerta, error)
+}
+
+// NewAlerta cria uma nova instancia do repositorio de alerta
+func NewAlerta(reader *gorm.DB) IAlertaStore {
+	return &storeImpl{reader}
+}
+
+type storeImpl struct {
+	db *gorm.DB
+}
+
+const (
+	layout = "02-01-2006T15:04:05"
+)
+
+func (r *storeImpl) FindAlertas(ctx context.Context, filtro model.FiltroAlerta) (*[]model.Alerta, error) {
+	alertas := new([]model.Alerta)
+
+	query := r.db.WithContext(ctx)
+	if filtro.Produto != "" {
+		query = query.Where("produto_codigo = ?", filtro.Produto)
+	}
+	if filtro.Reconhecido != nil {
+		query = query.Where("reconhecido = ?", *filtro.Reconhecido)
+	}
+
+	if err := query.Find(&alertas).Error; err != nil {
+		logger.FromContext(ctx).WithError(err).Error("store.alerta.FindAlertas")
+		return alertas, err
+	}
+
+	ordenar(*alertas)
+
+	return alertas, nil
+}
+
+func (r *storeImpl) FindAlertaByCodigo(ctx context.Context, codigo string) (*model.Alerta, error) {
+	res := new(model.Alerta)
+
+	if err := r.db.WithContext(ctx).Where(&model.Alerta{Codigo: codigo}).Find(res).Error; err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("codigo", codigo).Error("store.alerta.FindAlertaByCodigo")
+		return res, err
+	}
+
+	return res, nil
+}
+
+func (r *storeImpl) CreateAlerta(ctx context.Context, alerta *model.Alerta) (*model.Alerta, error) {
+	alerta.CriadoEm = time.Now().Format(layout)
+
+	exec := "INSERT INTO alertas (codigo,produto_codigo,nome,nivel,estoque_disponivel,limite,reconhecido,reconhecido_em,criado_em) VALUES (?,?,?,?,?,?,?,?,?)"
+
+	if err := r.db.WithContext(ctx).Exec(exec, alerta.Codigo, alerta.ProdutoCodigo, alerta.Nome, alerta.Nivel, alerta.EstoqueDisponivel, alerta.Limite, alerta.Reconhecido, alerta.ReconhecidoEm, alerta.CriadoEm).Error; err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("produto", alerta.ProdutoCodigo).Error("store.alerta.CreateAlerta")
+		return &model.Alerta{}, err
+	}
+
+	return alerta, nil
+}
+
+func (r *storeImpl) ReconhecerAlerta(ctx context.Context, alerta *model.Alerta) (*model.Alerta, error) {
+	alerta.Reconhecido = true
+	alerta.ReconhecidoEm = time.Now().Format(layout)
+
+	exec := "UPDATE alertas SET reconhecido=?,reconhecido_em=? WHERE codigo=?"
+
+	if err := r.db.WithContext(ctx).Exec(exec, alerta.Reconhecido, alerta.ReconhecidoEm, alerta.Codigo).Error; err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("codigo", alerta.Codigo).Error("store.alerta.ReconhecerAlerta")
+		return &model.Alerta{}, err
+	}
+
+	return alerta, nil
+}
+
+// ordenar coloca os alertas mais recentes primeiro. O layout das datas começa
+// pelo dia e não pode ser ordenado como texto
+func ordenar(alertas []model.Alerta) {
+	sort.SliceStable(alertas, func(i, j int) bool {
+		a, _ := time.Parse(layout, alertas[i].CriadoEm)
+		b, _ := time.Parse(layout, alertas[j].CriadoEm)
+		if !a.Equal(b) {
+			return a.After(b)
+		}
+		return alertas[i].Codigo < alertas[j].Codigo
+	})
+}
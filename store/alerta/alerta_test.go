@@ -0,0 +1,61 @@
+package alerta_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/GianGoulart/CrudProdutos/store/alerta"
+	"github.com/GianGoulart/CrudProdutos/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Alerta(t *testing.T) {
+	cases := map[string]struct {
+		Store alerta.IAlertaStore
+	}{
+		"sqlite":  {Store: alerta.NewAlerta(test.GetSQLite(t, model.Alerta{}))},
+		"memoria": {Store: alerta.NewAlertaMemory()},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			s := cs.Store
+
+			for _, a := range []model.Alerta{
+				{Codigo: "a1", ProdutoCodigo: "p1", Nivel: model.AlertaEstoqueBaixo, EstoqueDisponivel: 3, Limite: 5},
+				{Codigo: "a2", ProdutoCodigo: "p1", Nivel: model.AlertaEstoqueEsgotado, Limite: 5},
+				{Codigo: "a3", ProdutoCodigo: "p2", Nivel: model.AlertaEstoqueBaixo, EstoqueDisponivel: 1, Limite: 1},
+			} {
+				a := a
+				_, err := s.CreateAlerta(ctx, &a)
+				assert.NoError(t, err)
+			}
+
+			found, err := s.FindAlertaByCodigo(ctx, "a1")
+			assert.NoError(t, err)
+			assert.Equal(t, int64(3), found.EstoqueDisponivel)
+			assert.NotEmpty(t, found.CriadoEm)
+
+			_, err = s.ReconhecerAlerta(ctx, found)
+			assert.NoError(t, err)
+
+			found, err = s.FindAlertaByCodigo(ctx, "a1")
+			assert.NoError(t, err)
+			assert.True(t, found.Reconhecido)
+			assert.NotEmpty(t, found.ReconhecidoEm)
+
+			alertas, err := s.FindAlertas(ctx, model.FiltroAlerta{})
+			assert.NoError(t, err)
+			assert.Len(t, *alertas, 3)
+
+			reconhecido := false
+			alertas, err = s.FindAlertas(ctx, model.FiltroAlerta{Produto: "p1", Reconhecido: &reconhecido})
+			assert.NoError(t, err)
+			if assert.Len(t, *alertas, 1) {
+				assert.Equal(t, "a2", (*alertas)[0].Codigo)
+			}
+		})
+	}
+}
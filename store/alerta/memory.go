@@ -0,0 +1,91 @@
+package alerta
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/GianGoulart/CrudProdutos/logger"
+	"github.com/GianGoulart/CrudProdutos/model"
+)
+
+// ErrAlertaDuplicado erro retornado ao criar um alerta com um codigo já existente
+var ErrAlertaDuplicado = errors.New("alerta já cadastrado")
+
+// NewAlertaMemory cria uma nova instancia do repositorio de alerta em memória
+func NewAlertaMemory() IAlertaStore {
+	return &memoryImpl{
+		alertas: make(map[string]model.Alerta),
+	}
+}
+
+type memoryImpl struct {
+	mu      sync.RWMutex
+	alertas map[string]model.Alerta
+}
+
+func (r *memoryImpl) FindAlertas(ctx context.Context, filtro model.FiltroAlerta) (*[]model.Alerta, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	alertas := make([]model.Alerta, 0)
+	for _, alerta := range r.alertas {
+		if filtro.Produto != "" && alerta.ProdutoCodigo != filtro.Produto {
+			continue
+		}
+		if filtro.Reconhecido != nil && alerta.Reconhecido != *filtro.Reconhecido {
+			continue
+		}
+		alertas = append(alertas, alerta)
+	}
+
+	ordenar(alertas)
+
+	return &alertas, nil
+}
+
+func (r *memoryImpl) FindAlertaByCodigo(ctx context.Context, codigo string) (*model.Alerta, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	// assim como no banco, um codigo inexistente retorna um alerta vazio
+	alerta := r.alertas[codigo]
+
+	return &alerta, nil
+}
+
+func (r *memoryImpl) CreateAlerta(ctx context.Context, alerta *model.Alerta) (*model.Alerta, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.alertas[alerta.Codigo]; ok {
+		logger.FromContext(ctx).WithError(ErrAlertaDuplicado).WithField("codigo", alerta.Codigo).Error("store.alerta.memory.CreateAlerta")
+		return &model.Alerta{}, ErrAlertaDuplicado
+	}
+
+	alerta.CriadoEm = time.Now().Format(layout)
+
+	r.alertas[alerta.Codigo] = *alerta
+
+	return alerta, nil
+}
+
+func (r *memoryImpl) ReconhecerAlerta(ctx context.Context, alerta *model.Alerta) (*model.Alerta, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	alerta.Reconhecido = true
+	alerta.ReconhecidoEm = time.Now().Format(layout)
+
+	atual, ok := r.alertas[alerta.Codigo]
+	if !ok {
+		return alerta, nil
+	}
+
+	atual.Reconhecido = alerta.Reconhecido
+	atual.ReconhecidoEm = alerta.ReconhecidoEm
+	r.alertas[alerta.Codigo] = atual
+
+	return alerta, nil
+}
@@ -0,0 +1,62 @@
+package cache
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+)
+
+// Cache interface dos backends de cache
+type Cache interface {
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	// SetNX grava o valor apenas quando a chave não existe, de forma atômica, e
+	// retorna se gravou
+	SetNX(ctx context.Context, key string, value []byte, ttl time.Duration) (bool, error)
+	Delete(ctx context.Context, key string) error
+	Stats() Stats
+}
+
+// Stats estatísticas de uso do cache
+type Stats struct {
+	Backend   string `json:"backend"`
+	Hits      uint64 `json:"hits"`
+	Misses    uint64 `json:"misses"`
+	Sets      uint64 `json:"sets"`
+	Deletes   uint64 `json:"deletes"`
+	Evictions uint64 `json:"evictions"`
+	Size      int    `json:"size"`
+}
+
+// HitRatio retorna a proporção de acertos sobre o total de leituras
+func (s Stats) HitRatio() float64 {
+	total := s.Hits + s.Misses
+	if total == 0 {
+		return 0
+	}
+
+	return float64(s.Hits) / float64(total)
+}
+
+// counters contadores compartilhados pelos backends
+type counters struct {
+	hits, misses, sets, deletes, evictions uint64
+}
+
+func (c *counters) hit()   { atomic.AddUint64(&c.hits, 1) }
+func (c *counters) miss()  { atomic.AddUint64(&c.misses, 1) }
+func (c *counters) set()   { atomic.AddUint64(&c.sets, 1) }
+func (c *counters) del()   { atomic.AddUint64(&c.deletes, 1) }
+func (c *counters) evict() { atomic.AddUint64(&c.evictions, 1) }
+
+func (c *counters) stats(backend string, size int) Stats {
+	return Stats{
+		Backend:   backend,
+		Hits:      atomic.LoadUint64(&c.hits),
+		Misses:    atomic.LoadUint64(&c.misses),
+		Sets:      atomic.LoadUint64(&c.sets),
+		Deletes:   atomic.LoadUint64(&c.deletes),
+		Evictions: atomic.LoadUint64(&c.evictions),
+		Size:      size,
+	}
+}
@@ -0,0 +1,131 @@
+package cache
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+)
+
+// NewLRU cria um cache em memória do processo que descarta os itens menos
+// usados quando atinge o tamanho máximo
+func NewLRU(size int) Cache {
+	return &lruImpl{
+		size:  size,
+		ll:    list.New(),
+		items: make(map[string]*list.Element),
+		now:   time.Now,
+	}
+}
+
+type lruEntry struct {
+	key       string
+	value     []byte
+	expiresAt time.Time
+}
+
+type lruImpl struct {
+	mu sync.Mutex
+	counters
+
+	size  int
+	ll    *list.List
+	items map[string]*list.Element
+	now   func() time.Time
+}
+
+func (c *lruImpl) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.items[key]
+	if !ok {
+		c.miss()
+		return nil, false, nil
+	}
+
+	entry := el.Value.(*lruEntry)
+	if !entry.expiresAt.IsZero() && c.now().After(entry.expiresAt) {
+		c.remove(el)
+		c.miss()
+		return nil, false, nil
+	}
+
+	c.ll.MoveToFront(el)
+	c.hit()
+
+	return entry.value, true, nil
+}
+
+func (c *lruImpl) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.store(key, value, ttl)
+
+	return nil
+}
+
+func (c *lruImpl) SetNX(ctx context.Context, key string, value []byte, ttl time.Duration) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.items[key]; ok {
+		entry := el.Value.(*lruEntry)
+		if entry.expiresAt.IsZero() || !c.now().After(entry.expiresAt) {
+			return false, nil
+		}
+	}
+
+	c.store(key, value, ttl)
+
+	return true, nil
+}
+
+// store grava o valor com o lock já adquirido
+func (c *lruImpl) store(key string, value []byte, ttl time.Duration) {
+	var expiresAt time.Time
+	if ttl > 0 {
+		expiresAt = c.now().Add(ttl)
+	}
+
+	c.set()
+
+	if el, ok := c.items[key]; ok {
+		entry := el.Value.(*lruEntry)
+		entry.value, entry.expiresAt = value, expiresAt
+		c.ll.MoveToFront(el)
+		return
+	}
+
+	c.items[key] = c.ll.PushFront(&lruEntry{key: key, value: value, expiresAt: expiresAt})
+
+	if c.size > 0 && c.ll.Len() > c.size {
+		c.remove(c.ll.Back())
+		c.evict()
+	}
+}
+
+func (c *lruImpl) Delete(ctx context.Context, key string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.items[key]; ok {
+		c.remove(el)
+	}
+	c.del()
+
+	return nil
+}
+
+func (c *lruImpl) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.counters.stats("lru", c.ll.Len())
+}
+
+func (c *lruImpl) remove(el *list.Element) {
+	c.ll.Remove(el)
+	delete(c.items, el.Value.(*lruEntry).key)
+}
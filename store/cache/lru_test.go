@@ -0,0 +1,69 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_LRU(t *testing.T) {
+	ctx := context.Background()
+
+	cases := map[string]struct {
+		ExpectedFound bool
+		ExpectedStats Stats
+
+		Prepare func(c *lruImpl, now *time.Time)
+	}{
+		"deve encontrar a chave guardada": {ExpectedFound: true, ExpectedStats: Stats{Backend: "lru", Hits: 1, Sets: 1, Size: 1}, Prepare: func(c *lruImpl, now *time.Time) {
+			c.Set(ctx, "a", []byte("1"), time.Minute)
+		}},
+		"deve expirar a chave após o ttl": {ExpectedFound: false, ExpectedStats: Stats{Backend: "lru", Misses: 1, Sets: 1}, Prepare: func(c *lruImpl, now *time.Time) {
+			c.Set(ctx, "a", []byte("1"), time.Minute)
+			*now = now.Add(2 * time.Minute)
+		}},
+		"deve descartar a chave menos usada": {ExpectedFound: false, ExpectedStats: Stats{Backend: "lru", Misses: 1, Sets: 3, Evictions: 1, Size: 2}, Prepare: func(c *lruImpl, now *time.Time) {
+			c.Set(ctx, "a", []byte("1"), 0)
+			c.Set(ctx, "b", []byte("2"), 0)
+			c.Set(ctx, "c", []byte("3"), 0)
+		}},
+		"deve remover a chave no delete": {ExpectedFound: false, ExpectedStats: Stats{Backend: "lru", Misses: 1, Sets: 1, Deletes: 1}, Prepare: func(c *lruImpl, now *time.Time) {
+			c.Set(ctx, "a", []byte("1"), 0)
+			c.Delete(ctx, "a")
+		}},
+		"não deve sobrescrever a chave existente no SetNX": {ExpectedFound: true, ExpectedStats: Stats{Backend: "lru", Hits: 1, Sets: 1, Size: 1}, Prepare: func(c *lruImpl, now *time.Time) {
+			ok, err := c.SetNX(ctx, "a", []byte("1"), time.Minute)
+			assert.NoError(t, err)
+			assert.True(t, ok)
+
+			ok, err = c.SetNX(ctx, "a", []byte("2"), time.Minute)
+			assert.NoError(t, err)
+			assert.False(t, ok)
+		}},
+		"deve gravar no SetNX a chave expirada": {ExpectedFound: true, ExpectedStats: Stats{Backend: "lru", Hits: 1, Sets: 2, Size: 1}, Prepare: func(c *lruImpl, now *time.Time) {
+			c.Set(ctx, "a", []byte("1"), time.Minute)
+			*now = now.Add(2 * time.Minute)
+
+			ok, err := c.SetNX(ctx, "a", []byte("2"), time.Minute)
+			assert.NoError(t, err)
+			assert.True(t, ok)
+		}},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			now := time.Now()
+			c := NewLRU(2).(*lruImpl)
+			c.now = func() time.Time { return now }
+
+			cs.Prepare(c, &now)
+
+			_, found, err := c.Get(ctx, "a")
+			assert.NoError(t, err)
+			assert.Equal(t, cs.ExpectedFound, found)
+			assert.Equal(t, cs.ExpectedStats, c.Stats())
+		})
+	}
+}
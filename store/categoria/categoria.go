@@ -0,0 +1,179 @@
+package categoria
+
+import (
+	"context"
+	"time"
+
+	"github.com/GianGoulart/CrudProdutos/logger"
+	"github.com/GianGoulart/CrudProdutos/model"
+	"gorm.io/gorm"
+)
+
+// ICategoriaStore interface para implementação do repositorio de categorias
+type ICategoriaStore interface {
+	FindCategorias(ctx context.Context) (*[]model.Categoria, error)
+	FindCategoriaByCodigo(ctx context.Context, codigo string) (*model.Categoria, error)
+	CreateCategoria(ctx context.Context, categoria *model.Categoria) (*model.Categoria, error)
+	UpdateCategoria(ctx context.Context, categoria *model.Categoria) (*model.Categoria, error)
+	DeleteCategoriaByCodigo(ctx context.Context, categoria *model.Categoria) error
+	FindCategoriasByProduto(ctx context.Context, produto string) (*[]model.Categoria, error)
+	FindProdutosByCategorias(ctx context.Context, categorias []string) ([]string, error)
+	FindProdutoCategorias(ctx context.Context, produtos []string) (*[]model.ProdutoCategoria, error)
+	SetProdutoCategorias(ctx context.Context, produto string, categorias []string) error
+}
+
+// NewCategoria cria uma nova instancia do repositorio de categoria
+func NewCategoria(reader *gorm.DB) ICategoriaStore {
+	return &storeImpl{reader}
+}
+
+type storeImpl struct {
+	db *gorm.DB
+}
+
+const (
+	layout = "02-01-2006T15:04:05"
+)
+
+func (r *storeImpl) FindCategorias(ctx context.Context) (*[]model.Categoria, error) {
+	categorias := new([]model.Categoria)
+
+	if err := r.db.WithContext(ctx).Order("nome").Find(&categorias).Error; err != nil {
+		logger.FromContext(ctx).WithError(err).Error("store.categoria.FindCategorias")
+		return categorias, err
+	}
+
+	return categorias, nil
+}
+
+func (r *storeImpl) FindCategoriaByCodigo(ctx context.Context, codigo string) (*model.Categoria, error) {
+	res := new(model.Categoria)
+
+	if err := r.db.WithContext(ctx).Where(&model.Categoria{Codigo: codigo}).Find(res).Error; err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("codigo", codigo).Error("store.categoria.FindCategoriaByCodigo")
+		return res, err
+	}
+
+	return res, nil
+}
+
+func (r *storeImpl) CreateCategoria(ctx context.Context, categoria *model.Categoria) (*model.Categoria, error) {
+	categoria.CriadoEm = time.Now().Format(layout)
+	categoria.UltimaAlteracao = time.Now().Format(layout)
+
+	exec := "INSERT INTO categorias (codigo,nome,pai,criado_em,ultima_alteracao) VALUES (?,?,?,?,?)"
+
+	if err := r.db.WithContext(ctx).Exec(exec, categoria.Codigo, categoria.Nome, categoria.Pai, categoria.CriadoEm, categoria.UltimaAlteracao).Error; err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("codigo", categoria.Codigo).Error("store.categoria.CreateCategoria")
+		return &model.Categoria{}, err
+	}
+
+	return categoria, nil
+}
+
+func (r *storeImpl) UpdateCategoria(ctx context.Context, categoria *model.Categoria) (*model.Categoria, error) {
+	categoria.UltimaAlteracao = time.Now().Format(layout)
+
+	exec := "UPDATE categorias SET nome=?,pai=?,ultima_alteracao=? WHERE codigo=?"
+
+	if err := r.db.WithContext(ctx).Exec(exec, categoria.Nome, categoria.Pai, categoria.UltimaAlteracao, categoria.Codigo).Error; err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("codigo", categoria.Codigo).Error("store.categoria.UpdateCategoria")
+		return &model.Categoria{}, err
+	}
+
+	return categoria, nil
+}
+
+func (r *storeImpl) DeleteCategoriaByCodigo(ctx context.Context, categoria *model.Categoria) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("DELETE FROM produto_categorias WHERE categoria_codigo=?", categoria.Codigo).Error; err != nil {
+			return err
+		}
+
+		return tx.Exec("DELETE FROM categorias WHERE codigo=?", categoria.Codigo).Error
+	})
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("codigo", categoria.Codigo).Error("store.categoria.DeleteCategoriaByCodigo")
+		return err
+	}
+
+	return nil
+}
+
+func (r *storeImpl) FindCategoriasByProduto(ctx context.Context, produto string) (*[]model.Categoria, error) {
+	categorias := new([]model.Categoria)
+
+	err := r.db.WithContext(ctx).
+		Joins("JOIN produto_categorias ON produto_categorias.categoria_codigo = categorias.codigo").
+		Where("produto_categorias.produto_codigo = ?", produto).
+		Order("categorias.nome").
+		Find(&categorias).Error
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("produto", produto).Error("store.categoria.FindCategoriasByProduto")
+		return categorias, err
+	}
+
+	return categorias, nil
+}
+
+func (r *storeImpl) FindProdutosByCategorias(ctx context.Context, categorias []string) ([]string, error) {
+	codigos := []string{}
+	if len(categorias) == 0 {
+		return codigos, nil
+	}
+
+	err := r.db.WithContext(ctx).Model(&model.ProdutoCategoria{}).
+		Distinct("produto_codigo").
+		Where("categoria_codigo IN ?", categorias).
+		Order("produto_codigo").
+		Pluck("produto_codigo", &codigos).Error
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("categorias", categorias).Error("store.categoria.FindProdutosByCategorias")
+		return codigos, err
+	}
+
+	return codigos, nil
+}
+
+// FindProdutoCategorias retorna as associações dos produtos informados às suas categorias
+func (r *storeImpl) FindProdutoCategorias(ctx context.Context, produtos []string) (*[]model.ProdutoCategoria, error) {
+	associacoes := new([]model.ProdutoCategoria)
+	if len(produtos) == 0 {
+		return associacoes, nil
+	}
+
+	err := r.db.WithContext(ctx).
+		Where("produto_codigo IN ?", produtos).
+		Order("produto_codigo, categoria_codigo").
+		Find(associacoes).Error
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).Error("store.categoria.FindProdutoCategorias")
+		return associacoes, err
+	}
+
+	return associacoes, nil
+}
+
+// SetProdutoCategorias substitui as categorias associadas ao produto
+func (r *storeImpl) SetProdutoCategorias(ctx context.Context, produto string, categorias []string) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("DELETE FROM produto_categorias WHERE produto_codigo=?", produto).Error; err != nil {
+			return err
+		}
+
+		for _, categoria := range categorias {
+			exec := "INSERT INTO produto_categorias (produto_codigo,categoria_codigo) VALUES (?,?)"
+			if err := tx.Exec(exec, produto, categoria).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("produto", produto).Error("store.categoria.SetProdutoCategorias")
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package categoria_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/GianGoulart/CrudProdutos/store/categoria"
+	"github.com/GianGoulart/CrudProdutos/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Categoria(t *testing.T) {
+	cases := map[string]struct {
+		Store categoria.ICategoriaStore
+	}{
+		"sqlite":  {Store: categoria.NewCategoria(test.GetSQLite(t, model.Categoria{}, model.ProdutoCategoria{}))},
+		"memoria": {Store: categoria.NewCategoriaMemory()},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			s := cs.Store
+
+			for _, c := range []model.Categoria{
+				{Codigo: "eletro", Nome: "Eletrodomésticos"},
+				{Codigo: "tv", Nome: "Televisores", Pai: "eletro"},
+				{Codigo: "audio", Nome: "Áudio", Pai: "eletro"},
+			} {
+				c := c
+				_, err := s.CreateCategoria(ctx, &c)
+				assert.NoError(t, err)
+			}
+
+			tv := model.Categoria{Codigo: "tv", Nome: "TVs", Pai: "eletro"}
+			_, err := s.UpdateCategoria(ctx, &tv)
+			assert.NoError(t, err)
+
+			found, err := s.FindCategoriaByCodigo(ctx, "tv")
+			assert.NoError(t, err)
+			assert.Equal(t, "TVs", found.Nome)
+			assert.NotEmpty(t, found.CriadoEm)
+
+			assert.NoError(t, s.SetProdutoCategorias(ctx, "p1", []string{"tv", "audio"}))
+			assert.NoError(t, s.SetProdutoCategorias(ctx, "p2", []string{"audio"}))
+			assert.NoError(t, s.SetProdutoCategorias(ctx, "p3", []string{"tv"}))
+			assert.NoError(t, s.SetProdutoCategorias(ctx, "p3", []string{"eletro"}))
+
+			doProduto, err := s.FindCategoriasByProduto(ctx, "p1")
+			assert.NoError(t, err)
+			assert.Len(t, *doProduto, 2)
+
+			produtos, err := s.FindProdutosByCategorias(ctx, []string{"tv", "audio"})
+			assert.NoError(t, err)
+			assert.Equal(t, []string{"p1", "p2"}, produtos)
+
+			associacoes, err := s.FindProdutoCategorias(ctx, []string{"p3", "p1"})
+			assert.NoError(t, err)
+			assert.Equal(t, []model.ProdutoCategoria{
+				{ProdutoCodigo: "p1", CategoriaCodigo: "audio"},
+				{ProdutoCodigo: "p1", CategoriaCodigo: "tv"},
+				{ProdutoCodigo: "p3", CategoriaCodigo: "eletro"},
+			}, *associacoes)
+
+			assert.NoError(t, s.DeleteCategoriaByCodigo(ctx, &model.Categoria{Codigo: "audio"}))
+
+			produtos, err = s.FindProdutosByCategorias(ctx, []string{"audio"})
+			assert.NoError(t, err)
+			assert.Empty(t, produtos)
+
+			assert.NoError(t, s.SetProdutoCategorias(ctx, "p1", nil))
+
+			doProduto, err = s.FindCategoriasByProduto(ctx, "p1")
+			assert.NoError(t, err)
+			assert.Empty(t, *doProduto)
+
+			categorias, err := s.FindCategorias(ctx)
+			assert.NoError(t, err)
+			assert.Len(t, *categorias, 2)
+		})
+	}
+}
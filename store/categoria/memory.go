@@ -0,0 +1,180 @@
+package categoria
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/GianGoulart/CrudProdutos/logger"
+	"github.com/GianGoulart/CrudProdutos/model"
+)
+
+// ErrCategoriaDuplicada erro retornado ao criar uma categoria com um codigo já existente
+var ErrCategoriaDuplicada = errors.New("categoria já cadastrada")
+
+// NewCategoriaMemory cria uma nova instancia do repositorio de categoria em memória
+func NewCategoriaMemory() ICategoriaStore {
+	return &memoryImpl{
+		categorias: make(map[string]model.Categoria),
+		produtos:   make(map[string]map[string]bool),
+	}
+}
+
+type memoryImpl struct {
+	mu         sync.RWMutex
+	categorias map[string]model.Categoria
+	// produtos categorias associadas a cada produto
+	produtos map[string]map[string]bool
+}
+
+func (r *memoryImpl) FindCategorias(ctx context.Context) (*[]model.Categoria, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	categorias := make([]model.Categoria, 0, len(r.categorias))
+	for _, categoria := range r.categorias {
+		categorias = append(categorias, categoria)
+	}
+
+	sortByNome(categorias)
+
+	return &categorias, nil
+}
+
+func (r *memoryImpl) FindCategoriaByCodigo(ctx context.Context, codigo string) (*model.Categoria, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	// assim como no banco, um codigo inexistente retorna uma categoria vazia
+	categoria := r.categorias[codigo]
+
+	return &categoria, nil
+}
+
+func (r *memoryImpl) CreateCategoria(ctx context.Context, categoria *model.Categoria) (*model.Categoria, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.categorias[categoria.Codigo]; ok {
+		logger.FromContext(ctx).WithError(ErrCategoriaDuplicada).WithField("codigo", categoria.Codigo).Error("store.categoria.memory.CreateCategoria")
+		return &model.Categoria{}, ErrCategoriaDuplicada
+	}
+
+	categoria.CriadoEm = time.Now().Format(layout)
+	categoria.UltimaAlteracao = time.Now().Format(layout)
+
+	r.categorias[categoria.Codigo] = *categoria
+
+	return categoria, nil
+}
+
+func (r *memoryImpl) UpdateCategoria(ctx context.Context, categoria *model.Categoria) (*model.Categoria, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	categoria.UltimaAlteracao = time.Now().Format(layout)
+
+	atual, ok := r.categorias[categoria.Codigo]
+	if !ok {
+		return categoria, nil
+	}
+
+	categoria.CriadoEm = atual.CriadoEm
+	r.categorias[categoria.Codigo] = *categoria
+
+	return categoria, nil
+}
+
+func (r *memoryImpl) DeleteCategoriaByCodigo(ctx context.Context, categoria *model.Categoria) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.categorias, categoria.Codigo)
+	for _, categorias := range r.produtos {
+		delete(categorias, categoria.Codigo)
+	}
+
+	return nil
+}
+
+func (r *memoryImpl) FindCategoriasByProduto(ctx context.Context, produto string) (*[]model.Categoria, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	categorias := make([]model.Categoria, 0)
+	for codigo := range r.produtos[produto] {
+		if categoria, ok := r.categorias[codigo]; ok {
+			categorias = append(categorias, categoria)
+		}
+	}
+
+	sortByNome(categorias)
+
+	return &categorias, nil
+}
+
+func (r *memoryImpl) FindProdutosByCategorias(ctx context.Context, categorias []string) ([]string, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	codigos := []string{}
+	for produto, associadas := range r.produtos {
+		for _, categoria := range categorias {
+			if associadas[categoria] {
+				codigos = append(codigos, produto)
+				break
+			}
+		}
+	}
+
+	sort.Strings(codigos)
+
+	return codigos, nil
+}
+
+func (r *memoryImpl) FindProdutoCategorias(ctx context.Context, produtos []string) (*[]model.ProdutoCategoria, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	associacoes := []model.ProdutoCategoria{}
+	for _, produto := range produtos {
+		for categoria := range r.produtos[produto] {
+			associacoes = append(associacoes, model.ProdutoCategoria{ProdutoCodigo: produto, CategoriaCodigo: categoria})
+		}
+	}
+
+	sort.Slice(associacoes, func(i, j int) bool {
+		if associacoes[i].ProdutoCodigo != associacoes[j].ProdutoCodigo {
+			return associacoes[i].ProdutoCodigo < associacoes[j].ProdutoCodigo
+		}
+		return associacoes[i].CategoriaCodigo < associacoes[j].CategoriaCodigo
+	})
+
+	return &associacoes, nil
+}
+
+func (r *memoryImpl) SetProdutoCategorias(ctx context.Context, produto string, categorias []string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if len(categorias) == 0 {
+		delete(r.produtos, produto)
+		return nil
+	}
+
+	associadas := make(map[string]bool, len(categorias))
+	for _, categoria := range categorias {
+		associadas[categoria] = true
+	}
+	r.produtos[produto] = associadas
+
+	return nil
+}
+
+func sortByNome(categorias []model.Categoria) {
+	sort.Slice(categorias, func(i, j int) bool {
+		return categorias[i].Nome < categorias[j].Nome
+	})
+}
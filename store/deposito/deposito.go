@@ -0,0 +1,163 @@
+package deposito
+
+import (
+	"context"
+	"time"
+
+	"github.com/GianGoulart/CrudProdutos/logger"
+	"github.com/GianGoulart/CrudProdutos/model"
+	"gorm.io/gorm"
+)
+
+// IDepositoStore interface para implementação do repositorio de depósitos
+type IDepositoStore interface {
+	FindDepositos(ctx context.Context) (*[]model.Deposito, error)
+	FindDepositoByCodigo(ctx context.Context, codigo string) (*model.Deposito, error)
+	CreateDeposito(ctx context.Context, deposito *model.Deposito) (*model.Deposito, error)
+	UpdateDeposito(ctx context.Context, deposito *model.Deposito) (*model.Deposito, error)
+	DeleteDepositoByCodigo(ctx context.Context, deposito *model.Deposito) error
+	FindEstoquesByProduto(ctx context.Context, produto string) (*[]model.EstoqueDeposito, error)
+	FindProdutosByDeposito(ctx context.Context, deposito string) ([]string, error)
+	SaveEstoque(ctx context.Context, estoque *model.EstoqueDeposito) (*model.EstoqueDeposito, error)
+	DeleteEstoque(ctx context.Context, produto, deposito string) error
+	DeleteEstoquesByProduto(ctx context.Context, produto string) error
+}
+
+// NewDeposito cria uma nova instancia do repositorio de depósito
+func NewDeposito(reader *gorm.DB) IDepositoStore {
+	return &storeImpl{reader}
+}
+
+type storeImpl struct {
+	db *gorm.DB
+}
+
+const (
+	layout = "02-01-2006T15:04:05"
+)
+
+func (r *storeImpl) FindDepositos(ctx context.Context) (*[]model.Deposito, error) {
+	depositos := new([]model.Deposito)
+
+	if err := r.db.WithContext(ctx).Order("nome").Find(&depositos).Error; err != nil {
+		logger.FromContext(ctx).WithError(err).Error("store.deposito.FindDepositos")
+		return depositos, err
+	}
+
+	return depositos, nil
+}
+
+func (r *storeImpl) FindDepositoByCodigo(ctx context.Context, codigo string) (*model.Deposito, error) {
+	res := new(model.Deposito)
+
+	if err := r.db.WithContext(ctx).Where(&model.Deposito{Codigo: codigo}).Find(res).Error; err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("codigo", codigo).Error("store.deposito.FindDepositoByCodigo")
+		return res, err
+	}
+
+	return res, nil
+}
+
+func (r *storeImpl) CreateDeposito(ctx context.Context, deposito *model.Deposito) (*model.Deposito, error) {
+	deposito.CriadoEm = time.Now().Format(layout)
+	deposito.UltimaAlteracao = time.Now().Format(layout)
+
+	exec := "INSERT INTO depositos (codigo,nome,inativo,criado_em,ultima_alteracao) VALUES (?,?,?,?,?)"
+
+	if err := r.db.WithContext(ctx).Exec(exec, deposito.Codigo, deposito.Nome, deposito.Inativo, deposito.CriadoEm, deposito.UltimaAlteracao).Error; err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("codigo", deposito.Codigo).Error("store.deposito.CreateDeposito")
+		return &model.Deposito{}, err
+	}
+
+	return deposito, nil
+}
+
+func (r *storeImpl) UpdateDeposito(ctx context.Context, deposito *model.Deposito) (*model.Deposito, error) {
+	deposito.UltimaAlteracao = time.Now().Format(layout)
+
+	exec := "UPDATE depositos SET nome=?,inativo=?,ultima_alteracao=? WHERE codigo=?"
+
+	if err := r.db.WithContext(ctx).Exec(exec, deposito.Nome, deposito.Inativo, deposito.UltimaAlteracao, deposito.Codigo).Error; err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("codigo", deposito.Codigo).Error("store.deposito.UpdateDeposito")
+		return &model.Deposito{}, err
+	}
+
+	return deposito, nil
+}
+
+func (r *storeImpl) DeleteDepositoByCodigo(ctx context.Context, deposito *model.Deposito) error {
+	if err := r.db.WithContext(ctx).Exec("DELETE FROM depositos WHERE codigo=?", deposito.Codigo).Error; err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("codigo", deposito.Codigo).Error("store.deposito.DeleteDepositoByCodigo")
+		return err
+	}
+
+	return nil
+}
+
+func (r *storeImpl) FindEstoquesByProduto(ctx context.Context, produto string) (*[]model.EstoqueDeposito, error) {
+	estoques := new([]model.EstoqueDeposito)
+
+	if err := r.db.WithContext(ctx).Where("produto_codigo = ?", produto).Order("deposito_codigo").Find(&estoques).Error; err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("produto", produto).Error("store.deposito.FindEstoquesByProduto")
+		return estoques, err
+	}
+
+	return estoques, nil
+}
+
+func (r *storeImpl) FindProdutosByDeposito(ctx context.Context, deposito string) ([]string, error) {
+	codigos := []string{}
+
+	err := r.db.WithContext(ctx).Model(&model.EstoqueDeposito{}).
+		Where("deposito_codigo = ?", deposito).
+		Order("produto_codigo").
+		Pluck("produto_codigo", &codigos).Error
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("deposito", deposito).Error("store.deposito.FindProdutosByDeposito")
+		return codigos, err
+	}
+
+	return codigos, nil
+}
+
+// SaveEstoque grava o estoque do produto no depósito, substituindo o anterior
+func (r *storeImpl) SaveEstoque(ctx context.Context, estoque *model.EstoqueDeposito) (*model.EstoqueDeposito, error) {
+	estoque.UltimaAlteracao = time.Now().Format(layout)
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		exec := "UPDATE produto_depositos SET estoque_total=?,estoque_corte=?,estoque_disponivel=?,ultima_alteracao=? WHERE produto_codigo=? AND deposito_codigo=?"
+		res := tx.Exec(exec, estoque.EstoqueTotal, estoque.EstoqueCorte, estoque.EstoqueDisponivel, estoque.UltimaAlteracao, estoque.ProdutoCodigo, estoque.DepositoCodigo)
+		if res.Error != nil || res.RowsAffected > 0 {
+			return res.Error
+		}
+
+		exec = "INSERT INTO produto_depositos (produto_codigo,deposito_codigo,estoque_total,estoque_corte,estoque_disponivel,ultima_alteracao) VALUES (?,?,?,?,?,?)"
+		return tx.Exec(exec, estoque.ProdutoCodigo, estoque.DepositoCodigo, estoque.EstoqueTotal, estoque.EstoqueCorte, estoque.EstoqueDisponivel, estoque.UltimaAlteracao).Error
+	})
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("produto", estoque.ProdutoCodigo).WithField("deposito", estoque.DepositoCodigo).Error("store.deposito.SaveEstoque")
+		return &model.EstoqueDeposito{}, err
+	}
+
+	return estoque, nil
+}
+
+func (r *storeImpl) DeleteEstoque(ctx context.Context, produto, deposito string) error {
+	exec := "DELETE FROM produto_depositos WHERE produto_codigo=? AND deposito_codigo=?"
+	if err := r.db.WithContext(ctx).Exec(exec, produto, deposito).Error; err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("produto", produto).WithField("deposito", deposito).Error("store.deposito.DeleteEstoque")
+		return err
+	}
+
+	return nil
+}
+
+func (r *storeImpl) DeleteEstoquesByProduto(ctx context.Context, produto string) error {
+	exec := "DELETE FROM produto_depositos WHERE produto_codigo=?"
+	if err := r.db.WithContext(ctx).Exec(exec, produto).Error; err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("produto", produto).Error("store.deposito.DeleteEstoquesByProduto")
+		return err
+	}
+
+	return nil
+}
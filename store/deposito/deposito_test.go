@@ -0,0 +1,85 @@
+package deposito_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/GianGoulart/CrudProdutos/store/deposito"
+	"github.com/GianGoulart/CrudProdutos/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Deposito(t *testing.T) {
+	cases := map[string]struct {
+		Store deposito.IDepositoStore
+	}{
+		"sqlite":  {Store: deposito.NewDeposito(test.GetSQLite(t, model.Deposito{}, model.EstoqueDeposito{}))},
+		"memoria": {Store: deposito.NewDepositoMemory()},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			s := cs.Store
+
+			for _, d := range []model.Deposito{
+				{Codigo: "cd-sp", Nome: "CD São Paulo"},
+				{Codigo: "cd-rj", Nome: "CD Rio de Janeiro"},
+			} {
+				d := d
+				_, err := s.CreateDeposito(ctx, &d)
+				assert.NoError(t, err)
+			}
+
+			rj := model.Deposito{Codigo: "cd-rj", Nome: "CD Rio", Inativo: true}
+			_, err := s.UpdateDeposito(ctx, &rj)
+			assert.NoError(t, err)
+
+			found, err := s.FindDepositoByCodigo(ctx, "cd-rj")
+			assert.NoError(t, err)
+			assert.Equal(t, "CD Rio", found.Nome)
+			assert.True(t, found.Inativo)
+			assert.NotEmpty(t, found.CriadoEm)
+
+			for _, e := range []model.EstoqueDeposito{
+				{ProdutoCodigo: "p1", DepositoCodigo: "cd-sp", EstoqueTotal: 10, EstoqueCorte: 1, EstoqueDisponivel: 9},
+				{ProdutoCodigo: "p1", DepositoCodigo: "cd-rj", EstoqueTotal: 5, EstoqueDisponivel: 5},
+				{ProdutoCodigo: "p2", DepositoCodigo: "cd-sp", EstoqueTotal: 1, EstoqueDisponivel: 1},
+				{ProdutoCodigo: "p1", DepositoCodigo: "cd-sp", EstoqueTotal: 20, EstoqueCorte: 2, EstoqueDisponivel: 18},
+			} {
+				e := e
+				_, err := s.SaveEstoque(ctx, &e)
+				assert.NoError(t, err)
+			}
+
+			estoques, err := s.FindEstoquesByProduto(ctx, "p1")
+			assert.NoError(t, err)
+			if assert.Len(t, *estoques, 2) {
+				assert.Equal(t, "cd-rj", (*estoques)[0].DepositoCodigo)
+				assert.Equal(t, int64(18), (*estoques)[1].EstoqueDisponivel)
+			}
+
+			produtos, err := s.FindProdutosByDeposito(ctx, "cd-sp")
+			assert.NoError(t, err)
+			assert.Equal(t, []string{"p1", "p2"}, produtos)
+
+			assert.NoError(t, s.DeleteEstoque(ctx, "p1", "cd-rj"))
+			assert.NoError(t, s.DeleteEstoquesByProduto(ctx, "p2"))
+
+			produtos, err = s.FindProdutosByDeposito(ctx, "cd-rj")
+			assert.NoError(t, err)
+			assert.Empty(t, produtos)
+
+			produtos, err = s.FindProdutosByDeposito(ctx, "cd-sp")
+			assert.NoError(t, err)
+			assert.Equal(t, []string{"p1"}, produtos)
+
+			assert.NoError(t, s.DeleteDepositoByCodigo(ctx, &model.Deposito{Codigo: "cd-rj"}))
+
+			depositos, err := s.FindDepositos(ctx)
+			assert.NoError(t, err)
+			assert.Len(t, *depositos, 1)
+		})
+	}
+}
@@ -0,0 +1,169 @@
+package deposito
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/GianGoulart/CrudProdutos/logger"
+	"github.com/GianGoulart/CrudProdutos/model"
+)
+
+// ErrDepositoDuplicado erro retornado ao criar um depósito com um codigo já existente
+var ErrDepositoDuplicado = errors.New("depósito já cadastrado")
+
+// NewDepositoMemory cria uma nova instancia do repositorio de depósito em memória
+func NewDepositoMemory() IDepositoStore {
+	return &memoryImpl{
+		depositos: make(map[string]model.Deposito),
+		estoques:  make(map[string]map[string]model.EstoqueDeposito),
+	}
+}
+
+type memoryImpl struct {
+	mu        sync.RWMutex
+	depositos map[string]model.Deposito
+	// estoques estoque por produto e depósito
+	estoques map[string]map[string]model.EstoqueDeposito
+}
+
+func (r *memoryImpl) FindDepositos(ctx context.Context) (*[]model.Deposito, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	depositos := make([]model.Deposito, 0, len(r.depositos))
+	for _, deposito := range r.depositos {
+		depositos = append(depositos, deposito)
+	}
+
+	sort.Slice(depositos, func(i, j int) bool {
+		return depositos[i].Nome < depositos[j].Nome
+	})
+
+	return &depositos, nil
+}
+
+func (r *memoryImpl) FindDepositoByCodigo(ctx context.Context, codigo string) (*model.Deposito, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	// assim como no banco, um codigo inexistente retorna um depósito vazio
+	deposito := r.depositos[codigo]
+
+	return &deposito, nil
+}
+
+func (r *memoryImpl) CreateDeposito(ctx context.Context, deposito *model.Deposito) (*model.Deposito, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.depositos[deposito.Codigo]; ok {
+		logger.FromContext(ctx).WithError(ErrDepositoDuplicado).WithField("codigo", deposito.Codigo).Error("store.deposito.memory.CreateDeposito")
+		return &model.Deposito{}, ErrDepositoDuplicado
+	}
+
+	deposito.CriadoEm = time.Now().Format(layout)
+	deposito.UltimaAlteracao = time.Now().Format(layout)
+
+	r.depositos[deposito.Codigo] = *deposito
+
+	return deposito, nil
+}
+
+func (r *memoryImpl) UpdateDeposito(ctx context.Context, deposito *model.Deposito) (*model.Deposito, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	deposito.UltimaAlteracao = time.Now().Format(layout)
+
+	atual, ok := r.depositos[deposito.Codigo]
+	if !ok {
+		return deposito, nil
+	}
+
+	deposito.CriadoEm = atual.CriadoEm
+	r.depositos[deposito.Codigo] = *deposito
+
+	return deposito, nil
+}
+
+func (r *memoryImpl) DeleteDepositoByCodigo(ctx context.Context, deposito *model.Deposito) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.depositos, deposito.Codigo)
+
+	return nil
+}
+
+func (r *memoryImpl) FindEstoquesByProduto(ctx context.Context, produto string) (*[]model.EstoqueDeposito, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	estoques := make([]model.EstoqueDeposito, 0)
+	for _, estoque := range r.estoques[produto] {
+		estoques = append(estoques, estoque)
+	}
+
+	sort.Slice(estoques, func(i, j int) bool {
+		return estoques[i].DepositoCodigo < estoques[j].DepositoCodigo
+	})
+
+	return &estoques, nil
+}
+
+func (r *memoryImpl) FindProdutosByDeposito(ctx context.Context, deposito string) ([]string, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	codigos := []string{}
+	for produto, estoques := range r.estoques {
+		if _, ok := estoques[deposito]; ok {
+			codigos = append(codigos, produto)
+		}
+	}
+
+	sort.Strings(codigos)
+
+	return codigos, nil
+}
+
+func (r *memoryImpl) SaveEstoque(ctx context.Context, estoque *model.EstoqueDeposito) (*model.EstoqueDeposito, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	estoque.UltimaAlteracao = time.Now().Format(layout)
+
+	estoques, ok := r.estoques[estoque.ProdutoCodigo]
+	if !ok {
+		estoques = make(map[string]model.EstoqueDeposito)
+		r.estoques[estoque.ProdutoCodigo] = estoques
+	}
+
+	// o banco não guarda se o depósito está ativo, a memória também não
+	salvo := *estoque
+	salvo.Ativo = false
+	estoques[estoque.DepositoCodigo] = salvo
+
+	return estoque, nil
+}
+
+func (r *memoryImpl) DeleteEstoque(ctx context.Context, produto, deposito string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.estoques[produto], deposito)
+
+	return nil
+}
+
+func (r *memoryImpl) DeleteEstoquesByProduto(ctx context.Context, produto string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.estoques, produto)
+
+	return nil
+}
@@ -0,0 +1,168 @@
+package fornecedor
+
+import (
+	"context"
+	"time"
+
+	"github.com/GianGoulart/CrudProdutos/logger"
+	"github.com/GianGoulart/CrudProdutos/model"
+	"gorm.io/gorm"
+)
+
+// IFornecedorStore interface para implementação do repositorio de fornecedores
+type IFornecedorStore interface {
+	FindFornecedores(ctx context.Context) (*[]model.Fornecedor, error)
+	FindFornecedorByCodigo(ctx context.Context, codigo string) (*model.Fornecedor, error)
+	CreateFornecedor(ctx context.Context, fornecedor *model.Fornecedor) (*model.Fornecedor, error)
+	UpdateFornecedor(ctx context.Context, fornecedor *model.Fornecedor) (*model.Fornecedor, error)
+	DeleteFornecedorByCodigo(ctx context.Context, fornecedor *model.Fornecedor) error
+	FindFornecimentosByProduto(ctx context.Context, produto string) (*[]model.ProdutoFornecedor, error)
+	FindProdutosByFornecedor(ctx context.Context, fornecedor string) ([]string, error)
+	SaveFornecimento(ctx context.Context, fornecimento *model.ProdutoFornecedor) (*model.ProdutoFornecedor, error)
+	DeleteFornecimento(ctx context.Context, produto, fornecedor string) error
+	DeleteFornecimentosByProduto(ctx context.Context, produto string) error
+}
+
+// NewFornecedor cria uma nova instancia do repositorio de fornecedor
+func NewFornecedor(reader *gorm.DB) IFornecedorStore {
+	return &storeImpl{reader}
+}
+
+type storeImpl struct {
+	db *gorm.DB
+}
+
+const (
+	layout = "02-01-2006T15:04:05"
+)
+
+func (r *storeImpl) FindFornecedores(ctx context.Context) (*[]model.Fornecedor, error) {
+	fornecedores := new([]model.Fornecedor)
+
+	if err := r.db.WithContext(ctx).Order("nome").Find(&fornecedores).Error; err != nil {
+		logger.FromContext(ctx).WithError(err).Error("store.fornecedor.FindFornecedores")
+		return fornecedores, err
+	}
+
+	return fornecedores, nil
+}
+
+func (r *storeImpl) FindFornecedorByCodigo(ctx context.Context, codigo string) (*model.Fornecedor, error) {
+	res := new(model.Fornecedor)
+
+	if err := r.db.WithContext(ctx).Where(&model.Fornecedor{Codigo: codigo}).Find(res).Error; err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("codigo", codigo).Error("store.fornecedor.FindFornecedorByCodigo")
+		return res, err
+	}
+
+	return res, nil
+}
+
+func (r *storeImpl) CreateFornecedor(ctx context.Context, fornecedor *model.Fornecedor) (*model.Fornecedor, error) {
+	fornecedor.CriadoEm = time.Now().Format(layout)
+	fornecedor.UltimaAlteracao = time.Now().Format(layout)
+
+	exec := "INSERT INTO fornecedores (codigo,nome,documento,email,criado_em,ultima_alteracao) VALUES (?,?,?,?,?,?)"
+
+	if err := r.db.WithContext(ctx).Exec(exec, fornecedor.Codigo, fornecedor.Nome, fornecedor.Documento, fornecedor.Email, fornecedor.CriadoEm, fornecedor.UltimaAlteracao).Error; err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("codigo", fornecedor.Codigo).Error("store.fornecedor.CreateFornecedor")
+		return &model.Fornecedor{}, err
+	}
+
+	return fornecedor, nil
+}
+
+func (r *storeImpl) UpdateFornecedor(ctx context.Context, fornecedor *model.Fornecedor) (*model.Fornecedor, error) {
+	fornecedor.UltimaAlteracao = time.Now().Format(layout)
+
+	exec := "UPDATE fornecedores SET nome=?,documento=?,email=?,ultima_alteracao=? WHERE codigo=?"
+
+	if err := r.db.WithContext(ctx).Exec(exec, fornecedor.Nome, fornecedor.Documento, fornecedor.Email, fornecedor.UltimaAlteracao, fornecedor.Codigo).Error; err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("codigo", fornecedor.Codigo).Error("store.fornecedor.UpdateFornecedor")
+		return &model.Fornecedor{}, err
+	}
+
+	return fornecedor, nil
+}
+
+func (r *storeImpl) DeleteFornecedorByCodigo(ctx context.Context, fornecedor *model.Fornecedor) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("DELETE FROM produto_fornecedores WHERE fornecedor_codigo=?", fornecedor.Codigo).Error; err != nil {
+			return err
+		}
+
+		return tx.Exec("DELETE FROM fornecedores WHERE codigo=?", fornecedor.Codigo).Error
+	})
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("codigo", fornecedor.Codigo).Error("store.fornecedor.DeleteFornecedorByCodigo")
+		return err
+	}
+
+	return nil
+}
+
+func (r *storeImpl) FindFornecimentosByProduto(ctx context.Context, produto string) (*[]model.ProdutoFornecedor, error) {
+	fornecimentos := new([]model.ProdutoFornecedor)
+
+	if err := r.db.WithContext(ctx).Where("produto_codigo = ?", produto).Order("fornecedor_codigo").Find(&fornecimentos).Error; err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("produto", produto).Error("store.fornecedor.FindFornecimentosByProduto")
+		return fornecimentos, err
+	}
+
+	return fornecimentos, nil
+}
+
+func (r *storeImpl) FindProdutosByFornecedor(ctx context.Context, fornecedor string) ([]string, error) {
+	codigos := []string{}
+
+	err := r.db.WithContext(ctx).Model(&model.ProdutoFornecedor{}).
+		Where("fornecedor_codigo = ?", fornecedor).
+		Order("produto_codigo").
+		Pluck("produto_codigo", &codigos).Error
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("fornecedor", fornecedor).Error("store.fornecedor.FindProdutosByFornecedor")
+		return codigos, err
+	}
+
+	return codigos, nil
+}
+
+// SaveFornecimento grava as condições de fornecimento, substituindo as anteriores do mesmo par
+func (r *storeImpl) SaveFornecimento(ctx context.Context, fornecimento *model.ProdutoFornecedor) (*model.ProdutoFornecedor, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		exec := "UPDATE produto_fornecedores SET sku=?,custo=?,prazo_entrega=? WHERE produto_codigo=? AND fornecedor_codigo=?"
+		res := tx.Exec(exec, fornecimento.SKU, fornecimento.Custo, fornecimento.PrazoEntrega, fornecimento.ProdutoCodigo, fornecimento.FornecedorCodigo)
+		if res.Error != nil || res.RowsAffected > 0 {
+			return res.Error
+		}
+
+		exec = "INSERT INTO produto_fornecedores (produto_codigo,fornecedor_codigo,sku,custo,prazo_entrega) VALUES (?,?,?,?,?)"
+		return tx.Exec(exec, fornecimento.ProdutoCodigo, fornecimento.FornecedorCodigo, fornecimento.SKU, fornecimento.Custo, fornecimento.PrazoEntrega).Error
+	})
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("produto", fornecimento.ProdutoCodigo).WithField("fornecedor", fornecimento.FornecedorCodigo).Error("store.fornecedor.SaveFornecimento")
+		return &model.ProdutoFornecedor{}, err
+	}
+
+	return fornecimento, nil
+}
+
+func (r *storeImpl) DeleteFornecimento(ctx context.Context, produto, fornecedor string) error {
+	exec := "DELETE FROM produto_fornecedores WHERE produto_codigo=? AND fornecedor_codigo=?"
+	if err := r.db.WithContext(ctx).Exec(exec, produto, fornecedor).Error; err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("produto", produto).WithField("fornecedor", fornecedor).Error("store.fornecedor.DeleteFornecimento")
+		return err
+	}
+
+	return nil
+}
+
+func (r *storeImpl) DeleteFornecimentosByProduto(ctx context.Context, produto string) error {
+	exec := "DELETE FROM produto_fornecedores WHERE produto_codigo=?"
+	if err := r.db.WithContext(ctx).Exec(exec, produto).Error; err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("produto", produto).Error("store.fornecedor.DeleteFornecimentosByProduto")
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package fornecedor_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/GianGoulart/CrudProdutos/store/fornecedor"
+	"github.com/GianGoulart/CrudProdutos/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Fornecedor(t *testing.T) {
+	cases := map[string]struct {
+		Store fornecedor.IFornecedorStore
+	}{
+		"sqlite":  {Store: fornecedor.NewFornecedor(test.GetSQLite(t, model.Fornecedor{}, model.ProdutoFornecedor{}))},
+		"memoria": {Store: fornecedor.NewFornecedorMemory()},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			s := cs.Store
+
+			for _, f := range []model.Fornecedor{{Codigo: "f1", Nome: "Distribuidora A"}, {Codigo: "f2", Nome: "Distribuidora B"}} {
+				f := f
+				_, err := s.CreateFornecedor(ctx, &f)
+				assert.NoError(t, err)
+			}
+
+			_, err := s.UpdateFornecedor(ctx, &model.Fornecedor{Codigo: "f2", Nome: "Distribuidora B", Email: "compras@b.com"})
+			assert.NoError(t, err)
+
+			found, err := s.FindFornecedorByCodigo(ctx, "f2")
+			assert.NoError(t, err)
+			assert.Equal(t, "compras@b.com", found.Email)
+
+			_, err = s.SaveFornecimento(ctx, &model.ProdutoFornecedor{ProdutoCodigo: "p1", FornecedorCodigo: "f1", SKU: "A-1", Custo: 10, PrazoEntrega: 5})
+			assert.NoError(t, err)
+			_, err = s.SaveFornecimento(ctx, &model.ProdutoFornecedor{ProdutoCodigo: "p1", FornecedorCodigo: "f1", SKU: "A-1", Custo: 12, PrazoEntrega: 3})
+			assert.NoError(t, err)
+			_, err = s.SaveFornecimento(ctx, &model.ProdutoFornecedor{ProdutoCodigo: "p1", FornecedorCodigo: "f2", SKU: "B-9", Custo: 11, PrazoEntrega: 7})
+			assert.NoError(t, err)
+			_, err = s.SaveFornecimento(ctx, &model.ProdutoFornecedor{ProdutoCodigo: "p2", FornecedorCodigo: "f2", SKU: "B-7", Custo: 20, PrazoEntrega: 7})
+			assert.NoError(t, err)
+
+			fornecimentos, err := s.FindFornecimentosByProduto(ctx, "p1")
+			assert.NoError(t, err)
+			assert.Equal(t, []model.ProdutoFornecedor{
+				{ProdutoCodigo: "p1", FornecedorCodigo: "f1", SKU: "A-1", Custo: 12, PrazoEntrega: 3},
+				{ProdutoCodigo: "p1", FornecedorCodigo: "f2", SKU: "B-9", Custo: 11, PrazoEntrega: 7},
+			}, *fornecimentos)
+
+			produtos, err := s.FindProdutosByFornecedor(ctx, "f2")
+			assert.NoError(t, err)
+			assert.Equal(t, []string{"p1", "p2"}, produtos)
+
+			assert.NoError(t, s.DeleteFornecimento(ctx, "p1", "f2"))
+			assert.NoError(t, s.DeleteFornecimentosByProduto(ctx, "p2"))
+
+			produtos, err = s.FindProdutosByFornecedor(ctx, "f2")
+			assert.NoError(t, err)
+			assert.Empty(t, produtos)
+
+			assert.NoError(t, s.DeleteFornecedorByCodigo(ctx, &model.Fornecedor{Codigo: "f1"}))
+
+			fornecimentos, err = s.FindFornecimentosByProduto(ctx, "p1")
+			assert.NoError(t, err)
+			assert.Empty(t, *fornecimentos)
+
+			fornecedores, err := s.FindFornecedores(ctx)
+			assert.NoError(t, err)
+			assert.Len(t, *fornecedores, 1)
+		})
+	}
+}
@@ -0,0 +1,167 @@
+package fornecedor
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/GianGoulart/CrudProdutos/logger"
+	"github.com/GianGoulart/CrudProdutos/model"
+)
+
+// ErrFornecedorDuplicado erro retornado ao criar um fornecedor com um codigo já existente
+var ErrFornecedorDuplicado = errors.New("fornecedor já cadastrado")
+
+// NewFornecedorMemory cria uma nova instancia do repositorio de fornecedor em memória
+func NewFornecedorMemory() IFornecedorStore {
+	return &memoryImpl{
+		fornecedores:  make(map[string]model.Fornecedor),
+		fornecimentos: make(map[string]map[string]model.ProdutoFornecedor),
+	}
+}
+
+type memoryImpl struct {
+	mu           sync.RWMutex
+	fornecedores map[string]model.Fornecedor
+	// fornecimentos condições de fornecimento por produto e fornecedor
+	fornecimentos map[string]map[string]model.ProdutoFornecedor
+}
+
+func (r *memoryImpl) FindFornecedores(ctx context.Context) (*[]model.Fornecedor, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	fornecedores := make([]model.Fornecedor, 0, len(r.fornecedores))
+	for _, fornecedor := range r.fornecedores {
+		fornecedores = append(fornecedores, fornecedor)
+	}
+
+	sort.Slice(fornecedores, func(i, j int) bool {
+		return fornecedores[i].Nome < fornecedores[j].Nome
+	})
+
+	return &fornecedores, nil
+}
+
+func (r *memoryImpl) FindFornecedorByCodigo(ctx context.Context, codigo string) (*model.Fornecedor, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	// assim como no banco, um codigo inexistente retorna um fornecedor vazio
+	fornecedor := r.fornecedores[codigo]
+
+	return &fornecedor, nil
+}
+
+func (r *memoryImpl) CreateFornecedor(ctx context.Context, fornecedor *model.Fornecedor) (*model.Fornecedor, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.fornecedores[fornecedor.Codigo]; ok {
+		logger.FromContext(ctx).WithError(ErrFornecedorDuplicado).WithField("codigo", fornecedor.Codigo).Error("store.fornecedor.memory.CreateFornecedor")
+		return &model.Fornecedor{}, ErrFornecedorDuplicado
+	}
+
+	fornecedor.CriadoEm = time.Now().Format(layout)
+	fornecedor.UltimaAlteracao = time.Now().Format(layout)
+
+	r.fornecedores[fornecedor.Codigo] = *fornecedor
+
+	return fornecedor, nil
+}
+
+func (r *memoryImpl) UpdateFornecedor(ctx context.Context, fornecedor *model.Fornecedor) (*model.Fornecedor, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	fornecedor.UltimaAlteracao = time.Now().Format(layout)
+
+	atual, ok := r.fornecedores[fornecedor.Codigo]
+	if !ok {
+		return fornecedor, nil
+	}
+
+	fornecedor.CriadoEm = atual.CriadoEm
+	r.fornecedores[fornecedor.Codigo] = *fornecedor
+
+	return fornecedor, nil
+}
+
+func (r *memoryImpl) DeleteFornecedorByCodigo(ctx context.Context, fornecedor *model.Fornecedor) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.fornecedores, fornecedor.Codigo)
+	for _, fornecimentos := range r.fornecimentos {
+		delete(fornecimentos, fornecedor.Codigo)
+	}
+
+	return nil
+}
+
+func (r *memoryImpl) FindFornecimentosByProduto(ctx context.Context, produto string) (*[]model.ProdutoFornecedor, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	fornecimentos := make([]model.ProdutoFornecedor, 0)
+	for _, fornecimento := range r.fornecimentos[produto] {
+		fornecimentos = append(fornecimentos, fornecimento)
+	}
+
+	sort.Slice(fornecimentos, func(i, j int) bool {
+		return fornecimentos[i].FornecedorCodigo < fornecimentos[j].FornecedorCodigo
+	})
+
+	return &fornecimentos, nil
+}
+
+func (r *memoryImpl) FindProdutosByFornecedor(ctx context.Context, fornecedor string) ([]string, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	codigos := []string{}
+	for produto, fornecimentos := range r.fornecimentos {
+		if _, ok := fornecimentos[fornecedor]; ok {
+			codigos = append(codigos, produto)
+		}
+	}
+
+	sort.Strings(codigos)
+
+	return codigos, nil
+}
+
+func (r *memoryImpl) SaveFornecimento(ctx context.Context, fornecimento *model.ProdutoFornecedor) (*model.ProdutoFornecedor, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	fornecimentos, ok := r.fornecimentos[fornecimento.ProdutoCodigo]
+	if !ok {
+		fornecimentos = make(map[string]model.ProdutoFornecedor)
+		r.fornecimentos[fornecimento.ProdutoCodigo] = fornecimentos
+	}
+
+	fornecimentos[fornecimento.FornecedorCodigo] = *fornecimento
+
+	return fornecimento, nil
+}
+
+func (r *memoryImpl) DeleteFornecimento(ctx context.Context, produto, fornecedor string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.fornecimentos[produto], fornecedor)
+
+	return nil
+}
+
+func (r *memoryImpl) DeleteFornecimentosByProduto(ctx context.Context, produto string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.fornecimentos, produto)
+
+	return nil
+}
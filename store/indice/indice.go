@@ -0,0 +1,227 @@
+package indice
+
+import (
+	"context"
+	"os"
+
+	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/blevesearch/bleve/v2"
+	"github.com/blevesearch/bleve/v2/analysis/analyzer/custom"
+	"github.com/blevesearch/bleve/v2/analysis/token/lowercase"
+	"github.com/blevesearch/bleve/v2/analysis/tokenizer/unicode"
+	"github.com/blevesearch/bleve/v2/mapping"
+	"github.com/blevesearch/bleve/v2/search/query"
+)
+
+// IIndice interface do índice de texto dos produtos usado nas sugestões
+type IIndice interface {
+	Index(ctx context.Context, produto *model.Produto) error
+	Delete(ctx context.Context, codigo string) error
+	Suggest(ctx context.Context, q string, limite int) (*[]model.Sugestao, error)
+	Rebuild(ctx context.Context, produtos []model.Produto) error
+	Count() (uint64, error)
+	Close() error
+}
+
+const analyzerNome = "nome"
+
+// documento representação do produto no índice, o campo busca guarda o nome
+// normalizado sem acentos e o nome original fica apenas armazenado para a resposta
+type documento struct {
+	Nome  string `json:"nome"`
+	Busca string `json:"busca"`
+}
+
+// New abre o índice no diretório informado, criando-o se não existir. Com o path
+// vazio o índice é mantido apenas em memória
+func New(path string) (IIndice, error) {
+	if path == "" {
+		idx, err := bleve.NewMemOnly(newMapping())
+		if err != nil {
+			return nil, err
+		}
+		return &indiceImpl{idx: idx}, nil
+	}
+
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		idx, err := bleve.New(path, newMapping())
+		if err != nil {
+			return nil, err
+		}
+		return &indiceImpl{idx: idx}, nil
+	}
+
+	// o timeout evita que o processo fique travado se outro já estiver com o índice aberto
+	idx, err := bleve.OpenUsing(path, map[string]interface{}{"bolt_timeout": "1s"})
+	if err != nil {
+		return nil, err
+	}
+
+	return &indiceImpl{idx: idx}, nil
+}
+
+func newMapping() *mapping.IndexMappingImpl {
+	im := bleve.NewIndexMapping()
+	im.AddCustomAnalyzer(analyzerNome, map[string]interface{}{
+		"type":          custom.Name,
+		"tokenizer":     unicode.Name,
+		"token_filters": []string{lowercase.Name},
+	})
+
+	busca := bleve.NewTextFieldMapping()
+	busca.Analyzer = analyzerNome
+	busca.Store = false
+	busca.IncludeTermVectors = false
+
+	nome := bleve.NewTextFieldMapping()
+	nome.Index = false
+	nome.Store = true
+
+	dm := bleve.NewDocumentStaticMapping()
+	dm.AddFieldMappingsAt("busca", busca)
+	dm.AddFieldMappingsAt("nome", nome)
+
+	im.DefaultMapping = dm
+	im.DefaultAnalyzer = analyzerNome
+
+	return im
+}
+
+type indiceImpl struct {
+	idx bleve.Index
+}
+
+func (i *indiceImpl) Index(ctx context.Context, produto *model.Produto) error {
+	return i.idx.Index(produto.Codigo, documento{
+		Nome:  produto.Nome,
+		Busca: model.Normalize(produto.Nome),
+	})
+}
+
+func (i *indiceImpl) Delete(ctx context.Context, codigo string) error {
+	return i.idx.Delete(codigo)
+}
+
+// Suggest retorna os produtos cujo nome contém todos os termos como palavra ou
+// prefixo, ordenados pela pontuação do índice. A busca com tolerância a erros de
+// digitação, mais cara, só é feita quando não há resultados suficientes
+func (i *indiceImpl) Suggest(ctx context.Context, q string, limite int) (*[]model.Sugestao, error) {
+	sugestoes := []model.Sugestao{}
+
+	termos := model.Termos(q)
+	if len(termos) == 0 {
+		return &sugestoes, nil
+	}
+
+	res, err := i.search(ctx, termos, limite, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(res.Hits) < limite {
+		if res, err = i.search(ctx, termos, limite, true); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, hit := range res.Hits {
+		nome, _ := hit.Fields["nome"].(string)
+		sugestoes = append(sugestoes, model.Sugestao{
+			Codigo: hit.ID,
+			Nome:   nome,
+			Score:  hit.Score,
+		})
+	}
+
+	return &sugestoes, nil
+}
+
+func (i *indiceImpl) search(ctx context.Context, termos []string, limite int, fuzzy bool) (*bleve.SearchResult, error) {
+	conj := bleve.NewConjunctionQuery()
+	for _, termo := range termos {
+		conj.AddQuery(termQuery(termo, fuzzy))
+	}
+
+	req := bleve.NewSearchRequestOptions(conj, limite, 0, false)
+	req.Fields = []string{"nome"}
+
+	return i.idx.SearchInContext(ctx, req)
+}
+
+// termQuery combina a palavra exata e o prefixo, pesando mais os acertos exatos,
+// e opcionalmente a busca com tolerância a erros
+func termQuery(termo string, fuzzy bool) query.Query {
+	exato := bleve.NewTermQuery(termo)
+	exato.SetField("busca")
+	exato.SetBoost(3)
+
+	prefixo := bleve.NewPrefixQuery(termo)
+	prefixo.SetField("busca")
+	prefixo.SetBoost(2)
+
+	disj := bleve.NewDisjunctionQuery(exato, prefixo)
+
+	if f := fuzziness(termo); fuzzy && f > 0 {
+		fq := bleve.NewFuzzyQuery(termo)
+		fq.SetField("busca")
+		fq.SetFuzziness(f)
+		disj.AddQuery(fq)
+	}
+
+	return disj
+}
+
+// fuzziness quantidade de erros tolerados de acordo com o tamanho do termo.
+// Termos longos aceitam dois erros para cobrir letras trocadas de posição
+func fuzziness(termo string) int {
+	switch n := len([]rune(termo)); {
+	case n <= 3:
+		return 0
+	case n <= 6:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// Rebuild remove todos os documentos do índice e indexa os produtos informados
+func (i *indiceImpl) Rebuild(ctx context.Context, produtos []model.Produto) error {
+	atuais := map[string]bool{}
+
+	count, err := i.idx.DocCount()
+	if err != nil {
+		return err
+	}
+
+	if count > 0 {
+		req := bleve.NewSearchRequestOptions(bleve.NewMatchAllQuery(), int(count), 0, false)
+		res, err := i.idx.SearchInContext(ctx, req)
+		if err != nil {
+			return err
+		}
+		for _, hit := range res.Hits {
+			atuais[hit.ID] = true
+		}
+	}
+
+	batch := i.idx.NewBatch()
+	for _, produto := range produtos {
+		delete(atuais, produto.Codigo)
+		if err := batch.Index(produto.Codigo, documento{Nome: produto.Nome, Busca: model.Normalize(produto.Nome)}); err != nil {
+			return err
+		}
+	}
+	for codigo := range atuais {
+		batch.Delete(codigo)
+	}
+
+	return i.idx.Batch(batch)
+}
+
+func (i *indiceImpl) Count() (uint64, error) {
+	return i.idx.DocCount()
+}
+
+func (i *indiceImpl) Close() error {
+	return i.idx.Close()
+}
@@ -0,0 +1,85 @@
+package indice_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/GianGoulart/CrudProdutos/store/indice"
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+)
+
+var produtos = []model.Produto{
+	{Codigo: "1", Nome: "Televisão SONY 50"},
+	{Codigo: "2", Nome: "Televisão SAMSUNG 65"},
+	{Codigo: "3", Nome: "Geladeira Brastemp"},
+	{Codigo: "4", Nome: "Suporte para TV"},
+}
+
+func Test_Suggest(t *testing.T) {
+	ctx := context.Background()
+
+	idx, err := indice.New("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer idx.Close()
+
+	assert.NoError(t, idx.Rebuild(ctx, produtos))
+
+	cases := map[string]struct {
+		InputQ       string
+		ExpectedData []string
+	}{
+		"deve sugerir pelo prefixo":                {InputQ: "gela", ExpectedData: []string{"3"}},
+		"deve ignorar acentos e caixa":             {InputQ: "TELEVISAO sony", ExpectedData: []string{"1"}},
+		"deve tolerar erros de digitação":          {InputQ: "telvisao samsumg", ExpectedData: []string{"2"}},
+		"deve exigir todos os termos":              {InputQ: "televisao brastemp", ExpectedData: []string{}},
+		"deve retornar vazio sem termos":           {InputQ: " ", ExpectedData: []string{}},
+		"deve encontrar palavras curtas completas": {InputQ: "tv", ExpectedData: []string{"4"}},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			data, err := idx.Suggest(ctx, cs.InputQ, 10)
+			assert.NoError(t, err)
+
+			codigos := []string{}
+			for _, s := range *data {
+				codigos = append(codigos, s.Codigo)
+			}
+
+			if diff := cmp.Diff(codigos, cs.ExpectedData); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func Test_Sync(t *testing.T) {
+	ctx := context.Background()
+
+	idx, err := indice.New(t.TempDir() + "/produtos.bleve")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer idx.Close()
+
+	assert.NoError(t, idx.Rebuild(ctx, produtos))
+
+	assert.NoError(t, idx.Index(ctx, &model.Produto{Codigo: "3", Nome: "Geladeira Consul"}))
+	assert.NoError(t, idx.Delete(ctx, "4"))
+
+	data, err := idx.Suggest(ctx, "consul", 10)
+	assert.NoError(t, err)
+	assert.Len(t, *data, 1)
+	assert.Equal(t, "Geladeira Consul", (*data)[0].Nome)
+
+	// a recriação remove os documentos que não existem mais
+	assert.NoError(t, idx.Rebuild(ctx, produtos[:2]))
+
+	count, err := idx.Count()
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), count)
+}
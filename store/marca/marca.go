@@ -0,0 +1,91 @@
+package marca
+
+import (
+	"context"
+	"time"
+
+	"github.com/GianGoulart/CrudProdutos/logger"
+	"github.com/GianGoulart/CrudProdutos/model"
+	"gorm.io/gorm"
+)
+
+// IMarcaStore interface para implementação do repositorio de marcas
+type IMarcaStore interface {
+	FindMarcas(ctx context.Context) (*[]model.Marca, error)
+	FindMarcaByCodigo(ctx context.Context, codigo string) (*model.Marca, error)
+	CreateMarca(ctx context.Context, marca *model.Marca) (*model.Marca, error)
+	UpdateMarca(ctx context.Context, marca *model.Marca) (*model.Marca, error)
+	DeleteMarcaByCodigo(ctx context.Context, marca *model.Marca) error
+}
+
+// NewMarca cria uma nova instancia do repositorio de marca
+func NewMarca(reader *gorm.DB) IMarcaStore {
+	return &storeImpl{reader}
+}
+
+type storeImpl struct {
+	db *gorm.DB
+}
+
+const (
+	layout = "02-01-2006T15:04:05"
+)
+
+func (r *storeImpl) FindMarcas(ctx context.Context) (*[]model.Marca, error) {
+	marcas := new([]model.Marca)
+
+	if err := r.db.WithContext(ctx).Order("nome").Find(&marcas).Error; err != nil {
+		logger.FromContext(ctx).WithError(err).Error("store.marca.FindMarcas")
+		return marcas, err
+	}
+
+	return marcas, nil
+}
+
+func (r *storeImpl) FindMarcaByCodigo(ctx context.Context, codigo string) (*model.Marca, error) {
+	res := new(model.Marca)
+
+	if err := r.db.WithContext(ctx).Where(&model.Marca{Codigo: codigo}).Find(res).Error; err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("codigo", codigo).Error("store.marca.FindMarcaByCodigo")
+		return res, err
+	}
+
+	return res, nil
+}
+
+func (r *storeImpl) CreateMarca(ctx context.Context, marca *model.Marca) (*model.Marca, error) {
+	marca.CriadoEm = time.Now().Format(layout)
+	marca.UltimaAlteracao = time.Now().Format(layout)
+
+	exec := "INSERT INTO marcas (codigo,nome,criado_em,ultima_alteracao) VALUES (?,?,?,?)"
+
+	if err := r.db.WithContext(ctx).Exec(exec, marca.Codigo, marca.Nome, marca.CriadoEm, marca.UltimaAlteracao).Error; err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("codigo", marca.Codigo).Error("store.marca.CreateMarca")
+		return &model.Marca{}, err
+	}
+
+	return marca, nil
+}
+
+func (r *storeImpl) UpdateMarca(ctx context.Context, marca *model.Marca) (*model.Marca, error) {
+	marca.UltimaAlteracao = time.Now().Format(layout)
+
+	exec := "UPDATE marcas SET nome=?,ultima_alteracao=? WHERE codigo=?"
+
+	if err := r.db.WithContext(ctx).Exec(exec, marca.Nome, marca.UltimaAlteracao, marca.Codigo).Error; err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("codigo", marca.Codigo).Error("store.marca.UpdateMarca")
+		return &model.Marca{}, err
+	}
+
+	return marca, nil
+}
+
+func (r *storeImpl) DeleteMarcaByCodigo(ctx context.Context, marca *model.Marca) error {
+	exec := "DELETE FROM marcas WHERE codigo=?"
+	if err := r.db.WithContext(ctx).Exec(exec, marca.Codigo).Error; err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("codigo", marca.Codigo).Error("store.marca.DeleteMarcaByCodigo")
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package marca_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/GianGoulart/CrudProdutos/store/marca"
+	"github.com/GianGoulart/CrudProdutos/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Marca(t *testing.T) {
+	cases := map[string]struct {
+		Store marca.IMarcaStore
+	}{
+		"sqlite":  {Store: marca.NewMarca(test.GetSQLite(t, model.Marca{}))},
+		"memoria": {Store: marca.NewMarcaMemory()},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			s := cs.Store
+
+			for _, m := range []model.Marca{{Codigo: "sony", Nome: "Sony"}, {Codigo: "lg", Nome: "LG"}} {
+				m := m
+				_, err := s.CreateMarca(ctx, &m)
+				assert.NoError(t, err)
+			}
+
+			_, err := s.UpdateMarca(ctx, &model.Marca{Codigo: "lg", Nome: "LG Electronics"})
+			assert.NoError(t, err)
+
+			found, err := s.FindMarcaByCodigo(ctx, "lg")
+			assert.NoError(t, err)
+			assert.Equal(t, "LG Electronics", found.Nome)
+			assert.NotEmpty(t, found.CriadoEm)
+
+			assert.NoError(t, s.DeleteMarcaByCodigo(ctx, &model.Marca{Codigo: "sony"}))
+
+			marcas, err := s.FindMarcas(ctx)
+			assert.NoError(t, err)
+			assert.Len(t, *marcas, 1)
+
+			found, err = s.FindMarcaByCodigo(ctx, "sony")
+			assert.NoError(t, err)
+			assert.Empty(t, found.Codigo)
+		})
+	}
+}
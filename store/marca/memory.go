@@ -0,0 +1,96 @@
+package marca
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/GianGoulart/CrudProdutos/logger"
+	"github.com/GianGoulart/CrudProdutos/model"
+)
+
+// ErrMarcaDuplicada erro retornado ao criar uma marca com um codigo já existente
+var ErrMarcaDuplicada = errors.New("marca já cadastrada")
+
+// NewMarcaMemory cria uma nova instancia do repositorio de marca em memória
+func NewMarcaMemory() IMarcaStore {
+	return &memoryImpl{
+		marcas: make(map[string]model.Marca),
+	}
+}
+
+type memoryImpl struct {
+	mu     sync.RWMutex
+	marcas map[string]model.Marca
+}
+
+func (r *memoryImpl) FindMarcas(ctx context.Context) (*[]model.Marca, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	marcas := make([]model.Marca, 0, len(r.marcas))
+	for _, marca := range r.marcas {
+		marcas = append(marcas, marca)
+	}
+
+	sort.Slice(marcas, func(i, j int) bool {
+		return marcas[i].Nome < marcas[j].Nome
+	})
+
+	return &marcas, nil
+}
+
+func (r *memoryImpl) FindMarcaByCodigo(ctx context.Context, codigo string) (*model.Marca, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	// assim como no banco, um codigo inexistente retorna uma marca vazia
+	marca := r.marcas[codigo]
+
+	return &marca, nil
+}
+
+func (r *memoryImpl) CreateMarca(ctx context.Context, marca *model.Marca) (*model.Marca, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.marcas[marca.Codigo]; ok {
+		logger.FromContext(ctx).WithError(ErrMarcaDuplicada).WithField("codigo", marca.Codigo).Error("store.marca.memory.CreateMarca")
+		return &model.Marca{}, ErrMarcaDuplicada
+	}
+
+	marca.CriadoEm = time.Now().Format(layout)
+	marca.UltimaAlteracao = time.Now().Format(layout)
+
+	r.marcas[marca.Codigo] = *marca
+
+	return marca, nil
+}
+
+func (r *memoryImpl) UpdateMarca(ctx context.Context, marca *model.Marca) (*model.Marca, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	marca.UltimaAlteracao = time.Now().Format(layout)
+
+	atual, ok := r.marcas[marca.Codigo]
+	if !ok {
+		return marca, nil
+	}
+
+	marca.CriadoEm = atual.CriadoEm
+	r.marcas[marca.Codigo] = *marca
+
+	return marca, nil
+}
+
+func (r *memoryImpl) DeleteMarcaByCodigo(ctx context.Context, marca *model.Marca) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.marcas, marca.Codigo)
+
+	return nil
+}
@@ -0,0 +1,116 @@
+package outbox
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/GianGoulart/CrudProdutos/model"
+)
+
+// NewOutboxMemory cria uma nova instancia da outbox em memória
+func NewOutboxMemory() IOutboxStore {
+	return &memoryImpl{}
+}
+
+type memoryImpl struct {
+	mu        sync.RWMutex
+	sequencia uint64
+	// eventos na ordem de gravação
+	eventos []model.EventoOutbox
+}
+
+func (r *memoryImpl) CreateEvento(ctx context.Context, evento *model.EventoOutbox) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.sequencia++
+	evento.Sequencia = r.sequencia
+	evento.CriadoEm = time.Now().Format(layout)
+
+	r.eventos = append(r.eventos, *evento)
+
+	return nil
+}
+
+func (r *memoryImpl) FindPendentes(ctx context.Context, limite int) (*[]model.EventoOutbox, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	// assim como no banco, os produtos cujo primeiro evento já falhou só têm esse evento retornado
+	bloqueados := make(map[string]bool)
+	for _, evento := range r.eventos {
+		if pendente(evento) && evento.Tentativas > 0 {
+			bloqueados[evento.Agregado] = true
+		}
+	}
+
+	eventos := []model.EventoOutbox{}
+	for _, evento := range r.eventos {
+		if len(eventos) == limite {
+			break
+		}
+
+		if pendente(evento) && (evento.Tentativas > 0 || !bloqueados[evento.Agregado]) {
+			eventos = append(eventos, evento)
+		}
+	}
+
+	return &eventos, nil
+}
+
+func (r *memoryImpl) MarcarPublicados(ctx context.Context, sequencias []uint64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	publicados := make(map[uint64]bool, len(sequencias))
+	for _, sequencia := range sequencias {
+		publicados[sequencia] = true
+	}
+
+	agora := time.Now().Unix()
+	for i := range r.eventos {
+		if publicados[r.eventos[i].Sequencia] {
+			r.eventos[i].Publicado = true
+			r.eventos[i].PublicadoEm = agora
+		}
+	}
+
+	return nil
+}
+
+func (r *memoryImpl) RegistrarFalha(ctx context.Context, evento *model.EventoOutbox) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i := range r.eventos {
+		if r.eventos[i].Sequencia == evento.Sequencia {
+			r.eventos[i].Falhou = evento.Falhou
+			r.eventos[i].Tentativas = evento.Tentativas
+			r.eventos[i].UltimoErro = evento.UltimoErro
+		}
+	}
+
+	return nil
+}
+
+func (r *memoryImpl) DeletePublicados(ctx context.Context, antes int64) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	eventos := r.eventos[:0]
+	for _, evento := range r.eventos {
+		if !evento.Publicado || evento.PublicadoEm >= antes {
+			eventos = append(eventos, evento)
+		}
+	}
+
+	removidos := int64(len(r.eventos) - len(eventos))
+	r.eventos = eventos
+
+	return removidos, nil
+}
+
+func pendente(evento model.EventoOutbox) bool {
+	return !evento.Publicado && !evento.Falhou
+}
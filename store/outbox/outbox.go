@@ -0,0 +1,114 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"github.com/GianGoulart/CrudProdutos/logger"
+	"github.com/GianGoulart/CrudProdutos/model"
+	"gorm.io/gorm"
+)
+
+// IOutboxStore interface para implementação do repositorio da outbox de eventos
+type IOutboxStore interface {
+	CreateEvento(ctx context.Context, evento *model.EventoOutbox) error
+	FindPendentes(ctx context.Context, limite int) (*[]model.EventoOutbox, error)
+	MarcarPublicados(ctx context.Context, sequencias []uint64) error
+	RegistrarFalha(ctx context.Context, evento *model.EventoOutbox) error
+	DeletePublicados(ctx context.Context, antes int64) (int64, error)
+}
+
+// NewOutbox cria uma nova instancia do repositorio da outbox
+func NewOutbox(reader *gorm.DB) IOutboxStore {
+	return &storeImpl{reader}
+}
+
+type storeImpl struct {
+	db *gorm.DB
+}
+
+const (
+	layout = "02-01-2006T15:04:05"
+)
+
+// Inserir grava o evento na transação informada, para que ele só exista se a
+// alteração que o originou for confirmada
+func Inserir(tx *gorm.DB, evento *model.EventoOutbox) error {
+	evento.CriadoEm = time.Now().Format(layout)
+
+	exec := "INSERT INTO outbox (codigo,agregado,tipo,payload,publicado,falhou,tentativas,ultimo_erro,criado_em,publicado_em) VALUES (?,?,?,?,?,?,?,?,?,?)"
+
+	return tx.Exec(exec, evento.Codigo, evento.Agregado, evento.Tipo, evento.Payload, false, false, 0, "", evento.CriadoEm, 0).Error
+}
+
+func (r *storeImpl) CreateEvento(ctx context.Context, evento *model.EventoOutbox) error {
+	if err := Inserir(r.db.WithContext(ctx), evento); err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("codigo", evento.Codigo).Error("store.outbox.CreateEvento")
+		return err
+	}
+
+	return nil
+}
+
+// FindPendentes retorna os eventos ainda não publicados na ordem em que foram gravados.
+// Só o primeiro evento de cada produto é tentado, então um evento com tentativas é
+// o que bloqueia o produto: ele volta para nova tentativa, mas os seguintes do mesmo
+// produto ficam de fora para não ocuparem o lote dos outros produtos
+func (r *storeImpl) FindPendentes(ctx context.Context, limite int) (*[]model.EventoOutbox, error) {
+	eventos := new([]model.EventoOutbox)
+
+	bloqueados := r.db.Model(&model.EventoOutbox{}).Select("agregado").Where("publicado = ? AND falhou = ? AND tentativas > ?", false, false, 0)
+
+	err := r.db.WithContext(ctx).
+		Where("publicado = ? AND falhou = ?", false, false).
+		Where("tentativas > ? OR agregado NOT IN (?)", 0, bloqueados).
+		Order("sequencia").
+		Limit(limite).
+		Find(&eventos).Error
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).Error("store.outbox.FindPendentes")
+		return eventos, err
+	}
+
+	return eventos, nil
+}
+
+func (r *storeImpl) MarcarPublicados(ctx context.Context, sequencias []uint64) error {
+	if len(sequencias) == 0 {
+		return nil
+	}
+
+	exec := "UPDATE outbox SET publicado=?,publicado_em=? WHERE sequencia IN ?"
+
+	if err := r.db.WithContext(ctx).Exec(exec, true, time.Now().Unix(), sequencias).Error; err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("sequencias", sequencias).Error("store.outbox.MarcarPublicados")
+		return err
+	}
+
+	return nil
+}
+
+func (r *storeImpl) RegistrarFalha(ctx context.Context, evento *model.EventoOutbox) error {
+	exec := "UPDATE outbox SET falhou=?,tentativas=?,ultimo_erro=? WHERE sequencia=?"
+
+	if err := r.db.WithContext(ctx).Exec(exec, evento.Falhou, evento.Tentativas, evento.UltimoErro, evento.Sequencia).Error; err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("codigo", evento.Codigo).Error("store.outbox.RegistrarFalha")
+		return err
+	}
+
+	return nil
+}
+
+// DeletePublicados remove os eventos publicados antes do instante informado, em
+// unix, e retorna quantos foram removidos
+func (r *storeImpl) DeletePublicados(ctx context.Context, antes int64) (int64, error) {
+	exec := "DELETE FROM outbox WHERE publicado=? AND publicado_em<?"
+
+	res := r.db.WithContext(ctx).Exec(exec, true, antes)
+	if res.Error != nil {
+		logger.FromContext(ctx).WithError(res.Error).Error("store.outbox.DeletePublicados")
+		return 0, res.Error
+	}
+
+	return res.RowsAffected, nil
+}
@@ -0,0 +1,96 @@
+package outbox_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/GianGoulart/CrudProdutos/store/outbox"
+	"github.com/GianGoulart/CrudProdutos/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Outbox(t *testing.T) {
+	cases := map[string]struct {
+		Store outbox.IOutboxStore
+	}{
+		"sqlite":  {Store: outbox.NewOutbox(test.GetSQLite(t, model.EventoOutbox{}))},
+		"memoria": {Store: outbox.NewOutboxMemory()},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			s := cs.Store
+
+			for _, e := range []model.EventoOutbox{
+				{Codigo: "e1", Agregado: "p1", Tipo: model.EventoProdutoCriado, Payload: "{}"},
+				{Codigo: "e2", Agregado: "p2", Tipo: model.EventoProdutoCriado, Payload: "{}"},
+				{Codigo: "e3", Agregado: "p1", Tipo: model.EventoProdutoAlterado, Payload: "{}"},
+			} {
+				e := e
+				assert.NoError(t, s.CreateEvento(ctx, &e))
+			}
+
+			pendentes, err := s.FindPendentes(ctx, 2)
+			assert.NoError(t, err)
+			if assert.Len(t, *pendentes, 2) {
+				assert.Equal(t, "e1", (*pendentes)[0].Codigo)
+				assert.Equal(t, "e2", (*pendentes)[1].Codigo)
+			}
+
+			falha := (*pendentes)[1]
+			falha.Tentativas = 1
+			falha.UltimoErro = "broker fora do ar"
+			assert.NoError(t, s.RegistrarFalha(ctx, &falha))
+
+			assert.NoError(t, s.MarcarPublicados(ctx, []uint64{(*pendentes)[0].Sequencia}))
+
+			pendentes, err = s.FindPendentes(ctx, 10)
+			assert.NoError(t, err)
+			if assert.Len(t, *pendentes, 2) {
+				assert.Equal(t, "e2", (*pendentes)[0].Codigo)
+				assert.Equal(t, 1, (*pendentes)[0].Tentativas)
+				assert.Equal(t, "broker fora do ar", (*pendentes)[0].UltimoErro)
+				assert.Equal(t, "e3", (*pendentes)[1].Codigo)
+			}
+
+			// só os eventos publicados antes do instante informado são removidos
+			removidos, err := s.DeletePublicados(ctx, time.Now().Add(-time.Hour).Unix())
+			assert.NoError(t, err)
+			assert.Equal(t, int64(0), removidos)
+
+			removidos, err = s.DeletePublicados(ctx, time.Now().Add(time.Hour).Unix())
+			assert.NoError(t, err)
+			assert.Equal(t, int64(1), removidos)
+
+			pendentes, err = s.FindPendentes(ctx, 10)
+			assert.NoError(t, err)
+			assert.Len(t, *pendentes, 2)
+
+			// o evento seguinte do produto bloqueado pelo e2 fica de fora
+			e4 := model.EventoOutbox{Codigo: "e4", Agregado: "p2", Tipo: model.EventoProdutoAlterado, Payload: "{}"}
+			assert.NoError(t, s.CreateEvento(ctx, &e4))
+
+			pendentes, err = s.FindPendentes(ctx, 10)
+			assert.NoError(t, err)
+			if assert.Len(t, *pendentes, 2) {
+				assert.Equal(t, "e2", (*pendentes)[0].Codigo)
+				assert.Equal(t, "e3", (*pendentes)[1].Codigo)
+			}
+
+			// o evento falho não é mais pendente e libera o seguinte
+			falha.Tentativas = 2
+			falha.Falhou = true
+			assert.NoError(t, s.RegistrarFalha(ctx, &falha))
+
+			pendentes, err = s.FindPendentes(ctx, 10)
+			assert.NoError(t, err)
+			if assert.Len(t, *pendentes, 2) {
+				assert.Equal(t, "e3", (*pendentes)[0].Codigo)
+				assert.Equal(t, "e4", (*pendentes)[1].Codigo)
+			}
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package produto
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/GianGoulart/CrudProdutos/logger"
+	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/GianGoulart/CrudProdutos/store/cache"
+)
+
+// NewProdutoCache cria um decorator do repositorio de produto que faz a leitura
+// por codigo através do cache, invalidando a chave a cada escrita
+func NewProdutoCache(next IProdutoStore, c cache.Cache, ttl time.Duration) IProdutoStore {
+	return &cacheImpl{
+		next:  next,
+		cache: c,
+		ttl:   ttl,
+	}
+}
+
+type cacheImpl struct {
+	next  IProdutoStore
+	cache cache.Cache
+	ttl   time.Duration
+}
+
+func cacheKey(codigo string) string {
+	return "produto:" + codigo
+}
+
+func (r *cacheImpl) FindProdutos(ctx context.Context) (*[]model.Produto, error) {
+	return r.next.FindProdutos(ctx)
+}
+
+func (r *cacheImpl) FindProdutoByCodigo(ctx context.Context, codigo string) (*model.Produto, error) {
+	key := cacheKey(codigo)
+
+	// falhas no cache não impedem a leitura no repositorio
+	data, ok, err := r.cache.Get(ctx, key)
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("codigo", codigo).Warn("store.produto.cache.FindProdutoByCodigo")
+	}
+
+	if ok {
+		produto := new(model.Produto)
+		if err := json.Unmarshal(data, produto); err == nil {
+			return produto, nil
+		}
+	}
+
+	produto, err := r.next.FindProdutoByCodigo(ctx, codigo)
+	if err != nil {
+		return produto, err
+	}
+
+	// produtos inexistentes não são guardados para não atrasar a sua criação
+	if produto.Codigo == "" {
+		return produto, nil
+	}
+
+	if data, err := json.Marshal(produto); err == nil {
+		if err := r.cache.Set(ctx, key, data, r.ttl); err != nil {
+			logger.FromContext(ctx).WithError(err).WithField("codigo", codigo).Warn("store.produto.cache.FindProdutoByCodigo")
+		}
+	}
+
+	return produto, nil
+}
+
+func (r *cacheImpl) FindProdutoByNome(ctx context.Context, nome string) (*[]model.Produto, error) {
+	return r.next.FindProdutoByNome(ctx, nome)
+}
+
+func (r *cacheImpl) FindProdutosByCodigos(ctx context.Context, codigos []string) (*[]model.Produto, error) {
+	return r.next.FindProdutosByCodigos(ctx, codigos)
+}
+
+func (r *cacheImpl) FindProdutosByMarca(ctx context.Context, marca string) (*[]model.Produto, error) {
+	return r.next.FindProdutosByMarca(ctx, marca)
+}
+
+func (r *cacheImpl) SearchProdutos(ctx context.Context, termos []string) (*[]model.Produto, error) {
+	return r.next.SearchProdutos(ctx, termos)
+}
+
+func (r *cacheImpl) CreateProduto(ctx context.Context, produto *model.Produto) (*model.Produto, error) {
+	return r.next.CreateProduto(ctx, produto)
+}
+
+func (r *cacheImpl) UpdateProduto(ctx context.Context, produto *model.Produto) (*model.Produto, error) {
+	res, err := r.next.UpdateProduto(ctx, produto)
+	if err != nil {
+		return res, err
+	}
+
+	r.invalidate(ctx, produto.Codigo)
+
+	return res, nil
+}
+
+func (r *cacheImpl) DeleteProdutoByCodigo(ctx context.Context, produto *model.Produto) error {
+	if err := r.next.DeleteProdutoByCodigo(ctx, produto); err != nil {
+		return err
+	}
+
+	r.invalidate(ctx, produto.Codigo)
+
+	return nil
+}
+
+func (r *cacheImpl) ReajustarPrecos(ctx context.Context, itens []model.ItemReajuste) (*[]model.Produto, error) {
+	res, err := r.next.ReajustarPrecos(ctx, itens)
+	if err != nil {
+		return res, err
+	}
+
+	for _, item := range itens {
+		r.invalidate(ctx, item.Codigo)
+	}
+
+	return res, nil
+}
+
+func (r *cacheImpl) invalidate(ctx context.Context, codigo string) {
+	if err := r.cache.Delete(ctx, cacheKey(codigo)); err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("codigo", codigo).Error("store.produto.cache.invalidate")
+	}
+}
@@ -0,0 +1,74 @@
+package produto_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/GianGoulart/CrudProdutos/mocks"
+	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/GianGoulart/CrudProdutos/store/cache"
+	"github.com/GianGoulart/CrudProdutos/store/produto"
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Cache_FindProdutoByCodigo(t *testing.T) {
+	ctx := context.Background()
+
+	cases := map[string]struct {
+		ExpectedCalls  int
+		ExpectedHits   uint64
+		ExpectedMisses uint64
+
+		Prepare func(store produto.IProdutoStore)
+	}{
+		"deve ler do repositorio apenas na primeira vez": {ExpectedCalls: 1, ExpectedHits: 2, ExpectedMisses: 1, Prepare: func(store produto.IProdutoStore) {}},
+		"deve ler novamente do repositorio após o update": {ExpectedCalls: 2, ExpectedHits: 1, ExpectedMisses: 2, Prepare: func(store produto.IProdutoStore) {
+			store.UpdateProduto(ctx, &res[0])
+		}},
+		"deve ler novamente do repositorio após o delete": {ExpectedCalls: 2, ExpectedHits: 1, ExpectedMisses: 2, Prepare: func(store produto.IProdutoStore) {
+			store.DeleteProdutoByCodigo(ctx, &res[0])
+		}},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			mock := new(mocks.IProdutoStore)
+			mock.On("FindProdutoByCodigo", ctx, res[0].Codigo).Return(&res[0], nil)
+			mock.On("UpdateProduto", ctx, &res[0]).Return(&res[0], nil)
+			mock.On("DeleteProdutoByCodigo", ctx, &res[0]).Return(nil)
+
+			lru := cache.NewLRU(10)
+			store := produto.NewProdutoCache(mock, lru, time.Minute)
+
+			store.FindProdutoByCodigo(ctx, res[0].Codigo)
+			store.FindProdutoByCodigo(ctx, res[0].Codigo)
+			cs.Prepare(store)
+			response, err := store.FindProdutoByCodigo(ctx, res[0].Codigo)
+
+			assert.NoError(t, err)
+			if diff := cmp.Diff(response, &res[0]); diff != "" {
+				t.Error(diff)
+			}
+
+			mock.AssertNumberOfCalls(t, "FindProdutoByCodigo", cs.ExpectedCalls)
+			assert.Equal(t, cs.ExpectedHits, lru.Stats().Hits)
+			assert.Equal(t, cs.ExpectedMisses, lru.Stats().Misses)
+		})
+	}
+}
+
+func Test_Cache_ProdutoInexistente(t *testing.T) {
+	ctx := context.Background()
+
+	mock := new(mocks.IProdutoStore)
+	mock.On("FindProdutoByCodigo", ctx, "inexistente").Return(new(model.Produto), nil)
+
+	store := produto.NewProdutoCache(mock, cache.NewLRU(10), time.Minute)
+
+	store.FindProdutoByCodigo(ctx, "inexistente")
+	store.FindProdutoByCodigo(ctx, "inexistente")
+
+	mock.AssertNumberOfCalls(t, "FindProdutoByCodigo", 2)
+}
@@ -0,0 +1,252 @@
+package produto
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/GianGoulart/CrudProdutos/logger"
+	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/GianGoulart/CrudProdutos/store/outbox"
+)
+
+// ErrProdutoDuplicado erro retornado ao criar um produto com um codigo já existente
+var ErrProdutoDuplicado = errors.New("produto já cadastrado")
+
+// NewProdutoMemory cria uma nova instancia do repositorio de produto em memória,
+// sem dependências externas, para desenvolvimento e testes
+func NewProdutoMemory() IProdutoStore {
+	return &memoryImpl{
+		produtos: make(map[string]model.Produto),
+	}
+}
+
+// NewProdutoMemoryComOutbox cria o repositorio de produto em memória que grava
+// os eventos de produto na outbox informada
+func NewProdutoMemoryComOutbox(eventos outbox.IOutboxStore) IProdutoStore {
+	return &memoryImpl{
+		produtos: make(map[string]model.Produto),
+		outbox:   eventos,
+	}
+}
+
+type memoryImpl struct {
+	mu       sync.RWMutex
+	produtos map[string]model.Produto
+	// outbox recebe os eventos de produto, nil quando desabilitada
+	outbox outbox.IOutboxStore
+}
+
+func (r *memoryImpl) FindProdutos(ctx context.Context) (*[]model.Produto, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	produtos := make([]model.Produto, 0, len(r.produtos))
+	for _, produto := range r.produtos {
+		produtos = append(produtos, produto)
+	}
+
+	sortByCodigo(produtos)
+
+	return &produtos, nil
+}
+
+func (r *memoryImpl) FindProdutoByCodigo(ctx context.Context, codigo string) (*model.Produto, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	// assim como no banco, um codigo inexistente retorna um produto vazio
+	produto := r.produtos[codigo]
+
+	return &produto, nil
+}
+
+func (r *memoryImpl) FindProdutoByNome(ctx context.Context, nome string) (*[]model.Produto, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	prefix := strings.ToLower(nome)
+
+	produtos := make([]model.Produto, 0)
+	for _, produto := range r.produtos {
+		if strings.HasPrefix(strings.ToLower(produto.Nome), prefix) {
+			produtos = append(produtos, produto)
+		}
+	}
+
+	sortByCodigo(produtos)
+
+	return &produtos, nil
+}
+
+func (r *memoryImpl) FindProdutosByCodigos(ctx context.Context, codigos []string) (*[]model.Produto, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	produtos := make([]model.Produto, 0, len(codigos))
+	for _, codigo := range codigos {
+		if produto, ok := r.produtos[codigo]; ok {
+			produtos = append(produtos, produto)
+		}
+	}
+
+	sortByCodigo(produtos)
+
+	return &produtos, nil
+}
+
+func (r *memoryImpl) FindProdutosByMarca(ctx context.Context, marca string) (*[]model.Produto, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	produtos := make([]model.Produto, 0)
+	for _, produto := range r.produtos {
+		if produto.Marca == marca {
+			produtos = append(produtos, produto)
+		}
+	}
+
+	sortByCodigo(produtos)
+
+	return &produtos, nil
+}
+
+func (r *memoryImpl) SearchProdutos(ctx context.Context, termos []string) (*[]model.Produto, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	produtos := make([]model.Produto, 0)
+	for _, produto := range r.produtos {
+		nome := model.Normalize(produto.Nome)
+		for _, termo := range termos {
+			if strings.Contains(nome, termo) {
+				produtos = append(produtos, produto)
+				break
+			}
+		}
+	}
+
+	sortByCodigo(produtos)
+
+	return &produtos, nil
+}
+
+func (r *memoryImpl) CreateProduto(ctx context.Context, produto *model.Produto) (*model.Produto, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.produtos[produto.Codigo]; ok {
+		logger.FromContext(ctx).WithError(ErrProdutoDuplicado).WithField("codigo", produto.Codigo).Error("store.produto.memory.CreateProduto")
+		return &model.Produto{}, ErrProdutoDuplicado
+	}
+
+	produto.CriadoEm = time.Now().Format(layout)
+	produto.UltimaAlteracao = time.Now().Format(layout)
+
+	if err := r.publicar(ctx, model.EventoProdutoCriado, produto); err != nil {
+		return &model.Produto{}, err
+	}
+
+	r.produtos[produto.Codigo] = *produto
+
+	return produto, nil
+}
+
+func (r *memoryImpl) UpdateProduto(ctx context.Context, produto *model.Produto) (*model.Produto, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	produto.UltimaAlteracao = time.Now().Format(layout)
+	produto.EstoqueDisponivel = produto.EstoqueTotal - produto.EstoqueCorte
+
+	// assim como o UPDATE no banco, um codigo inexistente não altera nada
+	atual, ok := r.produtos[produto.Codigo]
+	if !ok {
+		return produto, nil
+	}
+
+	produto.CriadoEm = atual.CriadoEm
+
+	if err := r.publicar(ctx, model.EventoProdutoAlterado, produto); err != nil {
+		return &model.Produto{}, err
+	}
+
+	r.produtos[produto.Codigo] = *produto
+
+	return produto, nil
+}
+
+func (r *memoryImpl) DeleteProdutoByCodigo(ctx context.Context, produto *model.Produto) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.produtos[produto.Codigo]; !ok {
+		return nil
+	}
+
+	if err := r.publicar(ctx, model.EventoProdutoRemovido, produto); err != nil {
+		return err
+	}
+
+	delete(r.produtos, produto.Codigo)
+
+	return nil
+}
+
+func (r *memoryImpl) ReajustarPrecos(ctx context.Context, itens []model.ItemReajuste) (*[]model.Produto, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// todos os preços são conferidos antes de alterar qualquer produto
+	for _, item := range itens {
+		atual, ok := r.produtos[item.Codigo]
+		if !ok || atual.PrecoDe != item.PrecoDeAnterior || atual.PrecoPor != item.PrecoPorAnterior {
+			logger.FromContext(ctx).WithError(ErrPrecoAlterado).WithField("codigo", item.Codigo).Error("store.produto.memory.ReajustarPrecos")
+			return nil, ErrPrecoAlterado
+		}
+	}
+
+	agora := time.Now().Format(layout)
+	produtos := make([]model.Produto, 0, len(itens))
+	for _, item := range itens {
+		produto := r.produtos[item.Codigo]
+		produto.PrecoDe = item.PrecoDe
+		produto.PrecoPor = item.PrecoPor
+		produto.UltimaAlteracao = agora
+
+		if err := r.publicar(ctx, model.EventoProdutoAlterado, &produto); err != nil {
+			return nil, err
+		}
+
+		produtos = append(produtos, produto)
+	}
+
+	for _, produto := range produtos {
+		r.produtos[produto.Codigo] = produto
+	}
+
+	return &produtos, nil
+}
+
+// publicar grava o evento na outbox, chamado com o lock das alterações
+func (r *memoryImpl) publicar(ctx context.Context, tipo string, produto *model.Produto) error {
+	if r.outbox == nil {
+		return nil
+	}
+
+	evento, err := model.NovoEventoOutbox(tipo, produto)
+	if err != nil {
+		return err
+	}
+
+	return r.outbox.CreateEvento(ctx, evento)
+}
+
+func sortByCodigo(produtos []model.Produto) {
+	sort.Slice(produtos, func(i, j int) bool {
+		return produtos[i].Codigo < produtos[j].Codigo
+	})
+}
@@ -0,0 +1,136 @@
+package produto_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/GianGoulart/CrudProdutos/store/produto"
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+)
+
+func newMemory(t *testing.T, produtos ...model.Produto) produto.IProdutoStore {
+	store := produto.NewProdutoMemory()
+
+	for _, p := range produtos {
+		p := p
+		if _, err := store.CreateProduto(context.Background(), &p); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return store
+}
+
+func Test_Memory_FindProdutoByNome(t *testing.T) {
+	ctx := context.Background()
+	store := newMemory(t,
+		model.Produto{Codigo: "a", Nome: "Televisao SAMSUNG"},
+		model.Produto{Codigo: "b", Nome: "TELEVISAO SONY"},
+		model.Produto{Codigo: "c", Nome: "Geladeira"},
+	)
+
+	cases := map[string]struct {
+		Input        string
+		ExpectedData []string
+	}{
+		"deve ignorar maiusculas e minusculas": {Input: "televisao", ExpectedData: []string{"a", "b"}},
+		"deve buscar apenas pelo prefixo":      {Input: "SONY", ExpectedData: []string{}},
+		"deve retornar todos com nome vazio":   {Input: "", ExpectedData: []string{"a", "b", "c"}},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			response, err := store.FindProdutoByNome(ctx, cs.Input)
+			assert.NoError(t, err)
+
+			codigos := []string{}
+			for _, p := range *response {
+				codigos = append(codigos, p.Codigo)
+			}
+
+			if diff := cmp.Diff(codigos, cs.ExpectedData); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func Test_Memory_CreateProduto(t *testing.T) {
+	ctx := context.Background()
+	store := newMemory(t, model.Produto{Codigo: res[0].Codigo, Nome: res[0].Nome})
+
+	novo := &model.Produto{Codigo: "novo", Nome: "Notebook"}
+	response, err := store.CreateProduto(ctx, novo)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, response.CriadoEm)
+	assert.Equal(t, response.CriadoEm, response.UltimaAlteracao)
+
+	_, err = store.CreateProduto(ctx, &model.Produto{Codigo: res[0].Codigo})
+	assert.Equal(t, produto.ErrProdutoDuplicado, err)
+
+	produtos, err := store.FindProdutos(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, *produtos, 2)
+}
+
+func Test_Memory_UpdateProduto(t *testing.T) {
+	ctx := context.Background()
+	store := newMemory(t, model.Produto{Codigo: res[0].Codigo, Nome: res[0].Nome})
+
+	criado, _ := store.FindProdutoByCodigo(ctx, res[0].Codigo)
+
+	response, err := store.UpdateProduto(ctx, &model.Produto{
+		Codigo:       res[0].Codigo,
+		Nome:         "Televisao LG",
+		EstoqueTotal: 100,
+		EstoqueCorte: 10,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(90), response.EstoqueDisponivel)
+
+	found, err := store.FindProdutoByCodigo(ctx, res[0].Codigo)
+	assert.NoError(t, err)
+	assert.Equal(t, "Televisao LG", found.Nome)
+	assert.Equal(t, criado.CriadoEm, found.CriadoEm)
+}
+
+func Test_Memory_DeleteProdutoByCodigo(t *testing.T) {
+	ctx := context.Background()
+	store := newMemory(t, model.Produto{Codigo: res[0].Codigo, Nome: res[0].Nome})
+
+	assert.NoError(t, store.DeleteProdutoByCodigo(ctx, &res[0]))
+
+	found, err := store.FindProdutoByCodigo(ctx, res[0].Codigo)
+	assert.NoError(t, err)
+
+	if diff := cmp.Diff(found, new(model.Produto)); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func Test_Memory_Concorrencia(t *testing.T) {
+	ctx := context.Background()
+	store := produto.NewProdutoMemory()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			p := &model.Produto{Codigo: fmt.Sprint(i), Nome: "Produto"}
+			store.CreateProduto(ctx, p)
+			store.UpdateProduto(ctx, p)
+			store.FindProdutoByNome(ctx, "prod")
+		}(i)
+	}
+	wg.Wait()
+
+	produtos, err := store.FindProdutos(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, *produtos, 50)
+}
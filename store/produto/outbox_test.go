@@ -0,0 +1,79 @@
+package produto_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/GianGoulart/CrudProdutos/store/outbox"
+	"github.com/GianGoulart/CrudProdutos/store/produto"
+	"github.com/GianGoulart/CrudProdutos/test"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test_Outbox garante que cada alteração grava o seu evento, no banco e em memória
+func Test_Outbox(t *testing.T) {
+	cases := map[string]struct {
+		NewStore func(t *testing.T) (produto.IProdutoStore, outbox.IOutboxStore)
+	}{
+		"sqlite": {NewStore: func(t *testing.T) (produto.IProdutoStore, outbox.IOutboxStore) {
+			db := test.GetSQLite(t, model.Produto{}, model.EventoOutbox{})
+
+			return produto.NewProdutoComOutbox(db), outbox.NewOutbox(db)
+		}},
+		"memoria": {NewStore: func(t *testing.T) (produto.IProdutoStore, outbox.IOutboxStore) {
+			eventos := outbox.NewOutboxMemory()
+			return produto.NewProdutoMemoryComOutbox(eventos), eventos
+		}},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			s, eventos := cs.NewStore(t)
+
+			p := res[0]
+			_, err := s.CreateProduto(ctx, &p)
+			assert.NoError(t, err)
+
+			p.EstoqueCorte = 20
+			_, err = s.UpdateProduto(ctx, &p)
+			assert.NoError(t, err)
+
+			// alterações que não encontram o produto não geram eventos
+			_, err = s.UpdateProduto(ctx, &model.Produto{Codigo: "inexistente"})
+			assert.NoError(t, err)
+			assert.NoError(t, s.DeleteProdutoByCodigo(ctx, &model.Produto{Codigo: "inexistente"}))
+
+			assert.NoError(t, s.DeleteProdutoByCodigo(ctx, &p))
+
+			pendentes, err := eventos.FindPendentes(ctx, 10)
+			assert.NoError(t, err)
+
+			tipos := []string{}
+			for _, evento := range *pendentes {
+				assert.Equal(t, p.Codigo, evento.Agregado)
+				tipos = append(tipos, evento.Tipo)
+			}
+			assert.Equal(t, []string{model.EventoProdutoCriado, model.EventoProdutoAlterado, model.EventoProdutoRemovido}, tipos)
+		})
+	}
+}
+
+// Test_Outbox_Transacao garante que a alteração não é gravada sem o seu evento
+func Test_Outbox_Transacao(t *testing.T) {
+	ctx := context.Background()
+
+	// sem a tabela da outbox a gravação do evento falha
+	db := test.GetSQLite(t, model.Produto{})
+
+	s := produto.NewProdutoComOutbox(db)
+
+	p := res[0]
+	_, err := s.CreateProduto(ctx, &p)
+	assert.Error(t, err)
+
+	found, err := s.FindProdutoByCodigo(ctx, p.Codigo)
+	assert.NoError(t, err)
+	assert.Empty(t, found.Codigo)
+}
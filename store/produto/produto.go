@@ -2,10 +2,13 @@ package produto
 
 import (
 	"context"
+	"errors"
+	"strings"
 	"time"
 
+	"github.com/GianGoulart/CrudProdutos/logger"
 	"github.com/GianGoulart/CrudProdutos/model"
-	"github.com/sirupsen/logrus"
+	"github.com/GianGoulart/CrudProdutos/store/outbox"
 	"gorm.io/gorm"
 )
 
@@ -15,17 +18,32 @@ type IProdutoStore interface {
 	CreateProduto(ctx context.Context, produto *model.Produto) (*model.Produto, error)
 	FindProdutoByCodigo(ctx context.Context, codigo string) (*model.Produto, error)
 	FindProdutoByNome(ctx context.Context, nome string) (*[]model.Produto, error)
+	FindProdutosByCodigos(ctx context.Context, codigos []string) (*[]model.Produto, error)
+	FindProdutosByMarca(ctx context.Context, marca string) (*[]model.Produto, error)
+	SearchProdutos(ctx context.Context, termos []string) (*[]model.Produto, error)
 	UpdateProduto(ctx context.Context, produto *model.Produto) (*model.Produto, error)
 	DeleteProdutoByCodigo(ctx context.Context, produto *model.Produto) error
+	ReajustarPrecos(ctx context.Context, itens []model.ItemReajuste) (*[]model.Produto, error)
 }
 
+// ErrPrecoAlterado erro retornado quando o preço de um produto do reajuste não é
+// mais o da prévia, desfazendo todo o reajuste
+var ErrPrecoAlterado = errors.New("preço alterado depois da prévia do reajuste")
+
 // NewProduto cria uma nova instancia do repositorio de produto
 func NewProduto(reader *gorm.DB) IProdutoStore {
-	return &storeImpl{reader}
+	return &storeImpl{db: reader}
+}
+
+// NewProdutoComOutbox cria o repositorio de produto que grava os eventos de
+// produto na outbox, na mesma transação das alterações
+func NewProdutoComOutbox(reader *gorm.DB) IProdutoStore {
+	return &storeImpl{db: reader, outbox: true}
 }
 
 type storeImpl struct {
-	db *gorm.DB
+	db     *gorm.DB
+	outbox bool
 }
 
 const (
@@ -37,7 +55,7 @@ func (r *storeImpl) FindProdutos(ctx context.Context) (*[]model.Produto, error)
 
 	if err := r.db.Find(&produtos).Error; err != nil {
 
-		logrus.Error("store.produtos.FindProdutos", err.Error())
+		logger.FromContext(ctx).WithError(err).Error("store.produtos.FindProdutos")
 		return produtos, err
 	}
 
@@ -51,7 +69,7 @@ func (r *storeImpl) FindProdutoByCodigo(ctx context.Context, codigo string) (*mo
 
 	if err := r.db.WithContext(ctx).Where(&model.Produto{Codigo: codigo}).Find(res).Error; err != nil {
 
-		logrus.Error("store.produto.FindProdutoByCodigo", err.Error())
+		logger.FromContext(ctx).WithError(err).WithField("codigo", codigo).Error("store.produto.FindProdutoByCodigo")
 		return res, err
 	}
 
@@ -62,25 +80,75 @@ func (r *storeImpl) FindProdutoByCodigo(ctx context.Context, codigo string) (*mo
 func (r *storeImpl) FindProdutoByNome(ctx context.Context, nome string) (*[]model.Produto, error) {
 	produtos := new([]model.Produto)
 
-	if err := r.db.Where("nome like ?", nome+"%").Find(&produtos).Error; err != nil {
+	if err := r.db.Where("LOWER(nome) LIKE LOWER(?)", nome+"%").Find(&produtos).Error; err != nil {
+
+		logger.FromContext(ctx).WithError(err).WithField("nome", nome).Error("store.produtos.FindProdutoByNome")
+		return produtos, err
+	}
+
+	return produtos, nil
+
+}
+
+func (r *storeImpl) FindProdutosByCodigos(ctx context.Context, codigos []string) (*[]model.Produto, error) {
+	produtos := new([]model.Produto)
+	if len(codigos) == 0 {
+		return produtos, nil
+	}
+
+	if err := r.db.WithContext(ctx).Where("codigo IN ?", codigos).Order("codigo").Find(&produtos).Error; err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("codigos", codigos).Error("store.produtos.FindProdutosByCodigos")
+		return produtos, err
+	}
 
-		logrus.Error("store.produtos.FindProdutoByNome", err.Error())
+	return produtos, nil
+}
+
+func (r *storeImpl) FindProdutosByMarca(ctx context.Context, marca string) (*[]model.Produto, error) {
+	produtos := new([]model.Produto)
+
+	if err := r.db.WithContext(ctx).Where("marca = ?", marca).Order("codigo").Find(&produtos).Error; err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("marca", marca).Error("store.produtos.FindProdutosByMarca")
 		return produtos, err
 	}
 
 	return produtos, nil
+}
+
+// likeEscaper escapa os curingas do LIKE com um caractere aceito por todos os dialetos
+var likeEscaper = strings.NewReplacer("!", "!!", "%", "!%", "_", "!_")
+
+func (r *storeImpl) SearchProdutos(ctx context.Context, termos []string) (*[]model.Produto, error) {
+	produtos := new([]model.Produto)
+	if len(termos) == 0 {
+		return produtos, nil
+	}
 
+	conds := make([]string, 0, len(termos))
+	args := make([]interface{}, 0, len(termos))
+	for _, termo := range termos {
+		conds = append(conds, "nome_busca LIKE ? ESCAPE '!'")
+		args = append(args, "%"+likeEscaper.Replace(termo)+"%")
+	}
+
+	if err := r.db.WithContext(ctx).Where(strings.Join(conds, " OR "), args...).Find(&produtos).Error; err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("termos", termos).Error("store.produtos.SearchProdutos")
+		return produtos, err
+	}
+
+	return produtos, nil
 }
 
 func (r *storeImpl) CreateProduto(ctx context.Context, produto *model.Produto) (*model.Produto, error) {
 
 	produto.CriadoEm = time.Now().Format(layout)
 	produto.UltimaAlteracao = time.Now().Format(layout)
+	produto.NomeBusca = model.Normalize(produto.Nome)
 
-	exec := "INSERT INTO `produtos` (`codigo`,`nome`,`preco_de`,`preco_por`,`criado_em`,`ultima_alteracao`,`estoque_total`,`estoque_corte`,`estoque_disponivel`) VALUES (?,?,?,?,?,?,?,?,?)"
+	exec := "INSERT INTO produtos (codigo,nome,preco_de,preco_por,criado_em,ultima_alteracao,estoque_total,estoque_corte,estoque_disponivel,nome_busca,marca,limite_alerta) VALUES (?,?,?,?,?,?,?,?,?,?,?,?)"
 
-	if err := r.db.Exec(exec, produto.Codigo, produto.Nome, produto.PrecoDe, produto.PrecoPor, produto.CriadoEm, produto.UltimaAlteracao, produto.EstoqueTotal, produto.EstoqueCorte, produto.EstoqueDisponivel).Error; err != nil {
-		logrus.Error("store.produto.CreateProduto", err.Error())
+	if err := r.exec(ctx, model.EventoProdutoCriado, produto, exec, produto.Codigo, produto.Nome, produto.PrecoDe, produto.PrecoPor, produto.CriadoEm, produto.UltimaAlteracao, produto.EstoqueTotal, produto.EstoqueCorte, produto.EstoqueDisponivel, produto.NomeBusca, produto.Marca, produto.LimiteAlerta); err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("codigo", produto.Codigo).Error("store.produto.CreateProduto")
 		return &model.Produto{}, err
 	}
 
@@ -91,11 +159,12 @@ func (r *storeImpl) UpdateProduto(ctx context.Context, produto *model.Produto) (
 
 	produto.UltimaAlteracao = time.Now().Format(layout)
 	produto.EstoqueDisponivel = produto.EstoqueTotal - produto.EstoqueCorte
+	produto.NomeBusca = model.Normalize(produto.Nome)
 
-	exec := "UPDATE `produtos` SET `nome`=?, `preco_de`=?,`preco_por`=?,`ultima_alteracao`=?,`estoque_total`=?,`estoque_corte`=?,`estoque_disponivel`=? WHERE `codigo`=?"
+	exec := "UPDATE produtos SET nome=?, preco_de=?,preco_por=?,ultima_alteracao=?,estoque_total=?,estoque_corte=?,estoque_disponivel=?,nome_busca=?,marca=?,limite_alerta=? WHERE codigo=?"
 
-	if err := r.db.Exec(exec, produto.Nome, produto.PrecoDe, produto.PrecoPor, produto.UltimaAlteracao, produto.EstoqueTotal, produto.EstoqueCorte, produto.EstoqueDisponivel, produto.Codigo).Error; err != nil {
-		logrus.Error("store.produto.UpdateProdutoByCodigo", err.Error())
+	if err := r.exec(ctx, model.EventoProdutoAlterado, produto, exec, produto.Nome, produto.PrecoDe, produto.PrecoPor, produto.UltimaAlteracao, produto.EstoqueTotal, produto.EstoqueCorte, produto.EstoqueDisponivel, produto.NomeBusca, produto.Marca, produto.LimiteAlerta, produto.Codigo); err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("codigo", produto.Codigo).Error("store.produto.UpdateProdutoByCodigo")
 		return &model.Produto{}, err
 	}
 
@@ -104,11 +173,97 @@ func (r *storeImpl) UpdateProduto(ctx context.Context, produto *model.Produto) (
 
 func (r *storeImpl) DeleteProdutoByCodigo(ctx context.Context, produto *model.Produto) error {
 
-	exec := "DELETE FROM `produtos` WHERE `codigo`=?"
-	if err := r.db.Exec(exec, produto.Codigo).Error; err != nil {
-		logrus.Error("store.produto.DeleteProdutoByCodigo", err.Error())
+	exec := "DELETE FROM produtos WHERE codigo=?"
+	if err := r.exec(ctx, model.EventoProdutoRemovido, produto, exec, produto.Codigo); err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("codigo", produto.Codigo).Error("store.produto.DeleteProdutoByCodigo")
+		return err
+	}
+
+	return nil
+}
+
+// ReajustarPrecos altera os preços de todos os itens numa única transação, apenas
+// quando os preços atuais ainda são os anteriores do item
+func (r *storeImpl) ReajustarPrecos(ctx context.Context, itens []model.ItemReajuste) (*[]model.Produto, error) {
+	produtos := make([]model.Produto, 0, len(itens))
+	agora := time.Now().Format(layout)
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		exec := "UPDATE produtos SET preco_de=?,preco_por=?,ultima_alteracao=? WHERE codigo=? AND preco_de=? AND preco_por=?"
+
+		for _, item := range itens {
+			res := tx.Exec(exec, item.PrecoDe, item.PrecoPor, agora, item.Codigo, item.PrecoDeAnterior, item.PrecoPorAnterior)
+			if res.Error != nil {
+				return res.Error
+			}
+			if res.RowsAffected == 0 {
+				return ErrPrecoAlterado
+			}
+
+			produto := model.Produto{}
+			if err := tx.Where(&model.Produto{Codigo: item.Codigo}).Find(&produto).Error; err != nil {
+				return err
+			}
+
+			if r.outbox {
+				evento, err := model.NovoEventoOutbox(model.EventoProdutoAlterado, &produto)
+				if err != nil {
+					return err
+				}
+
+				if err := outbox.Inserir(tx, evento); err != nil {
+					return err
+				}
+			}
+
+			produtos = append(produtos, produto)
+		}
+
+		return nil
+	})
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("itens", len(itens)).Error("store.produto.ReajustarPrecos")
+		return nil, err
+	}
+
+	return &produtos, nil
+}
+
+// exec executa a alteração do produto e, com a outbox habilitada, grava o evento
+// na mesma transação. Uma alteração que não encontrou o produto não gera evento
+func (r *storeImpl) exec(ctx context.Context, tipo string, produto *model.Produto, exec string, args ...interface{}) error {
+	if !r.outbox {
+		return r.db.Exec(exec, args...).Error
+	}
+
+	evento, err := model.NovoEventoOutbox(tipo, produto)
+	if err != nil {
 		return err
 	}
 
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Exec(exec, args...)
+		if res.Error != nil || res.RowsAffected == 0 {
+			return res.Error
+		}
+
+		return outbox.Inserir(tx, evento)
+	})
+}
+
+// MigrateNomeBusca preenche o nome normalizado para busca dos produtos cadastrados antes da sua criação
+func MigrateNomeBusca(ctx context.Context, db *gorm.DB) error {
+	produtos := []model.Produto{}
+	if err := db.WithContext(ctx).Where("nome_busca = ? OR nome_busca IS NULL", "").Find(&produtos).Error; err != nil {
+		return err
+	}
+
+	for _, produto := range produtos {
+		exec := "UPDATE produtos SET nome_busca=? WHERE codigo=?"
+		if err := db.WithContext(ctx).Exec(exec, model.Normalize(produto.Nome), produto.Codigo).Error; err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
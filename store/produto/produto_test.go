@@ -156,7 +156,7 @@ func Test_FindProdutoByCodigo(t *testing.T) {
 
 func Test_FindProdutoByNome(t *testing.T) {
 
-	query := regexp.QuoteMeta("SELECT * FROM `produtos` WHERE nome like ?")
+	query := regexp.QuoteMeta("SELECT * FROM `produtos` WHERE LOWER(nome) LIKE LOWER(?)")
 
 	rows := sqlmock.NewRows([]string{
 		"Codigo",
@@ -218,7 +218,7 @@ func Test_FindProdutoByNome(t *testing.T) {
 
 func Test_CreateProduto(t *testing.T) {
 
-	query := regexp.QuoteMeta("INSERT INTO `produtos` (`codigo`,`nome`,`preco_de`,`preco_por`,`criado_em`,`ultima_alteracao`,`estoque_total`,`estoque_corte`,`estoque_disponivel`) VALUES (?,?,?,?,?,?,?,?,?)")
+	query := regexp.QuoteMeta("INSERT INTO produtos (codigo,nome,preco_de,preco_por,criado_em,ultima_alteracao,estoque_total,estoque_corte,estoque_disponivel,nome_busca,marca,limite_alerta) VALUES (?,?,?,?,?,?,?,?,?,?,?,?)")
 
 	cases := map[string]struct {
 		ExpectedErr  error
@@ -237,6 +237,9 @@ func Test_CreateProduto(t *testing.T) {
 				res[0].EstoqueTotal,
 				res[0].EstoqueCorte,
 				res[0].EstoqueDisponivel,
+				model.Normalize(res[0].Nome),
+				res[0].Marca,
+				res[0].LimiteAlerta,
 			).WillReturnResult(sqlmock.NewResult(1, 1))
 		}},
 		"deve retornar erro com a mensagem: ocorreu um erro": {ExpectedData: new(model.Produto), PrepareMock: func(mock sqlmock.Sqlmock) {
@@ -268,7 +271,7 @@ func Test_CreateProduto(t *testing.T) {
 
 func Test_UpdateProdutoByCodigo(t *testing.T) {
 
-	query := regexp.QuoteMeta("UPDATE `produtos` SET `nome`=?, `preco_de`=?,`preco_por`=?,`ultima_alteracao`=?,`estoque_total`=?,`estoque_corte`=?,`estoque_disponivel`=? WHERE `codigo`=?")
+	query := regexp.QuoteMeta("UPDATE produtos SET nome=?, preco_de=?,preco_por=?,ultima_alteracao=?,estoque_total=?,estoque_corte=?,estoque_disponivel=?,nome_busca=?,marca=?,limite_alerta=? WHERE codigo=?")
 
 	cases := map[string]struct {
 		ExpectedErr  error
@@ -285,6 +288,9 @@ func Test_UpdateProdutoByCodigo(t *testing.T) {
 				res[0].EstoqueTotal,
 				res[0].EstoqueCorte,
 				res[0].EstoqueDisponivel,
+				model.Normalize(res[0].Nome),
+				res[0].Marca,
+				res[0].LimiteAlerta,
 				res[0].Codigo,
 			).WillReturnResult(sqlmock.NewResult(1, 1))
 		}},
@@ -317,7 +323,7 @@ func Test_UpdateProdutoByCodigo(t *testing.T) {
 
 func Test_DeleteProdutoByCodigo(t *testing.T) {
 
-	query := regexp.QuoteMeta("DELETE FROM `produtos` WHERE `codigo`=?")
+	query := regexp.QuoteMeta("DELETE FROM produtos WHERE codigo=?")
 
 	cases := map[string]struct {
 		ExpectedErr  error
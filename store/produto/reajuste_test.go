@@ -0,0 +1,76 @@
+package produto_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/GianGoulart/CrudProdutos/store/outbox"
+	"github.com/GianGoulart/CrudProdutos/store/produto"
+	"github.com/GianGoulart/CrudProdutos/test"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test_ReajustarPrecos garante que o reajuste é aplicado por inteiro, ou não é
+// aplicado, no banco e em memória
+func Test_ReajustarPrecos(t *testing.T) {
+	cases := map[string]struct {
+		NewStore func(t *testing.T) (produto.IProdutoStore, outbox.IOutboxStore)
+	}{
+		"sqlite": {NewStore: func(t *testing.T) (produto.IProdutoStore, outbox.IOutboxStore) {
+			db := test.GetSQLite(t, model.Produto{}, model.EventoOutbox{})
+
+			return produto.NewProdutoComOutbox(db), outbox.NewOutbox(db)
+		}},
+		"memoria": {NewStore: func(t *testing.T) (produto.IProdutoStore, outbox.IOutboxStore) {
+			eventos := outbox.NewOutboxMemory()
+			return produto.NewProdutoMemoryComOutbox(eventos), eventos
+		}},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			s, eventos := cs.NewStore(t)
+
+			p1 := model.Produto{Codigo: "p1", Nome: "Televisao SAMSUNG", PrecoDe: 2500, PrecoPor: 2200}
+			p2 := model.Produto{Codigo: "p2", Nome: "Televisao LG", PrecoDe: 3000, PrecoPor: 3000}
+			for _, p := range []*model.Produto{&p1, &p2} {
+				_, err := s.CreateProduto(ctx, p)
+				assert.NoError(t, err)
+			}
+
+			// o preço do segundo item não é mais o anterior, nada é alterado
+			_, err := s.ReajustarPrecos(ctx, []model.ItemReajuste{
+				{Codigo: "p1", PrecoDeAnterior: 2500, PrecoPorAnterior: 2200, PrecoDe: 2625, PrecoPor: 2310},
+				{Codigo: "p2", PrecoDeAnterior: 3000, PrecoPorAnterior: 2900, PrecoDe: 3150, PrecoPor: 3045},
+			})
+			assert.ErrorIs(t, err, produto.ErrPrecoAlterado)
+
+			found, _ := s.FindProdutoByCodigo(ctx, "p1")
+			assert.Equal(t, float64(2200), found.PrecoPor)
+
+			produtos, err := s.ReajustarPrecos(ctx, []model.ItemReajuste{
+				{Codigo: "p1", PrecoDeAnterior: 2500, PrecoPorAnterior: 2200, PrecoDe: 2625, PrecoPor: 2310},
+				{Codigo: "p2", PrecoDeAnterior: 3000, PrecoPorAnterior: 3000, PrecoDe: 3150, PrecoPor: 3150},
+			})
+			assert.NoError(t, err)
+			if assert.Len(t, *produtos, 2) {
+				assert.Equal(t, "Televisao LG", (*produtos)[1].Nome)
+			}
+
+			found, _ = s.FindProdutoByCodigo(ctx, "p2")
+			assert.Equal(t, float64(3150), found.PrecoDe)
+			assert.Equal(t, float64(3150), found.PrecoPor)
+
+			// os eventos do reajuste vêm depois dos de criação
+			pendentes, err := eventos.FindPendentes(ctx, 10)
+			assert.NoError(t, err)
+			if assert.Len(t, *pendentes, 4) {
+				assert.Equal(t, model.EventoProdutoAlterado, (*pendentes)[2].Tipo)
+				assert.Equal(t, "p1", (*pendentes)[2].Agregado)
+				assert.Equal(t, "p2", (*pendentes)[3].Agregado)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package produto_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/GianGoulart/CrudProdutos/store/produto"
+	"github.com/GianGoulart/CrudProdutos/test"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test_SQLite_Produto garante que o sql do store roda fora do mysql
+func Test_SQLite_Produto(t *testing.T) {
+	ctx := context.Background()
+
+	db := test.GetSQLite(t, model.Produto{})
+
+	s := produto.NewProduto(db)
+
+	p := res[0]
+	_, err := s.CreateProduto(ctx, &p)
+	assert.NoError(t, err)
+
+	p.Nome = "Televisao LG"
+	p.EstoqueCorte = 20
+	p.Marca = "lg"
+	_, err = s.UpdateProduto(ctx, &p)
+	assert.NoError(t, err)
+
+	found, err := s.FindProdutoByCodigo(ctx, p.Codigo)
+	assert.NoError(t, err)
+	assert.Equal(t, "Televisao LG", found.Nome)
+	assert.Equal(t, int64(80), found.EstoqueDisponivel)
+
+	byNome, err := s.FindProdutoByNome(ctx, "TELEVISAO")
+	assert.NoError(t, err)
+	assert.Len(t, *byNome, 1)
+
+	byMarca, err := s.FindProdutosByMarca(ctx, "lg")
+	assert.NoError(t, err)
+	assert.Len(t, *byMarca, 1)
+
+	byCodigos, err := s.FindProdutosByCodigos(ctx, []string{p.Codigo, "inexistente"})
+	assert.NoError(t, err)
+	assert.Len(t, *byCodigos, 1)
+
+	busca, err := s.SearchProdutos(ctx, []string{"lg", "100%"})
+	assert.NoError(t, err)
+	assert.Len(t, *busca, 1)
+
+	assert.NoError(t, s.DeleteProdutoByCodigo(ctx, &p))
+
+	produtos, err := s.FindProdutos(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, *produtos, 0)
+}
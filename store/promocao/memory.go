@@ -0,0 +1,145 @@
+package promocao
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/GianGoulart/CrudProdutos/logger"
+	"github.com/GianGoulart/CrudProdutos/model"
+)
+
+// ErrPromocaoDuplicada erro retornado ao criar uma promoção com um codigo já existente
+var ErrPromocaoDuplicada = errors.New("promoção já cadastrada")
+
+// NewPromocaoMemory cria uma nova instancia do repositorio de promoção em memória
+func NewPromocaoMemory() IPromocaoStore {
+	return &memoryImpl{
+		promocoes: make(map[string]model.Promocao),
+	}
+}
+
+type memoryImpl struct {
+	mu        sync.RWMutex
+	promocoes map[string]model.Promocao
+}
+
+func (r *memoryImpl) FindPromocoes(ctx context.Context) (*[]model.Promocao, error) {
+	return r.find(func(model.Promocao) bool { return true })
+}
+
+func (r *memoryImpl) FindPromocoesVigentes(ctx context.Context, agora time.Time) (*[]model.Promocao, error) {
+	return r.find(func(promocao model.Promocao) bool { return promocao.Vigente(agora) })
+}
+
+// find retorna as promoções aceitas pelo filtro na mesma ordem do banco
+func (r *memoryImpl) find(filtro func(model.Promocao) bool) (*[]model.Promocao, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	promocoes := make([]model.Promocao, 0, len(r.promocoes))
+	for _, promocao := range r.promocoes {
+		if filtro(promocao) {
+			promocoes = append(promocoes, copiar(promocao))
+		}
+	}
+
+	sort.Slice(promocoes, func(i, j int) bool {
+		if promocoes[i].Prioridade != promocoes[j].Prioridade {
+			return promocoes[i].Prioridade > promocoes[j].Prioridade
+		}
+		return promocoes[i].Codigo < promocoes[j].Codigo
+	})
+
+	return &promocoes, nil
+}
+
+func (r *memoryImpl) FindPromocaoByCodigo(ctx context.Context, codigo string) (*model.Promocao, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	// assim como no banco, um codigo inexistente retorna uma promoção vazia
+	promocao := copiar(r.promocoes[codigo])
+
+	return &promocao, nil
+}
+
+func (r *memoryImpl) CreatePromocao(ctx context.Context, promocao *model.Promocao) (*model.Promocao, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.promocoes[promocao.Codigo]; ok {
+		logger.FromContext(ctx).WithError(ErrPromocaoDuplicada).WithField("codigo", promocao.Codigo).Error("store.promocao.memory.CreatePromocao")
+		return &model.Promocao{}, ErrPromocaoDuplicada
+	}
+
+	promocao.CriadoEm = time.Now().Format(layout)
+	promocao.UltimaAlteracao = time.Now().Format(layout)
+
+	r.promocoes[promocao.Codigo] = copiar(*promocao)
+
+	return promocao, nil
+}
+
+func (r *memoryImpl) UpdatePromocao(ctx context.Context, promocao *model.Promocao) (*model.Promocao, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	promocao.UltimaAlteracao = time.Now().Format(layout)
+
+	atual, ok := r.promocoes[promocao.Codigo]
+	if !ok {
+		return promocao, nil
+	}
+
+	promocao.CriadoEm = atual.CriadoEm
+	r.promocoes[promocao.Codigo] = copiar(*promocao)
+
+	return promocao, nil
+}
+
+func (r *memoryImpl) DeletePromocaoByCodigo(ctx context.Context, promocao *model.Promocao) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.promocoes, promocao.Codigo)
+
+	return nil
+}
+
+func (r *memoryImpl) DeleteAlvosByProduto(ctx context.Context, produto string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for codigo, promocao := range r.promocoes {
+		produtos := []string{}
+		for _, p := range promocao.Produtos {
+			if p != produto {
+				produtos = append(produtos, p)
+			}
+		}
+
+		if len(produtos) == 0 {
+			produtos = nil
+		}
+		promocao.Produtos = produtos
+		r.promocoes[codigo] = promocao
+	}
+
+	return nil
+}
+
+// copiar retorna a promoção com os alvos ordenados como no banco e sem
+// compartilhar as listas com quem chamou
+func copiar(promocao model.Promocao) model.Promocao {
+	alvos := promocao.Alvos()
+	sort.SliceStable(alvos, func(i, j int) bool {
+		return alvos[i].Alvo < alvos[j].Alvo
+	})
+
+	carregarAlvos(&promocao, alvos)
+
+	return promocao
+}
@@ -0,0 +1,200 @@
+package promocao
+
+import (
+	"context"
+	"time"
+
+	"github.com/GianGoulart/CrudProdutos/logger"
+	"github.com/GianGoulart/CrudProdutos/model"
+	"gorm.io/gorm"
+)
+
+// IPromocaoStore interface para implementação do repositorio de promoções
+type IPromocaoStore interface {
+	FindPromocoes(ctx context.Context) (*[]model.Promocao, error)
+	FindPromocoesVigentes(ctx context.Context, agora time.Time) (*[]model.Promocao, error)
+	FindPromocaoByCodigo(ctx context.Context, codigo string) (*model.Promocao, error)
+	CreatePromocao(ctx context.Context, promocao *model.Promocao) (*model.Promocao, error)
+	UpdatePromocao(ctx context.Context, promocao *model.Promocao) (*model.Promocao, error)
+	DeletePromocaoByCodigo(ctx context.Context, promocao *model.Promocao) error
+	DeleteAlvosByProduto(ctx context.Context, produto string) error
+}
+
+// NewPromocao cria uma nova instancia do repositorio de promoção
+func NewPromocao(reader *gorm.DB) IPromocaoStore {
+	return &storeImpl{reader}
+}
+
+type storeImpl struct {
+	db *gorm.DB
+}
+
+const (
+	layout = "02-01-2006T15:04:05"
+)
+
+func (r *storeImpl) FindPromocoes(ctx context.Context) (*[]model.Promocao, error) {
+	return r.find(ctx, r.db.WithContext(ctx), "store.promocao.FindPromocoes")
+}
+
+// FindPromocoesVigentes retorna apenas as promoções válidas no instante informado.
+// Inicio e fim são gravados em RFC 3339 em UTC, então a comparação dos textos segue
+// a ordem das datas
+func (r *storeImpl) FindPromocoesVigentes(ctx context.Context, agora time.Time) (*[]model.Promocao, error) {
+	instante := agora.UTC().Format(time.RFC3339)
+
+	return r.find(ctx, r.db.WithContext(ctx).Where("inicio <= ? AND fim > ?", instante, instante), "store.promocao.FindPromocoesVigentes")
+}
+
+// find carrega as promoções da consulta informada com os seus alvos
+func (r *storeImpl) find(ctx context.Context, query *gorm.DB, origem string) (*[]model.Promocao, error) {
+	promocoes := new([]model.Promocao)
+
+	if err := query.Order("prioridade DESC, codigo").Find(&promocoes).Error; err != nil {
+		logger.FromContext(ctx).WithError(err).Error(origem)
+		return promocoes, err
+	}
+
+	if len(*promocoes) == 0 {
+		return promocoes, nil
+	}
+
+	codigos := make([]string, 0, len(*promocoes))
+	for _, promocao := range *promocoes {
+		codigos = append(codigos, promocao.Codigo)
+	}
+
+	alvos := []model.PromocaoAlvo{}
+	if err := r.db.WithContext(ctx).Where("promocao_codigo IN ?", codigos).Order("alvo").Find(&alvos).Error; err != nil {
+		logger.FromContext(ctx).WithError(err).Error(origem)
+		return promocoes, err
+	}
+
+	porPromocao := map[string][]model.PromocaoAlvo{}
+	for _, alvo := range alvos {
+		porPromocao[alvo.PromocaoCodigo] = append(porPromocao[alvo.PromocaoCodigo], alvo)
+	}
+
+	for i := range *promocoes {
+		carregarAlvos(&(*promocoes)[i], porPromocao[(*promocoes)[i].Codigo])
+	}
+
+	return promocoes, nil
+}
+
+func (r *storeImpl) FindPromocaoByCodigo(ctx context.Context, codigo string) (*model.Promocao, error) {
+	res := new(model.Promocao)
+
+	if err := r.db.WithContext(ctx).Where(&model.Promocao{Codigo: codigo}).Find(res).Error; err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("codigo", codigo).Error("store.promocao.FindPromocaoByCodigo")
+		return res, err
+	}
+
+	if res.Codigo == "" {
+		return res, nil
+	}
+
+	alvos := []model.PromocaoAlvo{}
+	if err := r.db.WithContext(ctx).Where("promocao_codigo = ?", codigo).Order("alvo").Find(&alvos).Error; err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("codigo", codigo).Error("store.promocao.FindPromocaoByCodigo")
+		return res, err
+	}
+
+	carregarAlvos(res, alvos)
+
+	return res, nil
+}
+
+func (r *storeImpl) CreatePromocao(ctx context.Context, promocao *model.Promocao) (*model.Promocao, error) {
+	promocao.CriadoEm = time.Now().Format(layout)
+	promocao.UltimaAlteracao = time.Now().Format(layout)
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		exec := "INSERT INTO promocoes (codigo,nome,tipo,valor,inicio,fim,prioridade,acumulativa,criado_em,ultima_alteracao) VALUES (?,?,?,?,?,?,?,?,?,?)"
+		if err := tx.Exec(exec, promocao.Codigo, promocao.Nome, promocao.Tipo, promocao.Valor, promocao.Inicio, promocao.Fim, promocao.Prioridade, promocao.Acumulativa, promocao.CriadoEm, promocao.UltimaAlteracao).Error; err != nil {
+			return err
+		}
+
+		return salvarAlvos(tx, promocao)
+	})
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("codigo", promocao.Codigo).Error("store.promocao.CreatePromocao")
+		return &model.Promocao{}, err
+	}
+
+	return promocao, nil
+}
+
+func (r *storeImpl) UpdatePromocao(ctx context.Context, promocao *model.Promocao) (*model.Promocao, error) {
+	promocao.UltimaAlteracao = time.Now().Format(layout)
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		exec := "UPDATE promocoes SET nome=?,tipo=?,valor=?,inicio=?,fim=?,prioridade=?,acumulativa=?,ultima_alteracao=? WHERE codigo=?"
+		if err := tx.Exec(exec, promocao.Nome, promocao.Tipo, promocao.Valor, promocao.Inicio, promocao.Fim, promocao.Prioridade, promocao.Acumulativa, promocao.UltimaAlteracao, promocao.Codigo).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Exec("DELETE FROM promocao_alvos WHERE promocao_codigo=?", promocao.Codigo).Error; err != nil {
+			return err
+		}
+
+		return salvarAlvos(tx, promocao)
+	})
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("codigo", promocao.Codigo).Error("store.promocao.UpdatePromocao")
+		return &model.Promocao{}, err
+	}
+
+	return promocao, nil
+}
+
+func (r *storeImpl) DeletePromocaoByCodigo(ctx context.Context, promocao *model.Promocao) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("DELETE FROM promocao_alvos WHERE promocao_codigo=?", promocao.Codigo).Error; err != nil {
+			return err
+		}
+
+		return tx.Exec("DELETE FROM promocoes WHERE codigo=?", promocao.Codigo).Error
+	})
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("codigo", promocao.Codigo).Error("store.promocao.DeletePromocaoByCodigo")
+		return err
+	}
+
+	return nil
+}
+
+// DeleteAlvosByProduto remove o produto das promoções que o tinham como alvo
+func (r *storeImpl) DeleteAlvosByProduto(ctx context.Context, produto string) error {
+	if err := r.db.WithContext(ctx).Exec("DELETE FROM promocao_alvos WHERE tipo=? AND alvo=?", model.AlvoProduto, produto).Error; err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("produto", produto).Error("store.promocao.DeleteAlvosByProduto")
+		return err
+	}
+
+	return nil
+}
+
+// salvarAlvos grava os produtos e as categorias da promoção
+func salvarAlvos(tx *gorm.DB, promocao *model.Promocao) error {
+	for _, alvo := range promocao.Alvos() {
+		exec := "INSERT INTO promocao_alvos (promocao_codigo,tipo,alvo) VALUES (?,?,?)"
+		if err := tx.Exec(exec, alvo.PromocaoCodigo, alvo.Tipo, alvo.Alvo).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// carregarAlvos preenche os produtos e as categorias da promoção a partir dos alvos gravados
+func carregarAlvos(promocao *model.Promocao, alvos []model.PromocaoAlvo) {
+	promocao.Produtos, promocao.Categorias = nil, nil
+	for _, alvo := range alvos {
+		switch alvo.Tipo {
+		case model.AlvoProduto:
+			promocao.Produtos = append(promocao.Produtos, alvo.Alvo)
+		case model.AlvoCategoria:
+			promocao.Categorias = append(promocao.Categorias, alvo.Alvo)
+		}
+	}
+}
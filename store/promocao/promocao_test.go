@@ -0,0 +1,92 @@
+package promocao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/GianGoulart/CrudProdutos/store/promocao"
+	"github.com/GianGoulart/CrudProdutos/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Promocao(t *testing.T) {
+	cases := map[string]struct {
+		Store promocao.IPromocaoStore
+	}{
+		"sqlite":  {Store: promocao.NewPromocao(test.GetSQLite(t, model.Promocao{}, model.PromocaoAlvo{}))},
+		"memoria": {Store: promocao.NewPromocaoMemory()},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			s := cs.Store
+
+			for _, p := range []model.Promocao{
+				{Codigo: "natal", Nome: "Natal", Tipo: model.DescontoPercentual, Valor: 10, Inicio: "2021-12-01T00:00:00Z", Fim: "2021-12-26T00:00:00Z", Categorias: []string{"tv"}},
+				{Codigo: "queima", Nome: "Queima", Tipo: model.DescontoFixo, Valor: 100, Inicio: "2021-12-01T00:00:00Z", Fim: "2021-12-26T00:00:00Z", Prioridade: 10, Acumulativa: true, Produtos: []string{"p2", "p1"}},
+			} {
+				p := p
+				_, err := s.CreatePromocao(ctx, &p)
+				assert.NoError(t, err)
+			}
+
+			found, err := s.FindPromocaoByCodigo(ctx, "queima")
+			assert.NoError(t, err)
+			assert.Equal(t, []string{"p1", "p2"}, found.Produtos)
+			assert.Empty(t, found.Categorias)
+			assert.True(t, found.Acumulativa)
+			assert.NotEmpty(t, found.CriadoEm)
+
+			natal := model.Promocao{Codigo: "natal", Nome: "Natal", Tipo: model.DescontoPercentual, Valor: 15, Inicio: "2021-12-01T00:00:00Z", Fim: "2021-12-26T00:00:00Z", Prioridade: 20, Produtos: []string{"p3"}, Categorias: []string{"audio"}}
+			_, err = s.UpdatePromocao(ctx, &natal)
+			assert.NoError(t, err)
+
+			promocoes, err := s.FindPromocoes(ctx)
+			assert.NoError(t, err)
+			if assert.Len(t, *promocoes, 2) {
+				assert.Equal(t, "natal", (*promocoes)[0].Codigo)
+				assert.Equal(t, float64(15), (*promocoes)[0].Valor)
+				assert.Equal(t, []string{"p3"}, (*promocoes)[0].Produtos)
+				assert.Equal(t, []string{"audio"}, (*promocoes)[0].Categorias)
+				assert.Equal(t, []string{"p1", "p2"}, (*promocoes)[1].Produtos)
+			}
+
+			vigentes, err := s.FindPromocoesVigentes(ctx, time.Date(2021, 12, 10, 0, 0, 0, 0, time.UTC))
+			assert.NoError(t, err)
+			if assert.Len(t, *vigentes, 2) {
+				assert.Equal(t, "natal", (*vigentes)[0].Codigo)
+				assert.Equal(t, []string{"audio"}, (*vigentes)[0].Categorias)
+				assert.Equal(t, []string{"p1", "p2"}, (*vigentes)[1].Produtos)
+			}
+
+			// o fim não faz parte do período
+			vigentes, err = s.FindPromocoesVigentes(ctx, time.Date(2021, 12, 26, 0, 0, 0, 0, time.UTC))
+			assert.NoError(t, err)
+			assert.Empty(t, *vigentes)
+
+			// o início faz parte do período, em qualquer fuso
+			vigentes, err = s.FindPromocoesVigentes(ctx, time.Date(2021, 11, 30, 21, 0, 0, 0, time.FixedZone("BRT", -3*60*60)))
+			assert.NoError(t, err)
+			assert.Len(t, *vigentes, 2)
+
+			assert.NoError(t, s.DeleteAlvosByProduto(ctx, "p2"))
+
+			found, err = s.FindPromocaoByCodigo(ctx, "queima")
+			assert.NoError(t, err)
+			assert.Equal(t, []string{"p1"}, found.Produtos)
+
+			assert.NoError(t, s.DeletePromocaoByCodigo(ctx, &model.Promocao{Codigo: "queima"}))
+
+			found, err = s.FindPromocaoByCodigo(ctx, "queima")
+			assert.NoError(t, err)
+			assert.Empty(t, found.Codigo)
+
+			promocoes, err = s.FindPromocoes(ctx)
+			assert.NoError(t, err)
+			assert.Len(t, *promocoes, 1)
+		})
+	}
+}
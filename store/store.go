@@ -1,30 +1,195 @@
 package store
 
 import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/GianGoulart/CrudProdutos/entregador"
 	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/GianGoulart/CrudProdutos/notificacao"
+	"github.com/GianGoulart/CrudProdutos/relay"
+	"github.com/GianGoulart/CrudProdutos/store/alerta"
+	"github.com/GianGoulart/CrudProdutos/store/cache"
+	"github.com/GianGoulart/CrudProdutos/store/categoria"
+	"github.com/GianGoulart/CrudProdutos/store/deposito"
+	"github.com/GianGoulart/CrudProdutos/store/fornecedor"
+	"github.com/GianGoulart/CrudProdutos/store/indice"
+	"github.com/GianGoulart/CrudProdutos/store/marca"
+	"github.com/GianGoulart/CrudProdutos/store/outbox"
 	"github.com/GianGoulart/CrudProdutos/store/produto"
+	"github.com/GianGoulart/CrudProdutos/store/promocao"
+	"github.com/GianGoulart/CrudProdutos/store/variacao"
+	"github.com/GianGoulart/CrudProdutos/store/webhook"
+	"github.com/GianGoulart/CrudProdutos/stream"
 	"github.com/sirupsen/logrus"
+	"gorm.io/driver/mysql"
+	"gorm.io/driver/postgres"
+	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 )
 
 // Container modelo para exportação dos repositórios instanciados
 type Container struct {
-	Produto produto.IProdutoStore
+	Produto    produto.IProdutoStore
+	Categoria  categoria.ICategoriaStore
+	Marca      marca.IMarcaStore
+	Fornecedor fornecedor.IFornecedorStore
+	Variacao   variacao.IVariacaoStore
+	Deposito   deposito.IDepositoStore
+	Alerta     alerta.IAlertaStore
+	Webhook    webhook.IWebhookStore
+	Promocao   promocao.IPromocaoStore
+	// Outbox eventos de produto a publicar no broker, nil quando desabilitada
+	Outbox outbox.IOutboxStore
+
+	// Cache backend do cache de leitura, nil quando desabilitado
+	Cache cache.Cache
+	// Indice índice de texto para as sugestões, nil quando desabilitado
+	Indice indice.IIndice
+	// Notificador envio dos alertas de estoque, nil quando não há canais
+	Notificador notificacao.INotificador
+	// LimiteAlerta limite padrão de estoque disponível para os alertas
+	LimiteAlerta int64
+	// LimitesPreco limites de preço verificados nas escritas de produto
+	LimitesPreco model.LimitesPreco
+	// Entregador envio das entregas aos webhooks, nil quando desabilitado
+	Entregador entregador.IEntregador
+	// WebhooksInternos permite webhooks com destino em endereços internos
+	WebhooksInternos bool
+	// Relay publicação da outbox no broker, nil quando desabilitada
+	Relay relay.IRelay
+	// Stream distribuição dos eventos de produto aos clientes conectados, nil quando desabilitado
+	Stream stream.IStream
+}
+
+// Drivers de banco suportados
+const (
+	DriverMySQL    = "mysql"
+	DriverPostgres = "postgres"
+	DriverSQLite   = "sqlite"
+	// DriverMemory driver que mantém os dados em memória, sem banco de dados
+	DriverMemory = "memory"
+)
+
+// Open abre a conexão com o banco de acordo com o driver configurado
+func Open(driver, url string) (*gorm.DB, error) {
+	var dialector gorm.Dialector
+
+	switch driver {
+	case DriverMySQL:
+		dialector = mysql.Open(url)
+	case DriverPostgres:
+		dialector = postgres.Open(url)
+	case DriverSQLite:
+		dialector = sqlite.Open(url)
+	default:
+		return nil, fmt.Errorf("driver de banco não suportado: %s", driver)
+	}
+
+	return gorm.Open(dialector, &gorm.Config{})
+}
+
+// Cache backends de cache suportados
+const (
+	CacheLRU   = "lru"
+	CacheRedis = "redis"
+)
+
+// NewCache cria o backend de cache configurado
+func NewCache(backend string, size int, url string) (cache.Cache, error) {
+	switch backend {
+	case CacheLRU:
+		return cache.NewLRU(size), nil
+	case CacheRedis:
+		return cache.NewRedis(url, "crudprodutos:")
+	default:
+		return nil, fmt.Errorf("backend de cache não suportado: %s", backend)
+	}
 }
 
 // Options struct de opções para a criação de uma instancia dos repositórios
 type Options struct {
-	DB *gorm.DB
+	DB     *gorm.DB
+	Driver string
+
+	Cache    cache.Cache
+	CacheTTL time.Duration
+
+	Indice indice.IIndice
+
+	Notificador  notificacao.INotificador
+	LimiteAlerta int64
+
+	LimitesPreco model.LimitesPreco
+
+	WebhooksInternos bool
+
+	// Outbox habilita a gravação dos eventos de produto na outbox
+	Outbox bool
+
+	Stream stream.IStream
 }
 
 // New cria uma nova instancia dos repositórios
 func New(opts Options) *Container {
 	container := &Container{
-		Produto: produto.NewProduto(opts.DB),
+		Cache:  opts.Cache,
+		Indice: opts.Indice,
+
+		Notificador:  opts.Notificador,
+		LimiteAlerta: opts.LimiteAlerta,
+		LimitesPreco: opts.LimitesPreco,
+
+		WebhooksInternos: opts.WebhooksInternos,
+
+		Stream: opts.Stream,
+	}
+
+	if opts.Driver == DriverMemory {
+		container.Produto = produto.NewProdutoMemory()
+		if opts.Outbox {
+			container.Outbox = outbox.NewOutboxMemory()
+			container.Produto = produto.NewProdutoMemoryComOutbox(container.Outbox)
+		}
+		container.Categoria = categoria.NewCategoriaMemory()
+		container.Marca = marca.NewMarcaMemory()
+		container.Fornecedor = fornecedor.NewFornecedorMemory()
+		container.Variacao = variacao.NewVariacaoMemory()
+		container.Deposito = deposito.NewDepositoMemory()
+		container.Alerta = alerta.NewAlertaMemory()
+		container.Webhook = webhook.NewWebhookMemory()
+		container.Promocao = promocao.NewPromocaoMemory()
+	} else {
+		container.Produto = produto.NewProduto(opts.DB)
+		if opts.Outbox {
+			container.Outbox = outbox.NewOutbox(opts.DB)
+			container.Produto = produto.NewProdutoComOutbox(opts.DB)
+		}
+		container.Categoria = categoria.NewCategoria(opts.DB)
+		container.Marca = marca.NewMarca(opts.DB)
+		container.Fornecedor = fornecedor.NewFornecedor(opts.DB)
+		container.Variacao = variacao.NewVariacao(opts.DB)
+		container.Deposito = deposito.NewDeposito(opts.DB)
+		container.Alerta = alerta.NewAlerta(opts.DB)
+		container.Webhook = webhook.NewWebhook(opts.DB)
+		container.Promocao = promocao.NewPromocao(opts.DB)
+		opts.DB.AutoMigrate(model.Produto{}, model.Categoria{}, model.ProdutoCategoria{},
+			model.Marca{}, model.Fornecedor{}, model.ProdutoFornecedor{}, model.Variacao{},
+			model.Deposito{}, model.EstoqueDeposito{}, model.Alerta{},
+			model.Webhook{}, model.Entrega{}, model.EventoOutbox{},
+			model.Promocao{}, model.PromocaoAlvo{})
+
+		if err := produto.MigrateNomeBusca(context.Background(), opts.DB); err != nil {
+			logrus.WithError(err).Error("store.produto.MigrateNomeBusca")
+		}
+	}
+
+	if opts.Cache != nil {
+		container.Produto = produto.NewProdutoCache(container.Produto, opts.Cache, opts.CacheTTL)
 	}
 
-	opts.DB.AutoMigrate(model.Produto{})
-	logrus.Info("Registered -> Store")
+	logrus.WithField("driver", opts.Driver).Info("Registered -> Store")
 
 	return container
 }
@@ -0,0 +1,140 @@
+package variacao
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/GianGoulart/CrudProdutos/logger"
+	"github.com/GianGoulart/CrudProdutos/model"
+)
+
+// ErrVariacaoDuplicada erro retornado ao criar uma variação com um sku já existente
+var ErrVariacaoDuplicada = errors.New("variação já cadastrada")
+
+// NewVariacaoMemory cria uma nova instancia do repositorio de variação em memória
+func NewVariacaoMemory() IVariacaoStore {
+	return &memoryImpl{
+		variacoes: make(map[string]model.Variacao),
+	}
+}
+
+type memoryImpl struct {
+	mu        sync.RWMutex
+	variacoes map[string]model.Variacao
+}
+
+func (r *memoryImpl) FindVariacoesByProduto(ctx context.Context, produto string) (*[]model.Variacao, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	variacoes := make([]model.Variacao, 0)
+	for _, variacao := range r.variacoes {
+		if variacao.ProdutoCodigo == produto {
+			variacoes = append(variacoes, variacao)
+		}
+	}
+
+	sort.Slice(variacoes, func(i, j int) bool {
+		return variacoes[i].SKU < variacoes[j].SKU
+	})
+
+	return &variacoes, nil
+}
+
+func (r *memoryImpl) FindVariacoesByProdutos(ctx context.Context, produtos []string) (*[]model.Variacao, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	buscados := make(map[string]bool, len(produtos))
+	for _, produto := range produtos {
+		buscados[produto] = true
+	}
+
+	variacoes := make([]model.Variacao, 0)
+	for _, variacao := range r.variacoes {
+		if buscados[variacao.ProdutoCodigo] {
+			variacoes = append(variacoes, variacao)
+		}
+	}
+
+	sort.Slice(variacoes, func(i, j int) bool {
+		if variacoes[i].ProdutoCodigo != variacoes[j].ProdutoCodigo {
+			return variacoes[i].ProdutoCodigo < variacoes[j].ProdutoCodigo
+		}
+		return variacoes[i].SKU < variacoes[j].SKU
+	})
+
+	return &variacoes, nil
+}
+
+func (r *memoryImpl) FindVariacaoBySKU(ctx context.Context, sku string) (*model.Variacao, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	// assim como no banco, um sku inexistente retorna uma variação vazia
+	variacao := r.variacoes[sku]
+
+	return &variacao, nil
+}
+
+func (r *memoryImpl) CreateVariacao(ctx context.Context, variacao *model.Variacao) (*model.Variacao, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.variacoes[variacao.SKU]; ok {
+		logger.FromContext(ctx).WithError(ErrVariacaoDuplicada).WithField("sku", variacao.SKU).Error("store.variacao.memory.CreateVariacao")
+		return &model.Variacao{}, ErrVariacaoDuplicada
+	}
+
+	variacao.CriadoEm = time.Now().Format(layout)
+	variacao.UltimaAlteracao = time.Now().Format(layout)
+
+	r.variacoes[variacao.SKU] = *variacao
+
+	return variacao, nil
+}
+
+func (r *memoryImpl) UpdateVariacao(ctx context.Context, variacao *model.Variacao) (*model.Variacao, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	variacao.UltimaAlteracao = time.Now().Format(layout)
+	variacao.EstoqueDisponivel = variacao.EstoqueTotal - variacao.EstoqueCorte
+
+	atual, ok := r.variacoes[variacao.SKU]
+	if !ok {
+		return variacao, nil
+	}
+
+	// o produto da variação não muda na alteração
+	variacao.ProdutoCodigo = atual.ProdutoCodigo
+	variacao.CriadoEm = atual.CriadoEm
+	r.variacoes[variacao.SKU] = *variacao
+
+	return variacao, nil
+}
+
+func (r *memoryImpl) DeleteVariacaoBySKU(ctx context.Context, variacao *model.Variacao) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.variacoes, variacao.SKU)
+
+	return nil
+}
+
+func (r *memoryImpl) DeleteVariacoesByProduto(ctx context.Context, produto string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for sku, variacao := range r.variacoes {
+		if variacao.ProdutoCodigo == produto {
+			delete(r.variacoes, sku)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package variacao
+
+import (
+	"context"
+	"time"
+
+	"github.com/GianGoulart/CrudProdutos/logger"
+	"github.com/GianGoulart/CrudProdutos/model"
+	"gorm.io/gorm"
+)
+
+// IVariacaoStore interface para implementação do repositorio de variações
+type IVariacaoStore interface {
+	FindVariacoesByProduto(ctx context.Context, produto string) (*[]model.Variacao, error)
+	FindVariacoesByProdutos(ctx context.Context, produtos []string) (*[]model.Variacao, error)
+	FindVariacaoBySKU(ctx context.Context, sku string) (*model.Variacao, error)
+	CreateVariacao(ctx context.Context, variacao *model.Variacao) (*model.Variacao, error)
+	UpdateVariacao(ctx context.Context, variacao *model.Variacao) (*model.Variacao, error)
+	DeleteVariacaoBySKU(ctx context.Context, variacao *model.Variacao) error
+	DeleteVariacoesByProduto(ctx context.Context, produto string) error
+}
+
+// NewVariacao cria uma nova instancia do repositorio de variação
+func NewVariacao(reader *gorm.DB) IVariacaoStore {
+	return &storeImpl{reader}
+}
+
+type storeImpl struct {
+	db *gorm.DB
+}
+
+const (
+	layout = "02-01-2006T15:04:05"
+)
+
+func (r *storeImpl) FindVariacoesByProduto(ctx context.Context, produto string) (*[]model.Variacao, error) {
+	variacoes := new([]model.Variacao)
+
+	if err := r.db.WithContext(ctx).Where("produto_codigo = ?", produto).Order("sku").Find(&variacoes).Error; err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("produto", produto).Error("store.variacao.FindVariacoesByProduto")
+		return variacoes, err
+	}
+
+	return variacoes, nil
+}
+
+// FindVariacoesByProdutos retorna as variações de vários produtos em uma única consulta
+func (r *storeImpl) FindVariacoesByProdutos(ctx context.Context, produtos []string) (*[]model.Variacao, error) {
+	variacoes := new([]model.Variacao)
+	if len(produtos) == 0 {
+		return variacoes, nil
+	}
+
+	if err := r.db.WithContext(ctx).Where("produto_codigo IN ?", produtos).Order("produto_codigo, sku").Find(&variacoes).Error; err != nil {
+		logger.FromContext(ctx).WithError(err).Error("store.variacao.FindVariacoesByProdutos")
+		return variacoes, err
+	}
+
+	return variacoes, nil
+}
+
+func (r *storeImpl) FindVariacaoBySKU(ctx context.Context, sku string) (*model.Variacao, error) {
+	res := new(model.Variacao)
+
+	if err := r.db.WithContext(ctx).Where(&model.Variacao{SKU: sku}).Find(res).Error; err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("sku", sku).Error("store.variacao.FindVariacaoBySKU")
+		return res, err
+	}
+
+	return res, nil
+}
+
+func (r *storeImpl) CreateVariacao(ctx context.Context, variacao *model.Variacao) (*model.Variacao, error) {
+	variacao.CriadoEm = time.Now().Format(layout)
+	variacao.UltimaAlteracao = time.Now().Format(layout)
+
+	exec := "INSERT INTO variacoes (sku,produto_codigo,tamanho,cor,voltagem,preco_de,preco_por,estoque_total,estoque_corte,estoque_disponivel,criado_em,ultima_alteracao) VALUES (?,?,?,?,?,?,?,?,?,?,?,?)"
+
+	if err := r.db.WithContext(ctx).Exec(exec, variacao.SKU, variacao.ProdutoCodigo, variacao.Tamanho, variacao.Cor, variacao.Voltagem, variacao.PrecoDe, variacao.PrecoPor, variacao.EstoqueTotal, variacao.EstoqueCorte, variacao.EstoqueDisponivel, variacao.CriadoEm, variacao.UltimaAlteracao).Error; err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("sku", variacao.SKU).Error("store.variacao.CreateVariacao")
+		return &model.Variacao{}, err
+	}
+
+	return variacao, nil
+}
+
+func (r *storeImpl) UpdateVariacao(ctx context.Context, variacao *model.Variacao) (*model.Variacao, error) {
+	variacao.UltimaAlteracao = time.Now().Format(layout)
+	variacao.EstoqueDisponivel = variacao.EstoqueTotal - variacao.EstoqueCorte
+
+	exec := "UPDATE variacoes SET tamanho=?,cor=?,voltagem=?,preco_de=?,preco_por=?,estoque_total=?,estoque_corte=?,estoque_disponivel=?,ultima_alteracao=? WHERE sku=?"
+
+	if err := r.db.WithContext(ctx).Exec(exec, variacao.Tamanho, variacao.Cor, variacao.Voltagem, variacao.PrecoDe, variacao.PrecoPor, variacao.EstoqueTotal, variacao.EstoqueCorte, variacao.EstoqueDisponivel, variacao.UltimaAlteracao, variacao.SKU).Error; err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("sku", variacao.SKU).Error("store.variacao.UpdateVariacao")
+		return &model.Variacao{}, err
+	}
+
+	return variacao, nil
+}
+
+func (r *storeImpl) DeleteVariacaoBySKU(ctx context.Context, variacao *model.Variacao) error {
+	exec := "DELETE FROM variacoes WHERE sku=?"
+	if err := r.db.WithContext(ctx).Exec(exec, variacao.SKU).Error; err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("sku", variacao.SKU).Error("store.variacao.DeleteVariacaoBySKU")
+		return err
+	}
+
+	return nil
+}
+
+func (r *storeImpl) DeleteVariacoesByProduto(ctx context.Context, produto string) error {
+	exec := "DELETE FROM variacoes WHERE produto_codigo=?"
+	if err := r.db.WithContext(ctx).Exec(exec, produto).Error; err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("produto", produto).Error("store.variacao.DeleteVariacoesByProduto")
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package variacao_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/GianGoulart/CrudProdutos/store/variacao"
+	"github.com/GianGoulart/CrudProdutos/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Variacao(t *testing.T) {
+	cases := map[string]struct {
+		Store variacao.IVariacaoStore
+	}{
+		"sqlite":  {Store: variacao.NewVariacao(test.GetSQLite(t, model.Variacao{}))},
+		"memoria": {Store: variacao.NewVariacaoMemory()},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			s := cs.Store
+
+			for _, v := range []model.Variacao{
+				{SKU: "cam-p", ProdutoCodigo: "cam", Tamanho: "P", PrecoDe: 50, PrecoPor: 40, EstoqueTotal: 10, EstoqueCorte: 2},
+				{SKU: "cam-m", ProdutoCodigo: "cam", Tamanho: "M", PrecoDe: 50, PrecoPor: 40, EstoqueTotal: 5},
+				{SKU: "tv-110", ProdutoCodigo: "tv", Voltagem: "110", PrecoDe: 2000, PrecoPor: 1800, EstoqueTotal: 3},
+			} {
+				v := v
+				v.PreSave()
+				_, err := s.CreateVariacao(ctx, &v)
+				assert.NoError(t, err)
+			}
+
+			m := model.Variacao{SKU: "cam-m", ProdutoCodigo: "cam", Tamanho: "M", PrecoDe: 60, PrecoPor: 45, EstoqueTotal: 8, EstoqueCorte: 1}
+			_, err := s.UpdateVariacao(ctx, &m)
+			assert.NoError(t, err)
+
+			found, err := s.FindVariacaoBySKU(ctx, "cam-m")
+			assert.NoError(t, err)
+			assert.Equal(t, 45.0, found.PrecoPor)
+			assert.Equal(t, int64(7), found.EstoqueDisponivel)
+			assert.NotEmpty(t, found.CriadoEm)
+
+			variacoes, err := s.FindVariacoesByProduto(ctx, "cam")
+			assert.NoError(t, err)
+			assert.Len(t, *variacoes, 2)
+
+			variacoes, err = s.FindVariacoesByProdutos(ctx, []string{"tv", "cam", "xpto"})
+			assert.NoError(t, err)
+			skus := []string{}
+			for _, v := range *variacoes {
+				skus = append(skus, v.SKU)
+			}
+			assert.Equal(t, []string{"cam-m", "cam-p", "tv-110"}, skus)
+
+			assert.NoError(t, s.DeleteVariacaoBySKU(ctx, &model.Variacao{SKU: "cam-p"}))
+
+			found, err = s.FindVariacaoBySKU(ctx, "cam-p")
+			assert.NoError(t, err)
+			assert.Empty(t, found.SKU)
+
+			assert.NoError(t, s.DeleteVariacoesByProduto(ctx, "cam"))
+
+			variacoes, err = s.FindVariacoesByProduto(ctx, "cam")
+			assert.NoError(t, err)
+			assert.Empty(t, *variacoes)
+
+			variacoes, err = s.FindVariacoesByProduto(ctx, "tv")
+			assert.NoError(t, err)
+			assert.Len(t, *variacoes, 1)
+		})
+	}
+}
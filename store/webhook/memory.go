@@ -0,0 +1,196 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/GianGoulart/CrudProdutos/logger"
+	"github.com/GianGoulart/CrudProdutos/model"
+)
+
+// ErrWebhookDuplicado erro retornado ao criar um webhook com um codigo já existente
+var ErrWebhookDuplicado = errors.New("webhook já cadastrado")
+
+// NewWebhookMemory cria uma nova instancia do repositorio de webhook em memória
+func NewWebhookMemory() IWebhookStore {
+	return &memoryImpl{
+		webhooks: make(map[string]model.Webhook),
+		entregas: make(map[string]model.Entrega),
+	}
+}
+
+type memoryImpl struct {
+	mu       sync.RWMutex
+	webhooks map[string]model.Webhook
+	entregas map[string]model.Entrega
+}
+
+func (r *memoryImpl) FindWebhooks(ctx context.Context) (*[]model.Webhook, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	webhooks := make([]model.Webhook, 0, len(r.webhooks))
+	for _, webhook := range r.webhooks {
+		webhooks = append(webhooks, webhook)
+	}
+
+	sort.Slice(webhooks, func(i, j int) bool {
+		if webhooks[i].URL != webhooks[j].URL {
+			return webhooks[i].URL < webhooks[j].URL
+		}
+		return webhooks[i].Codigo < webhooks[j].Codigo
+	})
+
+	return &webhooks, nil
+}
+
+func (r *memoryImpl) FindWebhookByCodigo(ctx context.Context, codigo string) (*model.Webhook, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	// assim como no banco, um codigo inexistente retorna um webhook vazio
+	webhook := r.webhooks[codigo]
+
+	return &webhook, nil
+}
+
+func (r *memoryImpl) CreateWebhook(ctx context.Context, webhook *model.Webhook) (*model.Webhook, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.webhooks[webhook.Codigo]; ok {
+		logger.FromContext(ctx).WithError(ErrWebhookDuplicado).WithField("codigo", webhook.Codigo).Error("store.webhook.memory.CreateWebhook")
+		return &model.Webhook{}, ErrWebhookDuplicado
+	}
+
+	webhook.CriadoEm = time.Now().Format(layout)
+	webhook.UltimaAlteracao = time.Now().Format(layout)
+
+	r.webhooks[webhook.Codigo] = *webhook
+
+	return webhook, nil
+}
+
+func (r *memoryImpl) UpdateWebhook(ctx context.Context, webhook *model.Webhook) (*model.Webhook, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	webhook.UltimaAlteracao = time.Now().Format(layout)
+
+	atual, ok := r.webhooks[webhook.Codigo]
+	if !ok {
+		return webhook, nil
+	}
+
+	webhook.CriadoEm = atual.CriadoEm
+	r.webhooks[webhook.Codigo] = *webhook
+
+	return webhook, nil
+}
+
+func (r *memoryImpl) DeleteWebhookByCodigo(ctx context.Context, webhook *model.Webhook) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.webhooks, webhook.Codigo)
+	for codigo, entrega := range r.entregas {
+		if entrega.WebhookCodigo == webhook.Codigo {
+			delete(r.entregas, codigo)
+		}
+	}
+
+	return nil
+}
+
+func (r *memoryImpl) FindEntregas(ctx context.Context, filtro model.FiltroEntrega) (*[]model.Entrega, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	entregas := make([]model.Entrega, 0)
+	for _, entrega := range r.entregas {
+		if filtro.Webhook != "" && entrega.WebhookCodigo != filtro.Webhook {
+			continue
+		}
+		if filtro.Status != "" && entrega.Status != filtro.Status {
+			continue
+		}
+		entregas = append(entregas, entrega)
+	}
+
+	ordenar(entregas)
+
+	return &entregas, nil
+}
+
+func (r *memoryImpl) FindEntregaByCodigo(ctx context.Context, codigo string) (*model.Entrega, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	entrega := r.entregas[codigo]
+
+	return &entrega, nil
+}
+
+func (r *memoryImpl) FindEntregasPendentes(ctx context.Context, ate int64, limite int) (*[]model.Entrega, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	entregas := make([]model.Entrega, 0)
+	for _, entrega := range r.entregas {
+		if entrega.Status == model.EntregaPendente && entrega.ProximaTentativa <= ate {
+			entregas = append(entregas, entrega)
+		}
+	}
+
+	sort.Slice(entregas, func(i, j int) bool {
+		if entregas[i].ProximaTentativa != entregas[j].ProximaTentativa {
+			return entregas[i].ProximaTentativa < entregas[j].ProximaTentativa
+		}
+		return entregas[i].Codigo < entregas[j].Codigo
+	})
+
+	if len(entregas) > limite {
+		entregas = entregas[:limite]
+	}
+
+	return &entregas, nil
+}
+
+func (r *memoryImpl) CreateEntregas(ctx context.Context, entregas []model.Entrega) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	agora := time.Now().Format(layout)
+	for i := range entregas {
+		entregas[i].CriadoEm = agora
+		entregas[i].UltimaAlteracao = agora
+		r.entregas[entregas[i].Codigo] = entregas[i]
+	}
+
+	return nil
+}
+
+func (r *memoryImpl) UpdateEntrega(ctx context.Context, entrega *model.Entrega) (*model.Entrega, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entrega.UltimaAlteracao = time.Now().Format(layout)
+
+	atual, ok := r.entregas[entrega.Codigo]
+	if !ok {
+		return entrega, nil
+	}
+
+	atual.Status = entrega.Status
+	atual.Tentativas = entrega.Tentativas
+	atual.ProximaTentativa = entrega.ProximaTentativa
+	atual.UltimoStatus = entrega.UltimoStatus
+	atual.UltimoErro = entrega.UltimoErro
+	atual.UltimaAlteracao = entrega.UltimaAlteracao
+	r.entregas[entrega.Codigo] = atual
+
+	return entrega, nil
+}
@@ -0,0 +1,205 @@
+package webhook
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/GianGoulart/CrudProdutos/logger"
+	"github.com/GianGoulart/CrudProdutos/model"
+	"gorm.io/gorm"
+)
+
+// IWebhookStore interface para implementação do repositorio de webhooks e das suas entregas
+type IWebhookStore interface {
+	FindWebhooks(ctx context.Context) (*[]model.Webhook, error)
+	FindWebhookByCodigo(ctx context.Context, codigo string) (*model.Webhook, error)
+	CreateWebhook(ctx context.Context, webhook *model.Webhook) (*model.Webhook, error)
+	UpdateWebhook(ctx context.Context, webhook *model.Webhook) (*model.Webhook, error)
+	DeleteWebhookByCodigo(ctx context.Context, webhook *model.Webhook) error
+	FindEntregas(ctx context.Context, filtro model.FiltroEntrega) (*[]model.Entrega, error)
+	FindEntregaByCodigo(ctx context.Context, codigo string) (*model.Entrega, error)
+	FindEntregasPendentes(ctx context.Context, ate int64, limite int) (*[]model.Entrega, error)
+	CreateEntregas(ctx context.Context, entregas []model.Entrega) error
+	UpdateEntrega(ctx context.Context, entrega *model.Entrega) (*model.Entrega, error)
+}
+
+// NewWebhook cria uma nova instancia do repositorio de webhook
+func NewWebhook(reader *gorm.DB) IWebhookStore {
+	return &storeImpl{reader}
+}
+
+type storeImpl struct {
+	db *gorm.DB
+}
+
+const (
+	layout = "02-01-2006T15:04:05"
+)
+
+func (r *storeImpl) FindWebhooks(ctx context.Context) (*[]model.Webhook, error) {
+	webhooks := new([]model.Webhook)
+
+	if err := r.db.WithContext(ctx).Order("url, codigo").Find(&webhooks).Error; err != nil {
+		logger.FromContext(ctx).WithError(err).Error("store.webhook.FindWebhooks")
+		return webhooks, err
+	}
+
+	return webhooks, nil
+}
+
+func (r *storeImpl) FindWebhookByCodigo(ctx context.Context, codigo string) (*model.Webhook, error) {
+	res := new(model.Webhook)
+
+	if err := r.db.WithContext(ctx).Where(&model.Webhook{Codigo: codigo}).Find(res).Error; err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("codigo", codigo).Error("store.webhook.FindWebhookByCodigo")
+		return res, err
+	}
+
+	return res, nil
+}
+
+func (r *storeImpl) CreateWebhook(ctx context.Context, webhook *model.Webhook) (*model.Webhook, error) {
+	webhook.CriadoEm = time.Now().Format(layout)
+	webhook.UltimaAlteracao = time.Now().Format(layout)
+
+	exec := "INSERT INTO webhooks (codigo,url,segredo,eventos,inativo,criado_em,ultima_alteracao) VALUES (?,?,?,?,?,?,?)"
+
+	if err := r.db.WithContext(ctx).Exec(exec, webhook.Codigo, webhook.URL, webhook.Segredo, webhook.Eventos, webhook.Inativo, webhook.CriadoEm, webhook.UltimaAlteracao).Error; err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("codigo", webhook.Codigo).Error("store.webhook.CreateWebhook")
+		return &model.Webhook{}, err
+	}
+
+	return webhook, nil
+}
+
+func (r *storeImpl) UpdateWebhook(ctx context.Context, webhook *model.Webhook) (*model.Webhook, error) {
+	webhook.UltimaAlteracao = time.Now().Format(layout)
+
+	exec := "UPDATE webhooks SET url=?,segredo=?,eventos=?,inativo=?,ultima_alteracao=? WHERE codigo=?"
+
+	if err := r.db.WithContext(ctx).Exec(exec, webhook.URL, webhook.Segredo, webhook.Eventos, webhook.Inativo, webhook.UltimaAlteracao, webhook.Codigo).Error; err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("codigo", webhook.Codigo).Error("store.webhook.UpdateWebhook")
+		return &model.Webhook{}, err
+	}
+
+	return webhook, nil
+}
+
+func (r *storeImpl) DeleteWebhookByCodigo(ctx context.Context, webhook *model.Webhook) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("DELETE FROM webhook_entregas WHERE webhook_codigo=?", webhook.Codigo).Error; err != nil {
+			return err
+		}
+
+		return tx.Exec("DELETE FROM webhooks WHERE codigo=?", webhook.Codigo).Error
+	})
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("codigo", webhook.Codigo).Error("store.webhook.DeleteWebhookByCodigo")
+		return err
+	}
+
+	return nil
+}
+
+func (r *storeImpl) FindEntregas(ctx context.Context, filtro model.FiltroEntrega) (*[]model.Entrega, error) {
+	entregas := new([]model.Entrega)
+
+	query := r.db.WithContext(ctx)
+	if filtro.Webhook != "" {
+		query = query.Where("webhook_codigo = ?", filtro.Webhook)
+	}
+	if filtro.Status != "" {
+		query = query.Where("status = ?", filtro.Status)
+	}
+
+	if err := query.Find(&entregas).Error; err != nil {
+		logger.FromContext(ctx).WithError(err).Error("store.webhook.FindEntregas")
+		return entregas, err
+	}
+
+	ordenar(*entregas)
+
+	return entregas, nil
+}
+
+func (r *storeImpl) FindEntregaByCodigo(ctx context.Context, codigo string) (*model.Entrega, error) {
+	res := new(model.Entrega)
+
+	if err := r.db.WithContext(ctx).Where(&model.Entrega{Codigo: codigo}).Find(res).Error; err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("codigo", codigo).Error("store.webhook.FindEntregaByCodigo")
+		return res, err
+	}
+
+	return res, nil
+}
+
+// FindEntregasPendentes retorna as entregas pendentes com a próxima tentativa até
+// o instante informado, em unix, das mais antigas para as mais novas
+func (r *storeImpl) FindEntregasPendentes(ctx context.Context, ate int64, limite int) (*[]model.Entrega, error) {
+	entregas := new([]model.Entrega)
+
+	err := r.db.WithContext(ctx).
+		Where("status = ? AND proxima_tentativa <= ?", model.EntregaPendente, ate).
+		Order("proxima_tentativa, codigo").
+		Limit(limite).
+		Find(&entregas).Error
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).Error("store.webhook.FindEntregasPendentes")
+		return entregas, err
+	}
+
+	return entregas, nil
+}
+
+func (r *storeImpl) CreateEntregas(ctx context.Context, entregas []model.Entrega) error {
+	agora := time.Now().Format(layout)
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		exec := "INSERT INTO webhook_entregas (codigo,webhook_codigo,evento,produto,payload,status,tentativas,proxima_tentativa,ultimo_status,ultimo_erro,criado_em,ultima_alteracao) VALUES (?,?,?,?,?,?,?,?,?,?,?,?)"
+
+		for i := range entregas {
+			e := &entregas[i]
+			e.CriadoEm = agora
+			e.UltimaAlteracao = agora
+
+			if err := tx.Exec(exec, e.Codigo, e.WebhookCodigo, e.Evento, e.Produto, e.Payload, e.Status, e.Tentativas, e.ProximaTentativa, e.UltimoStatus, e.UltimoErro, e.CriadoEm, e.UltimaAlteracao).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).Error("store.webhook.CreateEntregas")
+		return err
+	}
+
+	return nil
+}
+
+func (r *storeImpl) UpdateEntrega(ctx context.Context, entrega *model.Entrega) (*model.Entrega, error) {
+	entrega.UltimaAlteracao = time.Now().Format(layout)
+
+	exec := "UPDATE webhook_entregas SET status=?,tentativas=?,proxima_tentativa=?,ultimo_status=?,ultimo_erro=?,ultima_alteracao=? WHERE codigo=?"
+
+	if err := r.db.WithContext(ctx).Exec(exec, entrega.Status, entrega.Tentativas, entrega.ProximaTentativa, entrega.UltimoStatus, entrega.UltimoErro, entrega.UltimaAlteracao, entrega.Codigo).Error; err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("codigo", entrega.Codigo).Error("store.webhook.UpdateEntrega")
+		return &model.Entrega{}, err
+	}
+
+	return entrega, nil
+}
+
+// ordenar coloca as entregas mais recentes primeiro. O layout das datas começa
+// pelo dia e não pode ser ordenado como texto
+func ordenar(entregas []model.Entrega) {
+	sort.SliceStable(entregas, func(i, j int) bool {
+		a, _ := time.Parse(layout, entregas[i].CriadoEm)
+		b, _ := time.Parse(layout, entregas[j].CriadoEm)
+		if !a.Equal(b) {
+			return a.After(b)
+		}
+		return entregas[i].Codigo < entregas[j].Codigo
+	})
+}
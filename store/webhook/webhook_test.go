@@ -0,0 +1,95 @@
+package webhook_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/GianGoulart/CrudProdutos/store/webhook"
+	"github.com/GianGoulart/CrudProdutos/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Webhook(t *testing.T) {
+	cases := map[string]struct {
+		Store webhook.IWebhookStore
+	}{
+		"sqlite":  {Store: webhook.NewWebhook(test.GetSQLite(t, model.Webhook{}, model.Entrega{}))},
+		"memoria": {Store: webhook.NewWebhookMemory()},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			s := cs.Store
+
+			for _, w := range []model.Webhook{
+				{Codigo: "w1", URL: "http://a.local/hook", Segredo: "s1", Eventos: model.ListaEventos{model.EventoProdutoCriado, model.EventoEstoqueAlterado}},
+				{Codigo: "w2", URL: "http://b.local/hook", Segredo: "s2", Eventos: model.ListaEventos{model.EventoProdutoRemovido}},
+			} {
+				w := w
+				_, err := s.CreateWebhook(ctx, &w)
+				assert.NoError(t, err)
+			}
+
+			found, err := s.FindWebhookByCodigo(ctx, "w1")
+			assert.NoError(t, err)
+			assert.Equal(t, model.ListaEventos{model.EventoProdutoCriado, model.EventoEstoqueAlterado}, found.Eventos)
+			assert.Equal(t, "s1", found.Segredo)
+
+			found.Inativo = true
+			_, err = s.UpdateWebhook(ctx, found)
+			assert.NoError(t, err)
+
+			found, err = s.FindWebhookByCodigo(ctx, "w1")
+			assert.NoError(t, err)
+			assert.True(t, found.Inativo)
+
+			webhooks, err := s.FindWebhooks(ctx)
+			assert.NoError(t, err)
+			assert.Len(t, *webhooks, 2)
+
+			err = s.CreateEntregas(ctx, []model.Entrega{
+				{Codigo: "e1", WebhookCodigo: "w1", Evento: model.EventoProdutoCriado, Payload: "{}", Status: model.EntregaPendente, ProximaTentativa: 20},
+				{Codigo: "e2", WebhookCodigo: "w1", Evento: model.EventoProdutoCriado, Payload: "{}", Status: model.EntregaPendente, ProximaTentativa: 10},
+				{Codigo: "e3", WebhookCodigo: "w2", Evento: model.EventoProdutoRemovido, Payload: "{}", Status: model.EntregaPendente, ProximaTentativa: 30},
+			})
+			assert.NoError(t, err)
+
+			pendentes, err := s.FindEntregasPendentes(ctx, 25, 10)
+			assert.NoError(t, err)
+			if assert.Len(t, *pendentes, 2) {
+				assert.Equal(t, "e2", (*pendentes)[0].Codigo)
+				assert.Equal(t, "e1", (*pendentes)[1].Codigo)
+			}
+
+			entrega, err := s.FindEntregaByCodigo(ctx, "e1")
+			assert.NoError(t, err)
+			entrega.Status = model.EntregaFalha
+			entrega.Tentativas = 8
+			entrega.UltimoErro = "timeout"
+			_, err = s.UpdateEntrega(ctx, entrega)
+			assert.NoError(t, err)
+
+			falhas, err := s.FindEntregas(ctx, model.FiltroEntrega{Status: model.EntregaFalha})
+			assert.NoError(t, err)
+			if assert.Len(t, *falhas, 1) {
+				assert.Equal(t, 8, (*falhas)[0].Tentativas)
+				assert.Equal(t, "timeout", (*falhas)[0].UltimoErro)
+			}
+
+			// a remoção do webhook leva junto o histórico das entregas
+			assert.NoError(t, s.DeleteWebhookByCodigo(ctx, &model.Webhook{Codigo: "w1"}))
+
+			entregas, err := s.FindEntregas(ctx, model.FiltroEntrega{})
+			assert.NoError(t, err)
+			if assert.Len(t, *entregas, 1) {
+				assert.Equal(t, "e3", (*entregas)[0].Codigo)
+			}
+
+			found, err = s.FindWebhookByCodigo(ctx, "w1")
+			assert.NoError(t, err)
+			assert.Empty(t, found.Codigo)
+		})
+	}
+}
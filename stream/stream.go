@@ -0,0 +1,214 @@
+package stream
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/GianGoulart/CrudProdutos/model"
+)
+
+// IStream interface da distribuição dos eventos de produto para os clientes conectados
+type IStream interface {
+	Publicar(tipo string, produto *model.Produto)
+	Assinar(filtro Filtro, ultimoID string) (*Assinatura, bool)
+}
+
+// Evento evento distribuído aos assinantes
+type Evento struct {
+	// ID crescente, enviado no Last-Event-ID para retomar o stream
+	ID      string
+	Tipo    string
+	Produto string
+	// Dados corpo do evento, o mesmo enviado aos webhooks
+	Dados []byte
+
+	sequencia uint64
+}
+
+// Filtro eventos recebidos pelo assinante, campos vazios não filtram
+type Filtro struct {
+	Codigos []string
+	Tipos   []string
+}
+
+// Aceita indica se o evento passa no filtro
+func (f Filtro) Aceita(e Evento) bool {
+	return contem(f.Codigos, e.Produto) && contem(f.Tipos, e.Tipo)
+}
+
+func contem(valores []string, valor string) bool {
+	if len(valores) == 0 {
+		return true
+	}
+
+	for _, v := range valores {
+		if v == valor {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Assinatura eventos recebidos por um cliente. O canal é fechado quando o
+// cliente não acompanha os eventos, e ele deve se reconectar com o último id
+type Assinatura struct {
+	Eventos <-chan Evento
+
+	eventos chan Evento
+	filtro  Filtro
+	hub     *Hub
+}
+
+// Close encerra a assinatura
+func (a *Assinatura) Close() {
+	a.hub.remover(a)
+}
+
+// Hub distribui os eventos aos assinantes e guarda os mais recentes para que os
+// clientes reconectados recebam o que perderam
+type Hub struct {
+	mu sync.Mutex
+	// inicio identifica a instancia nos ids, que recomeçam a cada subida
+	inicio    string
+	sequencia uint64
+	// recentes buffer circular com os últimos eventos publicados
+	recentes   []Evento
+	tamanho    int
+	pendentes  int
+	assinantes map[*Assinatura]struct{}
+}
+
+// New cria um hub que guarda os últimos eventos publicados, até o tamanho
+// informado, e com o buffer informado para cada assinante
+func New(tamanho, pendentes int) *Hub {
+	return &Hub{
+		inicio:     strconv.FormatInt(time.Now().UnixNano(), 36),
+		tamanho:    tamanho,
+		pendentes:  pendentes,
+		assinantes: make(map[*Assinatura]struct{}),
+	}
+}
+
+// Publicar distribui o evento do produto aos assinantes, sem bloquear quem publica
+func (h *Hub) Publicar(tipo string, produto *model.Produto) {
+	if produto == nil || produto.Codigo == "" {
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.sequencia++
+
+	dados, _ := json.Marshal(model.Evento{
+		Codigo:     model.NewId(),
+		Tipo:       tipo,
+		Produto:    produto.Codigo,
+		OcorridoEm: time.Now().UTC().Format(time.RFC3339),
+		Dados:      produto,
+	})
+
+	evento := Evento{
+		ID:        fmt.Sprintf("%s-%d", h.inicio, h.sequencia),
+		Tipo:      tipo,
+		Produto:   produto.Codigo,
+		Dados:     dados,
+		sequencia: h.sequencia,
+	}
+
+	h.recentes = append(h.recentes, evento)
+	if len(h.recentes) > h.tamanho {
+		h.recentes = h.recentes[len(h.recentes)-h.tamanho:]
+	}
+
+	for a := range h.assinantes {
+		if !a.filtro.Aceita(evento) {
+			continue
+		}
+
+		select {
+		case a.eventos <- evento:
+		default:
+			// o cliente não está acompanhando, ele retoma pelo último id recebido
+			delete(h.assinantes, a)
+			close(a.eventos)
+		}
+	}
+}
+
+// Assinar registra um assinante. Com o último id recebido, os eventos seguintes
+// ainda guardados são entregues antes dos novos; o retorno false indica que parte
+// desses eventos não está mais disponível e o cliente deve recarregar os dados
+func (h *Hub) Assinar(filtro Filtro, ultimoID string) (*Assinatura, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	replay, completo := h.desde(ultimoID)
+
+	eventos := make(chan Evento, h.pendentes+len(replay))
+	a := &Assinatura{Eventos: eventos, eventos: eventos, filtro: filtro, hub: h}
+
+	for _, evento := range replay {
+		if filtro.Aceita(evento) {
+			eventos <- evento
+		}
+	}
+
+	h.assinantes[a] = struct{}{}
+
+	return a, completo
+}
+
+// desde retorna os eventos guardados depois do id informado
+func (h *Hub) desde(ultimoID string) ([]Evento, bool) {
+	if ultimoID == "" {
+		return nil, true
+	}
+
+	sequencia, ok := h.sequenciaDe(ultimoID)
+	if !ok {
+		// id de outra subida ou inválido, todos os eventos guardados são novos para o cliente
+		return h.recentes, false
+	}
+
+	replay := []Evento{}
+	for _, evento := range h.recentes {
+		if evento.sequencia > sequencia {
+			replay = append(replay, evento)
+		}
+	}
+
+	// o primeiro evento seguinte ao id ainda deve estar guardado
+	completo := sequencia == h.sequencia || (len(h.recentes) > 0 && h.recentes[0].sequencia <= sequencia+1)
+
+	return replay, completo
+}
+
+func (h *Hub) sequenciaDe(id string) (uint64, bool) {
+	i := strings.LastIndex(id, "-")
+	if i < 0 || id[:i] != h.inicio {
+		return 0, false
+	}
+
+	sequencia, err := strconv.ParseUint(id[i+1:], 10, 64)
+	if err != nil || sequencia > h.sequencia {
+		return 0, false
+	}
+
+	return sequencia, true
+}
+
+func (h *Hub) remover(a *Assinatura) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if _, ok := h.assinantes[a]; ok {
+		delete(h.assinantes, a)
+		close(a.eventos)
+	}
+}
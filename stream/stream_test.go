@@ -0,0 +1,114 @@
+package stream_test
+
+import (
+	"testing"
+
+	"github.com/GianGoulart/CrudProdutos/model"
+	"github.com/GianGoulart/CrudProdutos/stream"
+	"github.com/stretchr/testify/assert"
+)
+
+// receber lê os eventos já entregues à assinatura
+func receber(a *stream.Assinatura) []stream.Evento {
+	eventos := []stream.Evento{}
+	for {
+		select {
+		case e, ok := <-a.Eventos:
+			if !ok {
+				return eventos
+			}
+			eventos = append(eventos, e)
+		default:
+			return eventos
+		}
+	}
+}
+
+func tipos(eventos []stream.Evento) []string {
+	res := []string{}
+	for _, e := range eventos {
+		res = append(res, e.Produto+":"+e.Tipo)
+	}
+
+	return res
+}
+
+func Test_Publicar(t *testing.T) {
+	h := stream.New(10, 10)
+
+	todos, _ := h.Assinar(stream.Filtro{}, "")
+	p1, _ := h.Assinar(stream.Filtro{Codigos: []string{"p1"}}, "")
+	estoque, _ := h.Assinar(stream.Filtro{Tipos: []string{model.EventoEstoqueAlterado}}, "")
+
+	h.Publicar(model.EventoProdutoCriado, &model.Produto{Codigo: "p1"})
+	h.Publicar(model.EventoEstoqueAlterado, &model.Produto{Codigo: "p2"})
+
+	assert.Equal(t, []string{"p1:produto.criado", "p2:estoque.alterado"}, tipos(receber(todos)))
+	assert.Equal(t, []string{"p1:produto.criado"}, tipos(receber(p1)))
+	assert.Equal(t, []string{"p2:estoque.alterado"}, tipos(receber(estoque)))
+}
+
+func Test_Assinar(t *testing.T) {
+	h := stream.New(3, 10)
+
+	a, _ := h.Assinar(stream.Filtro{}, "")
+	for _, codigo := range []string{"p1", "p2", "p3", "p4", "p5"} {
+		h.Publicar(model.EventoProdutoAlterado, &model.Produto{Codigo: codigo})
+	}
+	ids := []string{}
+	for _, e := range receber(a) {
+		ids = append(ids, e.ID)
+	}
+	a.Close()
+
+	cases := map[string]struct {
+		UltimoID         string
+		ExpectedEventos  []string
+		ExpectedCompleto bool
+	}{
+		"sem id recebe só os novos eventos": {
+			ExpectedEventos: []string{}, ExpectedCompleto: true,
+		},
+		"deve reenviar os eventos seguintes ao id": {
+			UltimoID: ids[2], ExpectedEventos: []string{"p4:produto.alterado", "p5:produto.alterado"}, ExpectedCompleto: true,
+		},
+		"deve reenviar a partir do mais antigo guardado": {
+			UltimoID: ids[1], ExpectedEventos: []string{"p3:produto.alterado", "p4:produto.alterado", "p5:produto.alterado"}, ExpectedCompleto: true,
+		},
+		"deve indicar os eventos que saíram do buffer": {
+			UltimoID: ids[0], ExpectedEventos: []string{"p3:produto.alterado", "p4:produto.alterado", "p5:produto.alterado"},
+		},
+		"deve indicar o id de outra subida": {
+			UltimoID: "xpto-1", ExpectedEventos: []string{"p3:produto.alterado", "p4:produto.alterado", "p5:produto.alterado"},
+		},
+		"nada a reenviar com o último id": {
+			UltimoID: ids[4], ExpectedEventos: []string{}, ExpectedCompleto: true,
+		},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			a, completo := h.Assinar(stream.Filtro{}, cs.UltimoID)
+			defer a.Close()
+
+			assert.Equal(t, cs.ExpectedEventos, tipos(receber(a)))
+			assert.Equal(t, cs.ExpectedCompleto, completo)
+		})
+	}
+}
+
+func Test_AssinanteLento(t *testing.T) {
+	h := stream.New(10, 1)
+
+	a, _ := h.Assinar(stream.Filtro{}, "")
+
+	h.Publicar(model.EventoProdutoCriado, &model.Produto{Codigo: "p1"})
+	h.Publicar(model.EventoProdutoCriado, &model.Produto{Codigo: "p2"})
+
+	// o assinante que não acompanha é desconectado sem bloquear a publicação
+	assert.Len(t, receber(a), 1)
+	_, ok := <-a.Eventos
+	assert.False(t, ok)
+
+	a.Close()
+}
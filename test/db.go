@@ -1,9 +1,12 @@
 package test
 
 import (
+	"testing"
+
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/sirupsen/logrus"
 	"gorm.io/driver/mysql"
+	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 )
 
@@ -26,3 +29,17 @@ func GetDB() (*gorm.DB, sqlmock.Sqlmock) {
 func NewRows(columns ...string) *sqlmock.Rows {
 	return sqlmock.NewRows(columns)
 }
+
+// GetSQLite retorna um banco sqlite em memória com as tabelas dos models informados
+func GetSQLite(t *testing.T, models ...interface{}) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := db.AutoMigrate(models...); err != nil {
+		t.Fatal(err)
+	}
+
+	return db
+}